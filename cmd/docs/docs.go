@@ -18,9 +18,58 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/auth/login": {
+        "/api/admin/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает приглашения классов, сначала новые. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Список приглашений",
+                "responses": {
+                    "200": {
+                        "description": "Приглашения",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.InvitationResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Аутентифицирует существующего пользователя, устанавливает refresh токен в cookie и возвращает access токен в теле ответа.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает код приглашения, по которому ученики класса могут зарегистрироваться. Доступно администраторам.",
                 "consumes": [
                     "application/json"
                 ],
@@ -28,25 +77,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Аутентификация пользователя",
+                "summary": "Приглашение класса",
                 "parameters": [
                     {
-                        "description": "Данные для входа",
+                        "description": "Класс, число регистраций и срок действия",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/common.LoginRequest"
+                            "$ref": "#/definitions/common.InvitationRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Пользователь успешно аутентифицирован",
+                    "201": {
+                        "description": "Приглашение создано",
                         "schema": {
-                            "$ref": "#/definitions/api.AccessTokenResponse"
+                            "$ref": "#/definitions/api.InvitationResponse"
                         }
                     },
                     "400": {
@@ -56,9 +105,15 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Логин/пароль некорректен",
+                        "description": "Пользователь не аутентифицирован",
                         "schema": {
-                            "$ref": "#/definitions/api.InvalidCredentialsErrorResponse"
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
                         }
                     },
                     "500": {
@@ -70,41 +125,130 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/logout": {
-            "post": {
-                "description": "Инвалидирует refresh токен в cookie",
+        "/api/admin/invitations/{code}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаляет приглашение; зарегистрироваться по нему больше нельзя. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "auth"
+                    "admin"
+                ],
+                "summary": "Отзыв приглашения",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Код приглашения",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Выход из системы",
                 "responses": {
                     "204": {
-                        "description": "Успешный выход, тело ответа отсутствует"
+                        "description": "Приглашение отозвано"
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Приглашение не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvitationNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/auth/refresh": {
+        "/api/admin/users": {
             "get": {
-                "description": "Проверяет refresh токен, установленный в cookie, и возврашает в теле ответа новый access токен",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает страницу пользователей по возрастанию id. Поиск идет по части логина, имени или фамилии без учета регистра. Доступно администраторам.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
+                ],
+                "summary": "Список пользователей",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Часть логина, имени или фамилии",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "enum": [
+                            "admin",
+                            "employee",
+                            "student"
+                        ],
+                        "description": "Роль",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер страницы, с 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, до 100",
+                        "name": "per_page",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Обновление access токена",
                 "responses": {
                     "200": {
-                        "description": "access токен успешно обновлен",
+                        "description": "Пользователи",
                         "schema": {
-                            "$ref": "#/definitions/api.AccessTokenResponse"
+                            "$ref": "#/definitions/api.UserListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Refresh токен не установлен или некорректен",
+                        "description": "Пользователь не аутентифицирован",
                         "schema": {
-                            "$ref": "#/definitions/api.RefreshTokenErrorResponse"
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
                         }
                     },
                     "500": {
@@ -114,11 +258,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/auth/register": {
+            },
             "post": {
-                "description": "Создает нового пользователя, устанавливает refresh токен в cookie и возвращает access токен в теле ответа.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает учетную запись без пароля и возвращает одноразовую ссылку, по которой владелец задает пароль. Доступно администраторам.",
                 "consumes": [
                     "application/json"
                 ],
@@ -126,25 +273,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Регистрация пользователя",
+                "summary": "Создание учетной записи",
                 "parameters": [
                     {
-                        "description": "Данные для регистрации",
+                        "description": "Логин, имя, фамилия, почта и роль",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/common.RegisterRequest"
+                            "$ref": "#/definitions/common.CreateAccountRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Пользователь успешно зарегистрирован",
+                        "description": "Учетная запись создана",
                         "schema": {
-                            "$ref": "#/definitions/api.AccessTokenResponse"
+                            "$ref": "#/definitions/api.AccountSetupResponse"
                         }
                     },
                     "400": {
@@ -153,6 +300,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ValidationErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
                     "409": {
                         "description": "Пользователь с таким логином уже существует",
                         "schema": {
@@ -167,69 +326,5662 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "api.AccessTokenResponse": {
-            "type": "object",
-            "properties": {
-                "access_token": {
-                    "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
-                }
-            }
-        },
-        "api.InternalServerErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "internal server error"
-                }
-            }
-        },
-        "api.InvalidCredentialsErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "invalid credentials"
-                }
-            }
-        },
-        "api.InvalidRequestErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "invalid request"
-                }
-            }
         },
-        "api.LoginInUseErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "login already in use"
+        "/api/admin/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает пользователя по id. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Пользователь",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователь",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
                 }
-            }
-        },
-        "api.RefreshTokenErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "refresh token error"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаляет учетную запись вместе с ее сессиями. Себя удалить нельзя. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Удаление пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Пользователь удален"
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Нельзя менять свою учетную запись",
+                        "schema": {
+                            "$ref": "#/definitions/api.OwnAccountErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "api.ValidationErrorResponse": {
+        "/api/admin/users/{id}/block": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Блокирует пользователя и отзывает все его refresh token; войти он больше не может. Себя заблокировать нельзя. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Блокировка пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователь заблокирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Нельзя менять свою учетную запись",
+                        "schema": {
+                            "$ref": "#/definitions/api.OwnAccountErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/users/{id}/reset-password": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Стирает пароль пользователя, завершает все его сессии и возвращает одноразовую ссылку для нового пароля. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Сброс пароля",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пароль сброшен",
+                        "schema": {
+                            "$ref": "#/definitions/api.AccountSetupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/users/{id}/role": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Меняет роль пользователя и завершает все его сессии; уже выданные access token действуют до истечения срока. Свою роль менять нельзя. Доступно администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Смена роли",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новая роль",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.ChangeRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Роль изменена",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Нельзя менять свою учетную запись",
+                        "schema": {
+                            "$ref": "#/definitions/api.OwnAccountErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/users/{id}/sessions": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Завершает все сессии указанного пользователя. Доступно администратору.",
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Завершение сессий пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Сессии пользователя завершены"
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/users/{id}/unblock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Снимает блокировку с пользователя. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Разблокировка пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователь разблокирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Нельзя менять свою учетную запись",
+                        "schema": {
+                            "$ref": "#/definitions/api.OwnAccountErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/users/{id}/unlock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Снимает временную блокировку, наложенную после неудачных попыток входа, и обнуляет их счетчик. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Снятие блокировки входа",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Блокировка снята"
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/forgot-password": {
+            "post": {
+                "description": "Отправляет на почту пользователя одноразовую ссылку для нового пароля; пароль задается через /api/auth/set-password. Ответ одинаков для любых логинов, чтобы по нему нельзя было узнать, какие учетные записи существуют.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Сброс забытого пароля",
+                "parameters": [
+                    {
+                        "description": "Логин или почта",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.ForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Если учетная запись с почтой существует, ссылка отправлена"
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Превышен лимит запросов",
+                        "schema": {
+                            "$ref": "#/definitions/api.TooManyRequestsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/login": {
+            "post": {
+                "description": "Аутентифицирует существующего пользователя, устанавливает refresh токен в cookie и возвращает access токен в теле ответа.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Аутентификация пользователя",
+                "parameters": [
+                    {
+                        "description": "Данные для входа",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователь успешно аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.AccessTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Логин/пароль некорректен",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidCredentialsErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь заблокирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserBlockedErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Учетная запись временно заблокирована после неудачных попыток входа",
+                        "schema": {
+                            "$ref": "#/definitions/api.AccountLockedErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Слишком много попыток входа, нужно подождать",
+                        "schema": {
+                            "$ref": "#/definitions/api.TooManyAttemptsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/logout": {
+            "post": {
+                "description": "Инвалидирует refresh токен в cookie",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Выход из системы",
+                "responses": {
+                    "204": {
+                        "description": "Успешный выход, тело ответа отсутствует"
+                    }
+                }
+            }
+        },
+        "/api/auth/refresh": {
+            "get": {
+                "description": "Проверяет refresh токен, установленный в cookie, и возврашает в теле ответа новый access токен",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Обновление access токена",
+                "responses": {
+                    "200": {
+                        "description": "access токен успешно обновлен",
+                        "schema": {
+                            "$ref": "#/definitions/api.AccessTokenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Refresh токен не установлен, некорректен или использован повторно; при повторном использовании все сессии цепочки завершаются",
+                        "schema": {
+                            "$ref": "#/definitions/api.RefreshTokenErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь заблокирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserBlockedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/register": {
+            "post": {
+                "description": "Создает ученика по коду приглашения класса, устанавливает refresh токен в cookie и возвращает access токен в теле ответа. Сотрудников и администраторов создает администратор.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Регистрация пользователя",
+                "parameters": [
+                    {
+                        "description": "Данные для регистрации",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Пользователь успешно зарегистрирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.AccessTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Код приглашения неизвестен, истек или исчерпан",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvitationInvalidErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Пользователь с таким логином уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/api.LoginInUseErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает устройства, на которых выполнен вход в учетную запись. Сессия запроса, если refresh токен передан в cookie, отмечена current.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Активные сессии",
+                "responses": {
+                    "200": {
+                        "description": "Список сессий",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.SessionResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Завершает все сессии пользователя, включая текущую, и удаляет refresh токен из cookie.",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Выход на всех устройствах",
+                "responses": {
+                    "204": {
+                        "description": "Все сессии завершены"
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/sessions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Выходит из учетной записи на одном устройстве. Выданные ему access токены действуют до истечения срока.",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Завершение сессии",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Сессия завершена"
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Сессия не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/api.SessionNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/set-password": {
+            "post": {
+                "description": "Задает пароль по одноразовому токену из ссылки, выданной администратором или присланной на почту при сбросе пароля. Все сессии пользователя завершаются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Установка пароля",
+                "parameters": [
+                    {
+                        "description": "Токен из ссылки и новый пароль",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.SetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Пароль установлен"
+                    },
+                    "400": {
+                        "description": "Токен неизвестен, истек или уже использован",
+                        "schema": {
+                            "$ref": "#/definitions/api.PasswordTokenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/inventory/low-stock": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает продукты, остаток которых не превышает минимальный. Доступно сотрудникам столовой и администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Заканчивающиеся продукты",
+                "responses": {
+                    "200": {
+                        "description": "Список продуктов",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ProductResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/inventory/products": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает все продукты на складе с текущими остатками. Доступно сотрудникам столовой и администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Склад",
+                "responses": {
+                    "200": {
+                        "description": "Список продуктов",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ProductResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавляет продукт на склад с нулевым остатком. Остаток пополняется движением receipt. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Добавление продукта",
+                "parameters": [
+                    {
+                        "description": "Название, единица измерения и минимальный остаток",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.ProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Продукт добавлен",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Продукт с таким названием уже есть",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProductExistsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/inventory/products/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает продукт и его текущий остаток. Доступно сотрудникам столовой и администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Продукт",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор продукта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Продукт",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Продукт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProductNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Изменяет название, единицу измерения или минимальный остаток продукта. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Редактирование продукта",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор продукта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Название, единица измерения и минимальный остаток",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.ProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Продукт обновлен",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Продукт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProductNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Продукт с таким названием уже есть",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProductExistsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/inventory/products/{id}/movements": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает историю поступлений, списаний и расхода продукта. Доступно сотрудникам столовой и администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Движения продукта",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор продукта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список движений",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.MovementResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Продукт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProductNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Проводит поступление (receipt), списание (write_off) или расход на блюдо (consumption). Количество указывается положительным числом. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Движение продукта",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор продукта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Вид движения, количество и блюдо для расхода",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.MovementRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Движение проведено",
+                        "schema": {
+                            "$ref": "#/definitions/api.MovementResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Блюдо не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/api.DishNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Недостаточно продукта на складе",
+                        "schema": {
+                            "$ref": "#/definitions/api.InsufficientStockErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/menu": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает меню завтрака и обеда на указанную дату (по умолчанию — на сегодня) со средней оценкой блюд. Для учеников блюда с аллергенами из профиля питания помечаются полем conflicts или скрываются.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu"
+                ],
+                "summary": "Меню на день",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Дата в формате YYYY-MM-DD",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Скрыть блюда с аллергенами из профиля питания",
+                        "name": "hide_conflicting",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Меню на день",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.MenuResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает меню завтрака или обеда на указанную дату. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu"
+                ],
+                "summary": "Создание меню",
+                "parameters": [
+                    {
+                        "description": "Дата, тип приема пищи и блюда",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.MenuRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Меню создано",
+                        "schema": {
+                            "$ref": "#/definitions/api.MenuResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Блюдо не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/api.DishNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Меню на эту дату уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/api.MenuExistsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/menu/dishes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает все блюда, из которых составляется меню, со средней оценкой по отзывам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu"
+                ],
+                "summary": "Список блюд",
+                "responses": {
+                    "200": {
+                        "description": "Список блюд",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.DishResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавляет блюдо, которое затем можно включить в меню. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu"
+                ],
+                "summary": "Создание блюда",
+                "parameters": [
+                    {
+                        "description": "Данные блюда",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.DishRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Блюдо создано",
+                        "schema": {
+                            "$ref": "#/definitions/api.DishResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/menu/dishes/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Изменяет название, описание, цену, вес порции или аллергены блюда. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu"
+                ],
+                "summary": "Редактирование блюда",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор блюда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные блюда",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.DishRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Блюдо обновлено",
+                        "schema": {
+                            "$ref": "#/definitions/api.DishResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Блюдо не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/api.DishNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/menu/dishes/{id}/recipe": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает продукты со склада, которые расходуются на одну порцию блюда. Доступно сотрудникам столовой и администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu"
+                ],
+                "summary": "Рецептура блюда",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор блюда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Рецептура",
+                        "schema": {
+                            "$ref": "#/definitions/api.RecipeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Блюдо не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/api.DishNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Заменяет продукты, которые расходуются на одну порцию блюда. При выдаче заказа они списываются со склада. Пустой список удаляет рецептуру. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu"
+                ],
+                "summary": "Изменение рецептуры блюда",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор блюда",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Продукты на порцию",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.RecipeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Рецептура обновлена",
+                        "schema": {
+                            "$ref": "#/definitions/api.RecipeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Продукт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProductNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/menu/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает меню завтрака или обеда по его идентификатору со средней оценкой блюд. Для учеников блюда с аллергенами из профиля питания помечаются полем conflicts или скрываются.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu"
+                ],
+                "summary": "Меню по идентификатору",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор меню",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Скрыть блюда с аллергенами из профиля питания",
+                        "name": "hide_conflicting",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Меню",
+                        "schema": {
+                            "$ref": "#/definitions/api.MenuResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Меню не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/api.MenuNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Заменяет список блюд в меню. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu"
+                ],
+                "summary": "Редактирование меню",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор меню",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Блюда",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.UpdateMenuRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Меню обновлено",
+                        "schema": {
+                            "$ref": "#/definitions/api.MenuResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Меню или блюдо не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/api.MenuNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/menu/{id}/coverage": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Сравнивает запланированные порции блюд меню с остатками на складе. Поле warnings перечисляет блюда и продукты, которых не хватает. Доступно сотрудникам столовой и администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu"
+                ],
+                "summary": "Обеспеченность меню продуктами",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор меню",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Обеспеченность меню",
+                        "schema": {
+                            "$ref": "#/definitions/api.CoverageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Меню не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/api.MenuNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/menu/{id}/portions": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Задает количество порций блюд меню, которое планируется приготовить, и возвращает обеспеченность плана продуктами. Блюда, не указанные в запросе, не планируются. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menu"
+                ],
+                "summary": "План порций",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор меню",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Порции по блюдам",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.PortionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "План сохранен",
+                        "schema": {
+                            "$ref": "#/definitions/api.CoverageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Блюда нет в меню",
+                        "schema": {
+                            "$ref": "#/definitions/api.DishNotInMenuErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Меню не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/api.MenuNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает все заказы текущего ученика.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Заказы ученика",
+                "responses": {
+                    "200": {
+                        "description": "Список заказов",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.OrderResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает заказ ученика на блюда из меню. Заказ создается в статусе placed. Если блюда содержат аллергены из профиля питания ученика, заказ нужно подтвердить флагом confirm_allergens.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Оформление заказа",
+                "parameters": [
+                    {
+                        "description": "Меню и выбранные блюда",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.OrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Заказ создан",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Блюда нет в меню",
+                        "schema": {
+                            "$ref": "#/definitions/api.DishNotInMenuErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Меню не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/api.MenuNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Заказ содержит аллергены из профиля питания и не подтвержден",
+                        "schema": {
+                            "$ref": "#/definitions/api.AllergenConflictErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/orders/queue": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает оплаченные и приготовленные заказы на указанную дату (по умолчанию — на сегодня). Доступно сотрудникам столовой и администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Очередь заказов",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Дата в формате YYYY-MM-DD",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Очередь заказов",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.OrderResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/orders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает заказ текущего ученика по идентификатору.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Заказ ученика",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заказа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заказ",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/orders/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Отменяет заказ текущего ученика, если он еще не приготовлен. Оплаченный заказ возвращается на баланс.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Отмена заказа",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заказа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заказ отменен",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Заказ нельзя отменить в текущем статусе",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderStatusErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/orders/{id}/issue": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Переводит приготовленный заказ в статус issued. Доступно сотрудникам столовой и администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Заказ выдан",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заказа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заказ выдан",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Недопустимая смена статуса",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderStatusErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/orders/{id}/pay": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Переводит заказ текущего ученика в статус paid. Если прием пищи покрыт действующим абонементом, заказ оплачивается абонементом, иначе стоимость списывается с баланса.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Оплата заказа",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заказа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заказ оплачен",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Недостаточно средств на балансе",
+                        "schema": {
+                            "$ref": "#/definitions/api.InsufficientFundsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/orders/{id}/prepare": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Переводит оплаченный заказ в статус prepared. Доступно сотрудникам столовой и администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Заказ приготовлен",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заказа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заказ приготовлен",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Недопустимая смена статуса",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderStatusErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/payments/top-up": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает платеж на пополнение баланса текущего ученика и возвращает адрес страницы оплаты. Баланс пополняется только после подтверждения платежа через webhook.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Пополнение баланса картой",
+                "parameters": [
+                    {
+                        "description": "Сумма пополнения в копейках",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.TopUpRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Платеж создан",
+                        "schema": {
+                            "$ref": "#/definitions/api.TopUpResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/payments/webhook": {
+            "post": {
+                "description": "Принимает уведомление о результате платежа. Тело подписывается HMAC-SHA256, подпись передается в заголовке X-Signature. Повторные уведомления обрабатываются идемпотентно.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Уведомление платежного шлюза",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "HMAC-SHA256 тела запроса в hex",
+                        "name": "X-Signature",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Уведомление обработано",
+                        "schema": {
+                            "$ref": "#/definitions/api.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректное уведомление",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidWebhookErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверная подпись",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidSignatureErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Платеж не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.PaymentNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/payments/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает платеж текущего ученика по идентификатору.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Статус платежа",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор платежа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Платеж",
+                        "schema": {
+                            "$ref": "#/definitions/api.PaymentResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Платеж не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.PaymentNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/procurement/requests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает заявки на закупку продуктов. Параметр status можно передать несколько раз. Доступно сотрудникам столовой и администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "procurement"
+                ],
+                "summary": "Заявки на закупку",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Статусы заявок (draft, submitted, approved, rejected, fulfilled)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список заявок",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.PurchaseRequestResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает черновик заявки на закупку продуктов со склада. Позиции одного продукта объединяются. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "procurement"
+                ],
+                "summary": "Создание заявки на закупку",
+                "parameters": [
+                    {
+                        "description": "Позиции заявки и комментарий",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.PurchaseRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Черновик создан",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Продукт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProductNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/procurement/requests/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает заявку на закупку с позициями и комментариями. Доступно сотрудникам столовой и администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "procurement"
+                ],
+                "summary": "Заявка на закупку",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заявки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заявка",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заявка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/procurement/requests/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Одобряет отправленную заявку на закупку. Доступно только администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "procurement"
+                ],
+                "summary": "Согласование заявки",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заявки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Комментарий",
+                        "name": "input",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/common.CommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заявка одобрена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заявка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Недопустимая смена статуса",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseStatusErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/procurement/requests/{id}/comments": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавляет комментарий к заявке на закупку. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "procurement"
+                ],
+                "summary": "Комментарий к заявке",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заявки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Комментарий",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.CommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Комментарий добавлен",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заявка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/procurement/requests/{id}/fulfill": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Отмечает одобренную заявку как исполненную и проводит поступление всех позиций на склад. Доступно сотрудникам столовой и администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "procurement"
+                ],
+                "summary": "Исполнение заявки",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заявки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Комментарий",
+                        "name": "input",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/common.CommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заявка исполнена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заявка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Заявка не одобрена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseStatusErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/procurement/requests/{id}/items": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Заменяет позиции черновика заявки. Изменить заявку может только ее автор до отправки на согласование.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "procurement"
+                ],
+                "summary": "Редактирование заявки на закупку",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заявки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Позиции заявки",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.PurchaseItemsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заявка обновлена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Заявку может изменить только автор",
+                        "schema": {
+                            "$ref": "#/definitions/api.NotRequestAuthorErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Продукт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.ProductNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Заявка уже отправлена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseStatusErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/procurement/requests/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Отклоняет отправленную заявку на закупку. Доступно только администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "procurement"
+                ],
+                "summary": "Отклонение заявки",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заявки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Причина отклонения",
+                        "name": "input",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/common.CommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заявка отклонена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заявка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Недопустимая смена статуса",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseStatusErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/procurement/requests/{id}/submit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Переводит черновик заявки в статус submitted. Отправить заявку может только ее автор.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "procurement"
+                ],
+                "summary": "Отправка заявки на согласование",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор заявки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заявка отправлена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Заявку может отправить только автор",
+                        "schema": {
+                            "$ref": "#/definitions/api.NotRequestAuthorErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заявка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseRequestNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Недопустимая смена статуса",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseStatusErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/profile/diet": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает аллергены и ограничения в питании текущего ученика.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Профиль питания",
+                "responses": {
+                    "200": {
+                        "description": "Профиль питания",
+                        "schema": {
+                            "$ref": "#/definitions/api.DietaryProfileResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Заменяет список аллергенов и ограничения в питании текущего ученика.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Изменение профиля питания",
+                "parameters": [
+                    {
+                        "description": "Аллергены и ограничения",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.DietaryProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Профиль питания обновлен",
+                        "schema": {
+                            "$ref": "#/definitions/api.DietaryProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неизвестный аллерген",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnknownAllergenErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reviews": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает отзывы о блюде, кроме скрытых модератором.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Отзывы о блюде",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор блюда",
+                        "name": "dish_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список отзывов",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ReviewResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ставит оценку от 1 до 5 и оставляет комментарий к блюду из выданного заказа текущего ученика. Каждое блюдо заказа можно оценить один раз.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Отзыв о блюде",
+                "parameters": [
+                    {
+                        "description": "Заказ, блюдо, оценка и комментарий",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.ReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Отзыв создан",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Данные невалидны",
+                        "schema": {
+                            "$ref": "#/definitions/api.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Заказ еще не выдан или не содержит блюдо",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReviewNotAllowedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.OrderNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Блюдо из этого заказа уже оценено",
+                        "schema": {
+                            "$ref": "#/definitions/api.AlreadyReviewedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reviews/moderation": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает все отзывы, включая скрытые. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Модерация отзывов",
+                "responses": {
+                    "200": {
+                        "description": "Список отзывов",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ReviewResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reviews/{id}/hide": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Скрывает комментарий отзыва от других пользователей. Оценка продолжает учитываться в рейтинге блюда. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Скрытие отзыва",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Отзыв скрыт",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Отзыв не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReviewNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reviews/{id}/unhide": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Снова показывает скрытый отзыв. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Восстановление отзыва",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Отзыв восстановлен",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Отзыв не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReviewNotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/subscriptions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает все купленные абонементы текущего ученика с количеством оставшихся приемов пищи.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Абонементы ученика",
+                "responses": {
+                    "200": {
+                        "description": "Список абонементов",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.SubscriptionResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/subscriptions/plans": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает все абонементы, доступные для покупки.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Абонементы",
+                "responses": {
+                    "200": {
+                        "description": "Список абонементов",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.PlanResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает абонемент на завтраки или обеды за период. Доступно администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Создание абонемента",
+                "parameters": [
+                    {
+                        "description": "Параметры абонемента",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.PlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Абонемент создан",
+                        "schema": {
+                            "$ref": "#/definitions/api.PlanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный период или цена",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidPlanErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/subscriptions/plans/{id}/purchase": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Списывает стоимость абонемента с баланса текущего ученика. Абонемент покрывает оставшиеся дни периода.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Покупка абонемента",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор абонемента",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Абонемент куплен",
+                        "schema": {
+                            "$ref": "#/definitions/api.SubscriptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Абонемент не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.PlanNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Недостаточно средств на балансе",
+                        "schema": {
+                            "$ref": "#/definitions/api.InsufficientFundsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/wallet": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает баланс текущего ученика и историю операций по его счету.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallet"
+                ],
+                "summary": "Баланс ученика",
+                "responses": {
+                    "200": {
+                        "description": "Баланс и история операций",
+                        "schema": {
+                            "$ref": "#/definitions/api.WalletResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/wallet/students/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает баланс и историю операций указанного ученика. Доступно администраторам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallet"
+                ],
+                "summary": "Баланс ученика (администратор)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор ученика",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Баланс и история операций",
+                        "schema": {
+                            "$ref": "#/definitions/api.WalletResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/wallet/students/{id}/entries": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавляет в историю операций ученика пополнение (top_up) или корректировку (correction). Доступно администраторам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallet"
+                ],
+                "summary": "Корректировка баланса",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор ученика",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Тип операции, сумма и комментарий",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/common.WalletEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Операция добавлена",
+                        "schema": {
+                            "$ref": "#/definitions/api.WalletEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректная сумма",
+                        "schema": {
+                            "$ref": "#/definitions/api.InvalidAmountErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не аутентифицирован",
+                        "schema": {
+                            "$ref": "#/definitions/api.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/api.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Ученик не найден",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserNotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Баланс ученика стал бы отрицательным",
+                        "schema": {
+                            "$ref": "#/definitions/api.InsufficientFundsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/api.InternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api.AccessTokenResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                }
+            }
+        },
+        "api.AccountLockedErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "account temporarily locked"
+                }
+            }
+        },
+        "api.AccountSetupResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "set_password_url": {
+                    "type": "string",
+                    "example": "http://localhost:8080/set-password?token=..."
+                }
+            }
+        },
+        "api.AllergenConflictErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "order contains allergens from dietary profile, confirmation required"
+                }
+            }
+        },
+        "api.AlreadyReviewedErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "dish already reviewed for this order"
+                }
+            }
+        },
+        "api.CoverageResponse": {
+            "type": "object",
+            "properties": {
+                "covered": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "dishes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.DishCoverageResponse"
+                    }
+                },
+                "menu_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "shortages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ShortageResponse"
+                    }
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Борщ: запланировано 120 порций",
+                        " продуктов хватит на 80"
+                    ]
+                }
+            }
+        },
+        "api.DietaryProfileResponse": {
+            "type": "object",
+            "properties": {
+                "allergens": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "nuts",
+                        "lactose"
+                    ]
+                },
+                "restrictions": {
+                    "type": "string",
+                    "example": "Вегетарианец"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.DishCoverageResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "Available is the number of portions the stock is enough for; it is\nnull for dishes without a recipe.",
+                    "type": "integer",
+                    "example": 80
+                },
+                "covered": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "dish_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Борщ"
+                },
+                "planned": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "api.DishNotFoundErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "dish not found"
+                }
+            }
+        },
+        "api.DishNotInMenuErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "dish is not in menu"
+                }
+            }
+        },
+        "api.DishResponse": {
+            "type": "object",
+            "properties": {
+                "allergens": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "gluten",
+                        "lactose"
+                    ]
+                },
+                "conflicts": {
+                    "description": "Conflicts lists the dish allergens from the dietary profile of the\ncurrent student.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "lactose"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Борщ со сметаной"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Борщ"
+                },
+                "planned_portions": {
+                    "description": "PlannedPortions is the number of portions the kitchen plans to cook\nfor the menu.",
+                    "type": "integer",
+                    "example": 120
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 12000
+                },
+                "rating": {
+                    "description": "Rating is omitted for dishes without reviews.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.RatingResponse"
+                        }
+                    ]
+                },
+                "weight": {
+                    "type": "integer",
+                    "example": 250
+                }
+            }
+        },
+        "api.ForbiddenErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "forbidden"
+                }
+            }
+        },
+        "api.IngredientResponse": {
+            "type": "object",
+            "properties": {
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "number",
+                    "example": 0.08
+                }
+            }
+        },
+        "api.InsufficientFundsErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "insufficient funds"
+                }
+            }
+        },
+        "api.InsufficientStockErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "insufficient stock"
+                }
+            }
+        },
+        "api.InternalServerErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "internal server error"
+                }
+            }
+        },
+        "api.InvalidAmountErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid amount"
+                }
+            }
+        },
+        "api.InvalidCredentialsErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid credentials"
+                }
+            }
+        },
+        "api.InvalidPlanErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid subscription plan"
+                }
+            }
+        },
+        "api.InvalidRequestErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid request"
+                }
+            }
+        },
+        "api.InvalidSignatureErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid webhook signature"
+                }
+            }
+        },
+        "api.InvalidWebhookErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid webhook payload"
+                }
+            }
+        },
+        "api.InvitationInvalidErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid invitation code"
+                }
+            }
+        },
+        "api.InvitationNotFoundErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invitation not found"
+                }
+            }
+        },
+        "api.InvitationResponse": {
+            "type": "object",
+            "properties": {
+                "class": {
+                    "type": "string",
+                    "example": "7Б"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "K7QX2MPA"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "max_uses": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "uses": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "api.LoginInUseErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "login already in use"
+                }
+            }
+        },
+        "api.MenuClosedErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "menu is closed for orders"
+                }
+            }
+        },
+        "api.MenuExistsErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "menu already exists"
+                }
+            }
+        },
+        "api.MenuNotFoundErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "menu not found"
+                }
+            }
+        },
+        "api.MenuResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2026-10-19"
+                },
+                "dishes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.DishResponse"
+                    }
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "meal_type": {
+                    "type": "string",
+                    "example": "lunch"
+                }
+            }
+        },
+        "api.MovementResponse": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "comment": {
+                    "type": "string",
+                    "example": "Поставка от 19.10"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "dish_id": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "receipt"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "number",
+                    "example": 25.5
+                }
+            }
+        },
+        "api.NotRequestAuthorErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "only the author can change a purchase request"
+                }
+            }
+        },
+        "api.OrderItemResponse": {
+            "type": "object",
+            "properties": {
+                "dish_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Борщ"
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 12000
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "api.OrderNotFoundErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "order not found"
+                }
+            }
+        },
+        "api.OrderResponse": {
+            "type": "object",
+            "properties": {
+                "confirmed_allergens": {
+                    "description": "ConfirmedAllergens lists the allergens the student accepted when\nplacing the order.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "lactose"
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2026-10-19"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.OrderItemResponse"
+                    }
+                },
+                "meal_type": {
+                    "type": "string",
+                    "example": "lunch"
+                },
+                "menu_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "status": {
+                    "type": "string",
+                    "example": "placed"
+                },
+                "student_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "subscription_id": {
+                    "description": "SubscriptionID is set when the order was paid by a subscription.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 12000
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.OrderStatusErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid order status transition"
+                }
+            }
+        },
+        "api.OwnAccountErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "cannot block, demote or delete your own account"
+                }
+            }
+        },
+        "api.PasswordTokenErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid or expired set-password token"
+                }
+            }
+        },
+        "api.PaymentNotFoundErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "payment not found"
+                }
+            }
+        },
+        "api.PaymentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 50000
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "0f8fad5b-d9cb-469f-a165-70867728950e"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.PlanExpiredErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "subscription plan has expired"
+                }
+            }
+        },
+        "api.PlanNotFoundErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "subscription plan not found"
+                }
+            }
+        },
+        "api.PlanResponse": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "example": "2026-10-31"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "meal_type": {
+                    "type": "string",
+                    "example": "lunch"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Обеды, будни, октябрь"
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 250000
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2026-10-01"
+                },
+                "weekdays_only": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "api.ProductExistsErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "product already exists"
+                }
+            }
+        },
+        "api.ProductNotFoundErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "product not found"
+                }
+            }
+        },
+        "api.ProductResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "low": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "min_quantity": {
+                    "type": "number",
+                    "example": 10
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Мука пшеничная"
+                },
+                "quantity": {
+                    "type": "number",
+                    "example": 42.5
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "kg"
+                }
+            }
+        },
+        "api.PurchaseCommentResponse": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string",
+                    "example": "Согласовано с бухгалтерией"
+                }
+            }
+        },
+        "api.PurchaseItemResponse": {
+            "type": "object",
+            "properties": {
+                "estimated_cost": {
+                    "type": "integer",
+                    "example": 250000
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Мука пшеничная"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "number",
+                    "example": 50
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "kg"
+                }
+            }
+        },
+        "api.PurchaseRequestNotFoundErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "purchase request not found"
+                }
+            }
+        },
+        "api.PurchaseRequestResponse": {
+            "type": "object",
+            "properties": {
+                "approver_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "author_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.PurchaseCommentResponse"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "estimated_cost": {
+                    "type": "integer",
+                    "example": 250000
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.PurchaseItemResponse"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "submitted"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.PurchaseStatusErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid purchase request status transition"
+                }
+            }
+        },
+        "api.RatingResponse": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number",
+                    "example": 4.5
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "api.RecipeResponse": {
+            "type": "object",
+            "properties": {
+                "dish_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "ingredients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.IngredientResponse"
+                    }
+                }
+            }
+        },
+        "api.RefreshTokenErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "refresh token error"
+                }
+            }
+        },
+        "api.ReviewNotAllowedErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "only dishes from issued orders can be reviewed"
+                }
+            }
+        },
+        "api.ReviewNotFoundErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "review not found"
+                }
+            }
+        },
+        "api.ReviewResponse": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "Очень вкусно"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "dish_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "hidden": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "order_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rating": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "student_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "api.SessionNotFoundErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "session not found"
+                }
+            }
+        },
+        "api.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "current": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed"
+                },
+                "ip": {
+                    "type": "string",
+                    "example": "192.0.2.1"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0 (X11; Linux x86_64)"
+                }
+            }
+        },
+        "api.ShortageResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "number",
+                    "example": 6.4
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Свекла"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "required": {
+                    "type": "number",
+                    "example": 9.6
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "kg"
+                }
+            }
+        },
+        "api.SubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "plan": {
+                    "$ref": "#/definitions/api.PlanResponse"
+                },
+                "purchased_at": {
+                    "type": "string"
+                },
+                "remaining_meals": {
+                    "type": "integer",
+                    "example": 22
+                }
+            }
+        },
+        "api.TooManyAttemptsErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "too many sign-in attempts, try again later"
+                }
+            }
+        },
+        "api.TooManyRequestsErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "too many requests"
+                }
+            }
+        },
+        "api.TopUpResponse": {
+            "type": "object",
+            "properties": {
+                "payment": {
+                    "$ref": "#/definitions/api.PaymentResponse"
+                },
+                "redirect_url": {
+                    "type": "string",
+                    "example": "/fake-gateway/checkout/0f8fad5b-d9cb-469f-a165-70867728950e"
+                }
+            }
+        },
+        "api.UnauthorizedErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid token"
+                }
+            }
+        },
+        "api.UnknownAllergenErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "unknown allergen"
+                }
+            }
+        },
+        "api.UserBlockedErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "user blocked"
+                }
+            }
+        },
+        "api.UserListResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "per_page": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.UserResponse"
+                    }
+                }
+            }
+        },
+        "api.UserNotFoundErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "user not found"
+                }
+            }
+        },
+        "api.UserResponse": {
+            "type": "object",
+            "properties": {
+                "blocked": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "email": {
+                    "type": "string",
+                    "example": "maria@example.com"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "login": {
+                    "type": "string",
+                    "example": "cook"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Maria"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "employee"
+                },
+                "surname": {
+                    "type": "string",
+                    "example": "Ivanova"
+                }
+            }
+        },
+        "api.ValidationErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "validation error"
+                }
+            }
+        },
+        "api.WalletEntryResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": -12000
+                },
+                "author_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "comment": {
+                    "type": "string",
+                    "example": ""
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "charge"
+                },
+                "order_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "payment_id": {
+                    "type": "string",
+                    "example": ""
+                }
+            }
+        },
+        "api.WalletResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer",
+                    "example": 50000
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.WalletEntryResponse"
+                    }
+                },
+                "student_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "api.WebhookResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "ok"
+                }
+            }
+        },
+        "common.ChangeRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "employee",
+                        "student"
+                    ],
+                    "example": "employee"
+                }
+            }
+        },
+        "common.CommentRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Согласовано с бухгалтерией"
+                }
+            }
+        },
+        "common.CreateAccountRequest": {
+            "type": "object",
+            "required": [
+                "login",
+                "name",
+                "role",
+                "surname"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 254,
+                    "example": "maria@example.com"
+                },
+                "login": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2,
+                    "example": "cook_maria"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Maria"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "employee",
+                        "student"
+                    ],
+                    "example": "employee"
+                },
+                "surname": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Ivanova"
+                }
+            }
+        },
+        "common.DietaryProfileRequest": {
             "type": "object",
             "properties": {
-                "error": {
+                "allergens": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "nuts",
+                        "lactose"
+                    ]
+                },
+                "restrictions": {
                     "type": "string",
-                    "example": "validation error"
+                    "maxLength": 500,
+                    "example": "Вегетарианец"
+                }
+            }
+        },
+        "common.DishPortionsRequest": {
+            "type": "object",
+            "required": [
+                "dish_id"
+            ],
+            "properties": {
+                "dish_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "portions": {
+                    "type": "integer",
+                    "maximum": 100000,
+                    "minimum": 0,
+                    "example": 120
+                }
+            }
+        },
+        "common.DishRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "price",
+                "weight"
+            ],
+            "properties": {
+                "allergens": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "gluten",
+                        "lactose"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Борщ со сметаной"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Борщ"
+                },
+                "price": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 12000
+                },
+                "weight": {
+                    "type": "integer",
+                    "maximum": 5000,
+                    "minimum": 1,
+                    "example": 250
+                }
+            }
+        },
+        "common.ForgotPasswordRequest": {
+            "type": "object",
+            "required": [
+                "login"
+            ],
+            "properties": {
+                "login": {
+                    "type": "string",
+                    "maxLength": 254,
+                    "example": "the_real_slim_shady"
+                }
+            }
+        },
+        "common.IngredientRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "quantity"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "number",
+                    "example": 0.08
+                }
+            }
+        },
+        "common.InvitationRequest": {
+            "type": "object",
+            "required": [
+                "class",
+                "max_uses",
+                "valid_days"
+            ],
+            "properties": {
+                "class": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "7Б"
+                },
+                "max_uses": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1,
+                    "example": 30
+                },
+                "valid_days": {
+                    "type": "integer",
+                    "maximum": 90,
+                    "minimum": 1,
+                    "example": 14
                 }
             }
         },
@@ -252,16 +6004,300 @@ const docTemplate = `{
                 }
             }
         },
+        "common.MenuRequest": {
+            "type": "object",
+            "required": [
+                "date",
+                "dish_ids",
+                "meal_type"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2026-10-19"
+                },
+                "dish_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2
+                    ]
+                },
+                "meal_type": {
+                    "type": "string",
+                    "enum": [
+                        "breakfast",
+                        "lunch"
+                    ],
+                    "example": "lunch"
+                }
+            }
+        },
+        "common.MovementRequest": {
+            "type": "object",
+            "required": [
+                "kind",
+                "quantity"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Поставка от 19.10"
+                },
+                "dish_id": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "receipt",
+                        "write_off",
+                        "consumption"
+                    ],
+                    "example": "receipt"
+                },
+                "quantity": {
+                    "type": "number",
+                    "example": 25.5
+                }
+            }
+        },
+        "common.OrderItemRequest": {
+            "type": "object",
+            "required": [
+                "dish_id",
+                "quantity"
+            ],
+            "properties": {
+                "dish_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
+        "common.OrderRequest": {
+            "type": "object",
+            "required": [
+                "items",
+                "menu_id"
+            ],
+            "properties": {
+                "confirm_allergens": {
+                    "description": "ConfirmAllergens must be set to order dishes that contain allergens\nfrom the student's dietary profile.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "items": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/common.OrderItemRequest"
+                    }
+                },
+                "menu_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
+        "common.PlanRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "meal_type",
+                "name",
+                "price",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "example": "2026-10-31"
+                },
+                "meal_type": {
+                    "type": "string",
+                    "enum": [
+                        "breakfast",
+                        "lunch"
+                    ],
+                    "example": "lunch"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Обеды, будни, октябрь"
+                },
+                "price": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 250000
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2026-10-01"
+                },
+                "weekdays_only": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "common.PortionsRequest": {
+            "type": "object",
+            "required": [
+                "dishes"
+            ],
+            "properties": {
+                "dishes": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "$ref": "#/definitions/common.DishPortionsRequest"
+                    }
+                }
+            }
+        },
+        "common.ProductRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "unit"
+            ],
+            "properties": {
+                "min_quantity": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 10
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Мука пшеничная"
+                },
+                "unit": {
+                    "type": "string",
+                    "enum": [
+                        "kg",
+                        "g",
+                        "l",
+                        "ml",
+                        "pcs"
+                    ],
+                    "example": "kg"
+                }
+            }
+        },
+        "common.PurchaseItemRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "quantity"
+            ],
+            "properties": {
+                "estimated_cost": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 250000
+                },
+                "product_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "number",
+                    "example": 50
+                }
+            }
+        },
+        "common.PurchaseItemsRequest": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/common.PurchaseItemRequest"
+                    }
+                }
+            }
+        },
+        "common.PurchaseRequestRequest": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Мука заканчивается, нужна поставка до пятницы"
+                },
+                "items": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/common.PurchaseItemRequest"
+                    }
+                }
+            }
+        },
+        "common.RecipeRequest": {
+            "type": "object",
+            "properties": {
+                "ingredients": {
+                    "description": "Ingredients per portion of the dish; an empty list removes the recipe.",
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "$ref": "#/definitions/common.IngredientRequest"
+                    }
+                }
+            }
+        },
         "common.RegisterRequest": {
             "type": "object",
             "required": [
+                "invite_code",
                 "login",
                 "name",
                 "password",
-                "role",
                 "surname"
             ],
             "properties": {
+                "email": {
+                    "description": "Email is optional; it is where password reset links are sent.",
+                    "type": "string",
+                    "maxLength": 254,
+                    "example": "slim@example.com"
+                },
+                "invite_code": {
+                    "description": "InviteCode is the class invitation a student registers with.",
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "K7QX2MPA"
+                },
                 "login": {
                     "type": "string",
                     "maxLength": 50,
@@ -279,21 +6315,132 @@ const docTemplate = `{
                     "minLength": 8,
                     "example": "password1234"
                 },
-                "role": {
-                    "type": "string",
-                    "enum": [
-                        "admin",
-                        "employee",
-                        "student"
-                    ],
-                    "example": "admin"
-                },
                 "surname": {
                     "type": "string",
                     "maxLength": 100,
                     "example": "Shady"
                 }
             }
+        },
+        "common.ReviewRequest": {
+            "type": "object",
+            "required": [
+                "dish_id",
+                "order_id",
+                "rating"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Очень вкусно"
+                },
+                "dish_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "order_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 5
+                }
+            }
+        },
+        "common.SetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 8,
+                    "example": "password1234"
+                },
+                "token": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "common.TopUpRequest": {
+            "type": "object",
+            "required": [
+                "amount"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "maximum": 1000000,
+                    "minimum": 100,
+                    "example": 50000
+                }
+            }
+        },
+        "common.UpdateMenuRequest": {
+            "type": "object",
+            "required": [
+                "dish_ids"
+            ],
+            "properties": {
+                "dish_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2
+                    ]
+                }
+            }
+        },
+        "common.WalletEntryRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "comment",
+                "kind"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "maximum": 10000000,
+                    "minimum": -10000000,
+                    "example": -5000
+                },
+                "comment": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Ошибочное списание"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "top_up",
+                        "correction"
+                    ],
+                    "example": "correction"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "Access токен в формате \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
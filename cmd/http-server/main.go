@@ -1,9 +1,15 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"canteen-app/internal/app"
+	"canteen-app/internal/config"
 )
 
 //	@title			CanteenApp API
@@ -12,14 +18,31 @@ import (
 //	@license.name	Apache 2.0
 //	@host			localhost:8080
 
+//	@securityDefinitions.apikey	BearerAuth
+//	@in							header
+//	@name						Authorization
+//	@description				Access токен в формате "Bearer <token>"
+
 func main() {
-	a, err := app.New()
+	configPath := flag.String("config", os.Getenv("CANTEEN_CONFIG"), "path to the YAML config file")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	log.Println("starting server on :8080")
-	if err := a.Run(":8080"); err != nil {
+	a, err := app.New(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("starting %s server", cfg.Env)
+	if err := a.Run(ctx); err != nil {
 		log.Fatal(err)
 	}
+	log.Println("server stopped")
 }
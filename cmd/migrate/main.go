@@ -0,0 +1,146 @@
+// Command migrate manages the database schema of the storage selected in the
+// application config.
+//
+//	migrate status        list migrations and whether they are applied
+//	migrate up            apply all pending migrations
+//	migrate down N        revert the last N applied migrations
+//	migrate create NAME   add an empty migration to the source tree
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"canteen-app/internal/adapter/repo/migrations"
+	"canteen-app/internal/adapter/repo/postgres"
+	"canteen-app/internal/adapter/repo/sqlite"
+	"canteen-app/internal/config"
+)
+
+const usage = `usage: migrate [-config FILE] [-dir DIR] status | up | down N | create NAME
+
+The storage is selected by the config file or CANTEEN_STORAGE (postgres or sqlite).
+`
+
+func main() {
+	log.SetFlags(0)
+	configPath := flag.String("config", os.Getenv("CANTEEN_CONFIG"), "path to the YAML config file")
+	dir := flag.String("dir", "", "migrations directory for create, the backend one by default")
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var (
+		open          func() (*sql.DB, error)
+		newMigrator   func(*sql.DB) (*migrations.Migrator, error)
+		migrationsDir string
+	)
+	switch cfg.Storage.Driver {
+	case config.StoragePostgres:
+		open = func() (*sql.DB, error) { return postgres.Open(cfg.Storage.PostgresDSN) }
+		newMigrator = postgres.NewMigrator
+		migrationsDir = postgres.MigrationsDir
+	case config.StorageSQLite:
+		open = func() (*sql.DB, error) { return sqlite.Open(cfg.Storage.SQLitePath) }
+		newMigrator = sqlite.NewMigrator
+		migrationsDir = sqlite.MigrationsDir
+	default:
+		log.Fatalf("storage %q has no schema to migrate", cfg.Storage.Driver)
+	}
+
+	if args[0] == "create" {
+		if len(args) != 2 {
+			log.Fatal("usage: migrate create NAME")
+		}
+		if *dir != "" {
+			migrationsDir = *dir
+		}
+		up, down, err := migrations.Create(migrationsDir, args[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("created", up)
+		fmt.Println("created", down)
+		return
+	}
+
+	db, err := open()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	migrator, err := newMigrator(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := run(migrator, args); err != nil {
+		db.Close()
+		log.Fatal(err)
+	}
+}
+
+func run(migrator *migrations.Migrator, args []string) error {
+	switch args[0] {
+	case "status":
+		states, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		for _, s := range states {
+			status := "pending"
+			if s.Applied() {
+				status = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-30s %s\n", s.Version, s.Name, status)
+		}
+		return nil
+
+	case "up":
+		done, err := migrator.Up()
+		printDone("applied", done)
+		if err == nil && len(done) == 0 {
+			fmt.Println("schema is up to date")
+		}
+		return err
+
+	case "down":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: migrate down N")
+		}
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid number of migrations %q", args[1])
+		}
+		done, err := migrator.Down(n)
+		printDone("reverted", done)
+		return err
+
+	default:
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+func printDone(verb string, done []migrations.Migration) {
+	for _, m := range done {
+		fmt.Printf("%s %04d_%s\n", verb, m.Version, m.Name)
+	}
+}
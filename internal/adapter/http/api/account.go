@@ -0,0 +1,575 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AccountHandler struct {
+	accounts  common.AccountUseCase
+	publicURL string
+	validator common.Validator
+}
+
+// NewAccountHandler registers the routes admins use to let people in: class
+// invitations for students and accounts for staff, and to manage the
+// accounts afterwards. publicURL is used to build the set-password links
+// handed to account owners, signInLimit guards setting a password by token.
+func NewAccountHandler(router *gin.Engine, accounts common.AccountUseCase, publicURL string, signInLimit gin.HandlerFunc, tokenSvc usecase.TokenService, validator common.Validator) {
+	handler := &AccountHandler{
+		accounts:  accounts,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+		validator: validator,
+	}
+
+	router.POST("/api/auth/set-password", signInLimit, handler.SetPassword)
+
+	{
+		admin := router.Group("/api/admin", AuthMiddleware(tokenSvc), common.RequirePermission(domUser.PermUsersManage))
+		admin.POST("/invitations", handler.CreateInvitation)
+		admin.GET("/invitations", handler.ListInvitations)
+		admin.DELETE("/invitations/:code", handler.RevokeInvitation)
+		admin.POST("/users", handler.CreateAccount)
+		admin.GET("/users", handler.ListUsers)
+		admin.GET("/users/:id", handler.GetUser)
+		admin.PUT("/users/:id/role", handler.ChangeRole)
+		admin.POST("/users/:id/block", handler.BlockUser)
+		admin.POST("/users/:id/unblock", handler.UnblockUser)
+		admin.POST("/users/:id/unlock", handler.UnlockUser)
+		admin.POST("/users/:id/reset-password", handler.ResetPassword)
+		admin.DELETE("/users/:id", handler.DeleteUser)
+	}
+}
+
+type InvitationResponse struct {
+	Code      string    `json:"code" example:"K7QX2MPA"`
+	Class     string    `json:"class" example:"7Б"`
+	CreatedBy int64     `json:"created_by" example:"1"`
+	MaxUses   int       `json:"max_uses" example:"30"`
+	Uses      int       `json:"uses" example:"12"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type AccountSetupResponse struct {
+	ID             int64     `json:"id" example:"2"`
+	SetPasswordURL string    `json:"set_password_url" example:"http://localhost:8080/set-password?token=..."`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+type UserResponse struct {
+	ID      int64  `json:"id" example:"2"`
+	Login   string `json:"login" example:"cook"`
+	Name    string `json:"name" example:"Maria"`
+	Surname string `json:"surname" example:"Ivanova"`
+	Role    string `json:"role" example:"employee"`
+	Email   string `json:"email" example:"maria@example.com"`
+	Blocked bool   `json:"blocked" example:"false"`
+}
+
+type UserListResponse struct {
+	Users   []UserResponse `json:"users"`
+	Total   int            `json:"total" example:"42"`
+	Page    int            `json:"page" example:"1"`
+	PerPage int            `json:"per_page" example:"20"`
+}
+
+func toUserResponse(user domUser.User) UserResponse {
+	return UserResponse{
+		ID:      int64(user.ID),
+		Login:   user.Login,
+		Name:    user.Name,
+		Surname: user.Surname,
+		Role:    string(user.Role),
+		Email:   user.Email,
+		Blocked: user.Blocked,
+	}
+}
+
+func (ah *AccountHandler) accountSetupResponse(setup domAuth.AccountSetup) AccountSetupResponse {
+	return AccountSetupResponse{
+		ID:             int64(setup.UserID),
+		SetPasswordURL: ah.publicURL + "/set-password?token=" + url.QueryEscape(setup.Token),
+		ExpiresAt:      setup.ExpiresAt,
+	}
+}
+
+func toInvitationResponse(invitation domAuth.Invitation) InvitationResponse {
+	return InvitationResponse{
+		Code:      invitation.Code,
+		Class:     invitation.Class,
+		CreatedBy: int64(invitation.CreatedBy),
+		MaxUses:   invitation.MaxUses,
+		Uses:      invitation.Uses,
+		CreatedAt: invitation.CreatedAt,
+		ExpiresAt: invitation.ExpiresAt,
+	}
+}
+
+// CreateInvitation godoc
+//
+//	@Summary		Приглашение класса
+//	@Description	Создает код приглашения, по которому ученики класса могут зарегистрироваться. Доступно администраторам.
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			input	body		common.InvitationRequest		true	"Класс, число регистраций и срок действия"
+//	@Success		201		{object}	InvitationResponse				"Приглашение создано"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/admin/invitations [post]
+func (ah *AccountHandler) CreateInvitation(c *gin.Context) {
+	adminID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.InvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ah.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	invitation, err := ah.accounts.CreateInvitation(adminID, req.Class, req.MaxUses, time.Duration(req.ValidDays)*24*time.Hour)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toInvitationResponse(*invitation))
+}
+
+// ListInvitations godoc
+//
+//	@Summary		Список приглашений
+//	@Description	Возвращает приглашения классов, сначала новые. Доступно администраторам.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		InvitationResponse			"Приглашения"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/admin/invitations [get]
+func (ah *AccountHandler) ListInvitations(c *gin.Context) {
+	invitations, err := ah.accounts.ListInvitations()
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	resp := make([]InvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		resp = append(resp, toInvitationResponse(invitation))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// RevokeInvitation godoc
+//
+//	@Summary		Отзыв приглашения
+//	@Description	Удаляет приглашение; зарегистрироваться по нему больше нельзя. Доступно администраторам.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			code	path	string	true	"Код приглашения"
+//	@Success		204		"Приглашение отозвано"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		404		{object}	InvitationNotFoundErrorResponse	"Приглашение не найдено"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/admin/invitations/{code} [delete]
+func (ah *AccountHandler) RevokeInvitation(c *gin.Context) {
+	if err := ah.accounts.RevokeInvitation(c.Param("code")); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateAccount godoc
+//
+//	@Summary		Создание учетной записи
+//	@Description	Создает учетную запись без пароля и возвращает одноразовую ссылку, по которой владелец задает пароль. Доступно администраторам.
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			input	body		common.CreateAccountRequest		true	"Логин, имя, фамилия, почта и роль"
+//	@Success		201		{object}	AccountSetupResponse			"Учетная запись создана"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		409		{object}	EmailInUseErrorResponse			"Пользователь с такой почтой уже существует"
+//	@Failure		409		{object}	LoginInUseErrorResponse			"Пользователь с таким логином уже существует"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/admin/users [post]
+func (ah *AccountHandler) CreateAccount(c *gin.Context) {
+	var req common.CreateAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ah.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	setup, err := ah.accounts.CreateAccount(req.Login, req.Name, req.Surname, req.Email, req.Role)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, ah.accountSetupResponse(*setup))
+}
+
+// ListUsers godoc
+//
+//	@Summary		Список пользователей
+//	@Description	Возвращает страницу пользователей по возрастанию id. Поиск идет по части логина, имени или фамилии без учета регистра. Доступно администраторам.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			search		query		string	false	"Часть логина, имени или фамилии"
+//	@Param			role		query		string	false	"Роль"	Enums(admin, employee, student)
+//	@Param			page		query		int		false	"Номер страницы, с 1"
+//	@Param			per_page	query		int		false	"Размер страницы, до 100"
+//	@Success		200			{object}	UserListResponse			"Пользователи"
+//	@Failure		400			{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		400			{object}	ValidationErrorResponse		"Данные невалидны"
+//	@Failure		401			{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403			{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500			{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/admin/users [get]
+func (ah *AccountHandler) ListUsers(c *gin.Context) {
+	var req common.UserListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ah.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	users, total, err := ah.accounts.ListUsers(req.ListQuery())
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	req = req.WithDefaults()
+	resp := UserListResponse{
+		Users:   make([]UserResponse, 0, len(users)),
+		Total:   total,
+		Page:    req.Page,
+		PerPage: req.PerPage,
+	}
+	for _, user := range users {
+		resp.Users = append(resp.Users, toUserResponse(user))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetUser godoc
+//
+//	@Summary		Пользователь
+//	@Description	Возвращает пользователя по id. Доступно администраторам.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"ID пользователя"
+//	@Success		200	{object}	UserResponse				"Пользователь"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	UserNotFoundErrorResponse	"Пользователь не найден"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/admin/users/{id} [get]
+func (ah *AccountHandler) GetUser(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	user, err := ah.accounts.GetUser(domUser.UserID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toUserResponse(*user))
+}
+
+// ChangeRole godoc
+//
+//	@Summary		Смена роли
+//	@Description	Меняет роль пользователя и завершает все его сессии; уже выданные access token действуют до истечения срока. Свою роль менять нельзя. Доступно администраторам.
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int							true	"ID пользователя"
+//	@Param			input	body		common.ChangeRoleRequest	true	"Новая роль"
+//	@Success		200		{object}	UserResponse				"Роль изменена"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse		"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404		{object}	UserNotFoundErrorResponse	"Пользователь не найден"
+//	@Failure		409		{object}	OwnAccountErrorResponse		"Нельзя менять свою учетную запись"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/admin/users/{id}/role [put]
+func (ah *AccountHandler) ChangeRole(c *gin.Context) {
+	adminID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.ChangeRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ah.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	user, err := ah.accounts.ChangeRole(adminID, domUser.UserID(id), req.Role)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toUserResponse(*user))
+}
+
+// BlockUser godoc
+//
+//	@Summary		Блокировка пользователя
+//	@Description	Блокирует пользователя и отзывает все его refresh token; войти он больше не может. Себя заблокировать нельзя. Доступно администраторам.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"ID пользователя"
+//	@Success		200	{object}	UserResponse				"Пользователь заблокирован"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	UserNotFoundErrorResponse	"Пользователь не найден"
+//	@Failure		409	{object}	OwnAccountErrorResponse		"Нельзя менять свою учетную запись"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/admin/users/{id}/block [post]
+func (ah *AccountHandler) BlockUser(c *gin.Context) {
+	ah.setBlocked(c, true)
+}
+
+// UnblockUser godoc
+//
+//	@Summary		Разблокировка пользователя
+//	@Description	Снимает блокировку с пользователя. Доступно администраторам.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"ID пользователя"
+//	@Success		200	{object}	UserResponse				"Пользователь разблокирован"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	UserNotFoundErrorResponse	"Пользователь не найден"
+//	@Failure		409	{object}	OwnAccountErrorResponse		"Нельзя менять свою учетную запись"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/admin/users/{id}/unblock [post]
+func (ah *AccountHandler) UnblockUser(c *gin.Context) {
+	ah.setBlocked(c, false)
+}
+
+func (ah *AccountHandler) setBlocked(c *gin.Context, blocked bool) {
+	adminID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	user, err := ah.accounts.SetBlocked(adminID, domUser.UserID(id), blocked)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toUserResponse(*user))
+}
+
+// UnlockUser godoc
+//
+//	@Summary		Снятие блокировки входа
+//	@Description	Снимает временную блокировку, наложенную после неудачных попыток входа, и обнуляет их счетчик. Доступно администраторам.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"ID пользователя"
+//	@Success		204	"Блокировка снята"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	UserNotFoundErrorResponse	"Пользователь не найден"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/admin/users/{id}/unlock [post]
+func (ah *AccountHandler) UnlockUser(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	if err := ah.accounts.UnlockUser(domUser.UserID(id)); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ResetPassword godoc
+//
+//	@Summary		Сброс пароля
+//	@Description	Стирает пароль пользователя, завершает все его сессии и возвращает одноразовую ссылку для нового пароля. Доступно администраторам.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"ID пользователя"
+//	@Success		200	{object}	AccountSetupResponse		"Пароль сброшен"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	UserNotFoundErrorResponse	"Пользователь не найден"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/admin/users/{id}/reset-password [post]
+func (ah *AccountHandler) ResetPassword(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	setup, err := ah.accounts.ResetPassword(domUser.UserID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ah.accountSetupResponse(*setup))
+}
+
+// DeleteUser godoc
+//
+//	@Summary		Удаление пользователя
+//	@Description	Удаляет учетную запись вместе с ее сессиями. Себя удалить нельзя. Доступно администраторам.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"ID пользователя"
+//	@Success		204	"Пользователь удален"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	UserNotFoundErrorResponse	"Пользователь не найден"
+//	@Failure		409	{object}	OwnAccountErrorResponse		"Нельзя менять свою учетную запись"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/admin/users/{id} [delete]
+func (ah *AccountHandler) DeleteUser(c *gin.Context) {
+	adminID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	if err := ah.accounts.DeleteUser(adminID, domUser.UserID(id)); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetPassword godoc
+//
+//	@Summary		Установка пароля
+//	@Description	Задает пароль по одноразовому токену из ссылки, выданной администратором или присланной на почту при сбросе пароля. Все сессии пользователя завершаются.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			input	body	common.SetPasswordRequest	true	"Токен из ссылки и новый пароль"
+//	@Success		204		"Пароль установлен"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse		"Данные невалидны"
+//	@Failure		400		{object}	PasswordTokenErrorResponse	"Токен неизвестен, истек или уже использован"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/auth/set-password [post]
+func (ah *AccountHandler) SetPassword(c *gin.Context) {
+	var req common.SetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ah.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	if err := ah.accounts.SetPassword(req.Token, req.Password); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,534 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"canteen-app/internal/adapter/http/api/mocks"
+	"canteen-app/internal/adapter/http/common"
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRouterWithAccountUseCase(accountUC *mocks.AccountUseCase, tokenSvc usecase.TokenService, validator *mocks.Validator) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	NewAccountHandler(r, accountUC, "https://canteen.example.com/", noLimit, tokenSvc, validator)
+
+	return r
+}
+
+func TestAccountHandler_CreateAccount(t *testing.T) {
+	requestBody := map[string]interface{}{
+		"login":   "cook",
+		"name":    "Maria",
+		"surname": "Ivanova",
+		"email":   "maria@example.com",
+		"role":    "employee",
+	}
+	validRequest := common.CreateAccountRequest{Login: "cook", Name: "Maria", Surname: "Ivanova", Email: "maria@example.com", Role: domUser.RoleEmployee}
+
+	tests := []struct {
+		name           string
+		role           string
+		setupAccountUC func(m *mocks.AccountUseCase)
+		setupValidator func(m *mocks.Validator)
+		wantStatusCode int
+		wantErrorText  string
+	}{
+		{
+			name: "success",
+			role: "admin",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("CreateAccount", "cook", "Maria", "Ivanova", "maria@example.com", domUser.RoleEmployee).Return(
+					&domAuth.AccountSetup{UserID: 7, Token: "a+b/c", ExpiresAt: time.Now().Add(time.Hour)}, nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusCreated,
+		},
+
+		{
+			name: "login in use",
+			role: "admin",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("CreateAccount", "cook", "Maria", "Ivanova", "maria@example.com", domUser.RoleEmployee).Return(
+					nil, usecase.ErrLoginInUse).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "login already in use",
+		},
+
+		{
+			name: "email in use",
+			role: "admin",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("CreateAccount", "cook", "Maria", "Ivanova", "maria@example.com", domUser.RoleEmployee).Return(
+					nil, usecase.ErrEmailInUse).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "email already in use",
+		},
+
+		{
+			name: "employee is forbidden",
+			role: "employee",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			accountUC := mocks.NewAccountUseCase(t)
+
+			if tc.setupAccountUC != nil {
+				tc.setupAccountUC(accountUC)
+			}
+
+			validator := mocks.NewValidator(t)
+
+			if tc.setupValidator != nil {
+				tc.setupValidator(validator)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithAccountUseCase(accountUC, tokenSvc, validator)
+
+			bodyBytes, err := json.Marshal(requestBody)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/api/admin/users", bytes.NewReader(bodyBytes))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, float64(7), resp["id"])
+				assert.Equal(t, "https://canteen.example.com/set-password?token=a%2Bb%2Fc", resp["set_password_url"])
+			}
+
+			accountUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAccountHandler_CreateInvitation(t *testing.T) {
+	requestBody := map[string]interface{}{
+		"class":      "7Б",
+		"max_uses":   30,
+		"valid_days": 14,
+	}
+	validRequest := common.InvitationRequest{Class: "7Б", MaxUses: 30, ValidDays: 14}
+
+	tests := []struct {
+		name           string
+		role           string
+		setupAccountUC func(m *mocks.AccountUseCase)
+		setupValidator func(m *mocks.Validator)
+		wantStatusCode int
+		wantErrorText  string
+	}{
+		{
+			name: "success",
+			role: "admin",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("CreateInvitation", domUser.UserID(1), "7Б", 30, 14*24*time.Hour).Return(
+					&domAuth.Invitation{Code: "K7QX2MPA", Class: "7Б", CreatedBy: 1, MaxUses: 30}, nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusCreated,
+		},
+
+		{
+			name: "validation error",
+			role: "admin",
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(common.ErrValidationError).Once()
+			},
+
+			wantStatusCode: http.StatusBadRequest,
+			wantErrorText:  "validation error",
+		},
+
+		{
+			name: "student is forbidden",
+			role: "student",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			accountUC := mocks.NewAccountUseCase(t)
+
+			if tc.setupAccountUC != nil {
+				tc.setupAccountUC(accountUC)
+			}
+
+			validator := mocks.NewValidator(t)
+
+			if tc.setupValidator != nil {
+				tc.setupValidator(validator)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithAccountUseCase(accountUC, tokenSvc, validator)
+
+			bodyBytes, err := json.Marshal(requestBody)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/api/admin/invitations", bytes.NewReader(bodyBytes))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, "K7QX2MPA", resp["code"])
+				assert.Equal(t, float64(30), resp["max_uses"])
+			}
+
+			accountUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAccountHandler_SetPassword(t *testing.T) {
+	requestBody := map[string]interface{}{
+		"token":    "secret",
+		"password": "password1234",
+	}
+	validRequest := common.SetPasswordRequest{Token: "secret", Password: "password1234"}
+
+	tests := []struct {
+		name           string
+		setupAccountUC func(m *mocks.AccountUseCase)
+		wantStatusCode int
+		wantErrorText  string
+	}{
+		{
+			name: "success",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("SetPassword", "secret", "password1234").Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusNoContent,
+		},
+
+		{
+			name: "invalid token",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("SetPassword", "secret", "password1234").Return(usecase.ErrPasswordTokenInvalid).Once()
+			},
+
+			wantStatusCode: http.StatusBadRequest,
+			wantErrorText:  "invalid or expired set-password token",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			accountUC := mocks.NewAccountUseCase(t)
+			tc.setupAccountUC(accountUC)
+
+			validator := mocks.NewValidator(t)
+			validator.On("Struct", validRequest).Return(nil).Once()
+
+			router := setupRouterWithAccountUseCase(accountUC, newTestTokenService(), validator)
+
+			bodyBytes, err := json.Marshal(requestBody)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/api/auth/set-password", bytes.NewReader(bodyBytes))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			if tc.wantErrorText != "" {
+				var resp map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			}
+
+			accountUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAccountHandler_ListUsers(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		setupAccountUC func(m *mocks.AccountUseCase)
+		setupValidator func(m *mocks.Validator)
+		wantStatusCode int
+		wantErrorText  string
+		wantTotal      int
+	}{
+		{
+			name:  "defaults",
+			query: "",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("ListUsers", domUser.ListQuery{Offset: 0, Limit: common.DefaultPerPage}).Return(
+					[]domUser.User{{ID: 2, Login: "cook", Role: domUser.RoleEmployee}}, 1, nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", common.UserListRequest{}).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+			wantTotal:      1,
+		},
+
+		{
+			name:  "search, role and page",
+			query: "?search=ma&role=student&page=3&per_page=10",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("ListUsers", domUser.ListQuery{Search: "ma", Role: domUser.RoleStudent, Offset: 20, Limit: 10}).Return(
+					[]domUser.User{}, 21, nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", common.UserListRequest{Search: "ma", Role: domUser.RoleStudent, Page: 3, PerPage: 10}).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+			wantTotal:      21,
+		},
+
+		{
+			name:  "page is not a number",
+			query: "?page=first",
+
+			wantStatusCode: http.StatusBadRequest,
+			wantErrorText:  "invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			accountUC := mocks.NewAccountUseCase(t)
+
+			if tc.setupAccountUC != nil {
+				tc.setupAccountUC(accountUC)
+			}
+
+			validator := mocks.NewValidator(t)
+
+			if tc.setupValidator != nil {
+				tc.setupValidator(validator)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithAccountUseCase(accountUC, tokenSvc, validator)
+
+			req, err := http.NewRequest(http.MethodGet, "/api/admin/users"+tc.query, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", bearer(t, tokenSvc, "admin"))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, float64(tc.wantTotal), resp["total"])
+			}
+
+			accountUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAccountHandler_ManageUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		setupAccountUC func(m *mocks.AccountUseCase)
+		wantStatusCode int
+		wantErrorText  string
+	}{
+		{
+			name:   "block",
+			method: http.MethodPost,
+			path:   "/api/admin/users/2/block",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("SetBlocked", domUser.UserID(1), domUser.UserID(2), true).Return(
+					&domUser.User{ID: 2, Login: "cook", Blocked: true}, nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+		},
+
+		{
+			name:   "unblock unknown user",
+			method: http.MethodPost,
+			path:   "/api/admin/users/42/unblock",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("SetBlocked", domUser.UserID(1), domUser.UserID(42), false).Return(
+					nil, usecase.ErrUserNotFound).Once()
+			},
+
+			wantStatusCode: http.StatusNotFound,
+			wantErrorText:  "user not found",
+		},
+
+		{
+			name:   "delete own account",
+			method: http.MethodDelete,
+			path:   "/api/admin/users/1",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("DeleteUser", domUser.UserID(1), domUser.UserID(1)).Return(usecase.ErrOwnAccount).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "cannot block, demote or delete your own account",
+		},
+
+		{
+			name:   "delete",
+			method: http.MethodDelete,
+			path:   "/api/admin/users/2",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("DeleteUser", domUser.UserID(1), domUser.UserID(2)).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusNoContent,
+		},
+
+		{
+			name:   "unlock",
+			method: http.MethodPost,
+			path:   "/api/admin/users/2/unlock",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("UnlockUser", domUser.UserID(2)).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusNoContent,
+		},
+
+		{
+			name:   "reset password",
+			method: http.MethodPost,
+			path:   "/api/admin/users/2/reset-password",
+
+			setupAccountUC: func(m *mocks.AccountUseCase) {
+				m.On("ResetPassword", domUser.UserID(2)).Return(
+					&domAuth.AccountSetup{UserID: 2, Token: "secret", ExpiresAt: time.Now().Add(time.Hour)}, nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+		},
+
+		{
+			name:   "bad id",
+			method: http.MethodGet,
+			path:   "/api/admin/users/zero",
+
+			wantStatusCode: http.StatusBadRequest,
+			wantErrorText:  "invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			accountUC := mocks.NewAccountUseCase(t)
+
+			if tc.setupAccountUC != nil {
+				tc.setupAccountUC(accountUC)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithAccountUseCase(accountUC, tokenSvc, mocks.NewValidator(t))
+
+			req, err := http.NewRequest(tc.method, tc.path, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", bearer(t, tokenSvc, "admin"))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			if tc.wantErrorText != "" {
+				var resp map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			}
+
+			accountUC.AssertExpectations(t)
+		})
+	}
+}
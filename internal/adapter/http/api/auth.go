@@ -8,6 +8,9 @@ import (
 
 	_ "canteen-app/cmd/docs"
 	"canteen-app/internal/adapter/http/common"
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
 
 	"github.com/gin-gonic/gin"
 )
@@ -18,7 +21,9 @@ type AuthHandler struct {
 	validator  common.Validator
 }
 
-func NewAuthHandler(router *gin.Engine, auth common.AuthUseCase, refreshTTL time.Duration, validator common.Validator) {
+// NewAuthHandler registers the sign-in routes; signInLimit guards those that
+// check a password or a token against guessing.
+func NewAuthHandler(router *gin.Engine, auth common.AuthUseCase, refreshTTL time.Duration, signInLimit gin.HandlerFunc, tokenSvc usecase.TokenService, validator common.Validator) {
 	handler := &AuthHandler{
 		auth:       auth,
 		refreshTTL: refreshTTL,
@@ -27,10 +32,22 @@ func NewAuthHandler(router *gin.Engine, auth common.AuthUseCase, refreshTTL time
 
 	{
 		auth := router.Group("/api/auth")
-		auth.POST("/register", handler.Register)
-		auth.POST("/login", handler.Login)
+		auth.POST("/register", signInLimit, handler.Register)
+		auth.POST("/login", signInLimit, handler.Login)
 		auth.POST("/logout", handler.Logout)
-		auth.GET("/refresh", handler.Refresh)
+		auth.GET("/refresh", signInLimit, handler.Refresh)
+	}
+
+	{
+		sessions := router.Group("/api/auth/sessions", AuthMiddleware(tokenSvc))
+		sessions.GET("", handler.ListSessions)
+		sessions.DELETE("", handler.RevokeAllSessions)
+		sessions.DELETE("/:id", handler.RevokeSession)
+	}
+
+	{
+		admin := router.Group("/api/admin/users", AuthMiddleware(tokenSvc), common.RequirePermission(domUser.PermUsersManage))
+		admin.DELETE("/:id/sessions", handler.RevokeUserSessions)
 	}
 }
 
@@ -38,10 +55,36 @@ type AccessTokenResponse struct {
 	AccessToken string `json:"access_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
 }
 
+type SessionResponse struct {
+	ID         string    `json:"id" example:"1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed"`
+	UserAgent  string    `json:"user_agent" example:"Mozilla/5.0 (X11; Linux x86_64)"`
+	IP         string    `json:"ip" example:"192.0.2.1"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current" example:"true"`
+}
+
+func toSessionResponses(sessions []domAuth.Session) []SessionResponse {
+	resp := make([]SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		resp = append(resp, SessionResponse{
+			ID:         session.ID,
+			UserAgent:  session.Client.UserAgent,
+			IP:         session.Client.IP,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.Current,
+		})
+	}
+	return resp
+}
+
 // Register godoc
 //
 //	@Summary		Регистрация пользователя
-//	@Description	Создает нового пользователя, устанавливает refresh токен в cookie и возвращает access токен в теле ответа.
+//	@Description	Создает ученика по коду приглашения класса, устанавливает refresh токен в cookie и возвращает access токен в теле ответа. Сотрудников и администраторов создает администратор.
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
@@ -49,6 +92,8 @@ type AccessTokenResponse struct {
 //	@Success		201		{object}	AccessTokenResponse			"Пользователь успешно зарегистрирован"
 //	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
 //	@Failure		400		{object}	ValidationErrorResponse		"Данные невалидны"
+//	@Failure		400		{object}	InvitationInvalidErrorResponse	"Код приглашения неизвестен, истек или исчерпан"
+//	@Failure		409		{object}	EmailInUseErrorResponse		"Пользователь с такой почтой уже существует"
 //	@Failure		409		{object}	LoginInUseErrorResponse		"Пользователь с таким логином уже существует"
 //	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
 //	@Router			/api/auth/register [post]
@@ -65,7 +110,7 @@ func (ah *AuthHandler) Register(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ah.auth.Register(req.Login, req.Password, req.Name, req.Surname, req.Role)
+	tokens, err := ah.auth.Register(req.Login, req.Password, req.Name, req.Surname, req.Email, req.InviteCode, common.Client(c))
 	if err != nil {
 		writeError(c, err)
 		return
@@ -78,7 +123,7 @@ func (ah *AuthHandler) Register(c *gin.Context) {
 		Domain:   "",
 		Expires:  time.Now().Add(ah.refreshTTL),
 		HttpOnly: true,
-		Secure:   false,
+		Secure:   common.CookieSecure(c),
 		SameSite: http.SameSiteLaxMode,
 	})
 
@@ -97,6 +142,9 @@ func (ah *AuthHandler) Register(c *gin.Context) {
 //	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
 //	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
 //	@Failure		401		{object}	InvalidCredentialsErrorResponse	"Логин/пароль некорректен"
+//	@Failure		403		{object}	UserBlockedErrorResponse		"Пользователь заблокирован"
+//	@Failure		423		{object}	AccountLockedErrorResponse		"Учетная запись временно заблокирована после неудачных попыток входа"
+//	@Failure		429		{object}	TooManyAttemptsErrorResponse	"Слишком много попыток входа, нужно подождать"
 //	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
 //	@Router			/api/auth/login [post]
 func (ah *AuthHandler) Login(c *gin.Context) {
@@ -111,7 +159,7 @@ func (ah *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ah.auth.Login(req.Login, req.Password)
+	tokens, err := ah.auth.Login(req.Login, req.Password, common.Client(c))
 	if err != nil {
 		writeError(c, err)
 		return
@@ -124,7 +172,7 @@ func (ah *AuthHandler) Login(c *gin.Context) {
 		Domain:   "",
 		Expires:  time.Now().Add(ah.refreshTTL),
 		HttpOnly: true,
-		Secure:   false,
+		Secure:   common.CookieSecure(c),
 		SameSite: http.SameSiteLaxMode,
 	})
 
@@ -138,7 +186,8 @@ func (ah *AuthHandler) Login(c *gin.Context) {
 //	@Tags			auth
 //	@Produce		json
 //	@Success		200	{object}	AccessTokenResponse			"access токен успешно обновлен"
-//	@Failure		401	{object}	RefreshTokenErrorResponse	"Refresh токен не установлен или некорректен"
+//	@Failure		401	{object}	RefreshTokenErrorResponse	"Refresh токен не установлен, некорректен или использован повторно; при повторном использовании все сессии цепочки завершаются"
+//	@Failure		403	{object}	UserBlockedErrorResponse	"Пользователь заблокирован"
 //	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
 //	@Router			/api/auth/refresh [get]
 func (ah *AuthHandler) Refresh(c *gin.Context) {
@@ -148,7 +197,7 @@ func (ah *AuthHandler) Refresh(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ah.auth.Refresh(refreshToken)
+	tokens, err := ah.auth.Refresh(refreshToken, common.Client(c))
 	if err != nil {
 		writeError(c, err)
 		return
@@ -161,7 +210,7 @@ func (ah *AuthHandler) Refresh(c *gin.Context) {
 		Domain:   "",
 		Expires:  time.Now().Add(ah.refreshTTL),
 		HttpOnly: true,
-		Secure:   false,
+		Secure:   common.CookieSecure(c),
 		SameSite: http.SameSiteLaxMode,
 	})
 	c.JSON(http.StatusOK, AccessTokenResponse{AccessToken: tokens.AccessToken})
@@ -182,6 +231,121 @@ func (ah *AuthHandler) Logout(c *gin.Context) {
 		}
 	}
 
+	clearRefreshCookie(c)
+	c.Status(http.StatusNoContent)
+}
+
+// ListSessions godoc
+//
+//	@Summary		Активные сессии
+//	@Description	Возвращает устройства, на которых выполнен вход в учетную запись. Сессия запроса, если refresh токен передан в cookie, отмечена current.
+//	@Tags			auth
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		SessionResponse				"Список сессий"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/auth/sessions [get]
+func (ah *AuthHandler) ListSessions(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	refreshToken, _ := c.Cookie("refresh_token")
+	sessions, err := ah.auth.ListSessions(userID, refreshToken)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toSessionResponses(sessions))
+}
+
+// RevokeSession godoc
+//
+//	@Summary		Завершение сессии
+//	@Description	Выходит из учетной записи на одном устройстве. Выданные ему access токены действуют до истечения срока.
+//	@Tags			auth
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Идентификатор сессии"
+//	@Success		204	"Сессия завершена"
+//	@Failure		401	{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		404	{object}	SessionNotFoundErrorResponse	"Сессия не найдена"
+//	@Failure		500	{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/auth/sessions/{id} [delete]
+func (ah *AuthHandler) RevokeSession(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	if err := ah.auth.RevokeSession(userID, c.Param("id")); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeAllSessions godoc
+//
+//	@Summary		Выход на всех устройствах
+//	@Description	Завершает все сессии пользователя, включая текущую, и удаляет refresh токен из cookie.
+//	@Tags			auth
+//	@Security		BearerAuth
+//	@Success		204	"Все сессии завершены"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/auth/sessions [delete]
+func (ah *AuthHandler) RevokeAllSessions(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	if err := ah.auth.RevokeAllSessions(userID); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	clearRefreshCookie(c)
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeUserSessions godoc
+//
+//	@Summary		Завершение сессий пользователя
+//	@Description	Завершает все сессии указанного пользователя. Доступно администратору.
+//	@Tags			admin
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"Идентификатор пользователя"
+//	@Success		204	"Сессии пользователя завершены"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	UserNotFoundErrorResponse	"Пользователь не найден"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/admin/users/{id}/sessions [delete]
+func (ah *AuthHandler) RevokeUserSessions(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	if err := ah.auth.RevokeAllSessions(domUser.UserID(id)); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func clearRefreshCookie(c *gin.Context) {
 	c.SetCookieData(&http.Cookie{
 		Name:     "refresh_token",
 		Value:    "",
@@ -189,9 +353,7 @@ func (ah *AuthHandler) Logout(c *gin.Context) {
 		Domain:   "",
 		MaxAge:   -1,
 		HttpOnly: true,
-		Secure:   false,
+		Secure:   common.CookieSecure(c),
 		SameSite: http.SameSiteLaxMode,
 	})
-
-	c.Status(http.StatusNoContent)
 }
@@ -33,24 +33,3 @@ func AuthMiddleware(tokenService usecase.TokenService) gin.HandlerFunc {
 		c.Next()
 	}
 }
-
-func RequireRole(roles ...string) gin.HandlerFunc {
-	allowed := make(map[string]struct{}, len(roles))
-	for _, r := range roles {
-		allowed[r] = struct{}{}
-	}
-
-	return func(c *gin.Context) {
-		roleVal, ok := c.Get("userRole")
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "no role in context"})
-		}
-
-		role, _ := roleVal.(string)
-		if _, ok := allowed[role]; ok {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "forbidden"})
-			return
-		}
-		c.Next()
-	}
-}
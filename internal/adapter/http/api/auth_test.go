@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	"canteen-app/internal/adapter/http/api/mocks"
 	"canteen-app/internal/adapter/http/common"
 	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
 	"canteen-app/internal/usecase"
 
 	"github.com/gin-gonic/gin"
@@ -19,15 +21,18 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func setupRouterWithAuthUseCase(authUC *mocks.AuthUseCase, refreshTTL time.Duration, validator *mocks.Validator) *gin.Engine {
+func setupRouterWithAuthUseCase(authUC *mocks.AuthUseCase, refreshTTL time.Duration, tokenSvc usecase.TokenService, validator *mocks.Validator) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 
 	r := gin.New()
-	NewAuthHandler(r, authUC, refreshTTL, validator)
+	NewAuthHandler(r, authUC, refreshTTL, noLimit, tokenSvc, validator)
 
 	return r
 }
 
+// noLimit stands in for the sign-in rate limit, which has tests of its own.
+func noLimit(c *gin.Context) { c.Next() }
+
 func TestAuthHandler_Register(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -40,15 +45,15 @@ func TestAuthHandler_Register(t *testing.T) {
 		{
 			name: "success",
 			requestBody: map[string]string{
-				"login":    "the_real_slim_shady",
-				"password": "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
-				"name":     "Slim",
-				"surname":  "Shady",
-				"role":     "admin",
+				"login":       "the_real_slim_shady",
+				"password":    "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+				"name":        "Slim",
+				"surname":     "Shady",
+				"invite_code": "K7QX2MPA",
 			},
 
 			setupAuthUC: func(m *mocks.AuthUseCase) {
-				m.On("Register", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", "Slim", "Shady", "admin").Return(
+				m.On("Register", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", "Slim", "Shady", "", "K7QX2MPA", domAuth.Client{}).Return(
 					&domAuth.Tokens{
 						AccessToken:  "access_token",
 						RefreshToken: "refresh_token",
@@ -57,11 +62,11 @@ func TestAuthHandler_Register(t *testing.T) {
 
 			setupValidator: func(m *mocks.Validator) {
 				m.On("Struct", common.RegisterRequest{
-					Login:    "the_real_slim_shady",
-					Password: "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
-					Name:     "Slim",
-					Surname:  "Shady",
-					Role:     "admin",
+					Login:      "the_real_slim_shady",
+					Password:   "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+					Name:       "Slim",
+					Surname:    "Shady",
+					InviteCode: "K7QX2MPA",
 				}).Return(nil).Once()
 			},
 
@@ -72,10 +77,10 @@ func TestAuthHandler_Register(t *testing.T) {
 		{
 			name: "missing required field",
 			requestBody: map[string]string{
-				"login":   "the_real_slim_shady",
-				"name":    "Slim",
-				"surname": "Shady",
-				"role":    "admin",
+				"login":       "the_real_slim_shady",
+				"name":        "Slim",
+				"surname":     "Shady",
+				"invite_code": "K7QX2MPA",
 			},
 
 			wantStatusCode: http.StatusBadRequest,
@@ -85,16 +90,16 @@ func TestAuthHandler_Register(t *testing.T) {
 		{
 			name: "user exists error",
 			requestBody: map[string]string{
-				"login":    "the_real_slim_shady",
-				"password": "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
-				"name":     "Slim",
-				"surname":  "Shady",
-				"role":     "admin",
+				"login":       "the_real_slim_shady",
+				"password":    "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+				"name":        "Slim",
+				"surname":     "Shady",
+				"invite_code": "K7QX2MPA",
 			},
 
 			setupAuthUC: func(m *mocks.AuthUseCase) {
-				m.On("Register", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", "Slim", "Shady", "admin").Return(
-					func(login, password, name, surname, role string) (*domAuth.Tokens, error) {
+				m.On("Register", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", "Slim", "Shady", "", "K7QX2MPA", domAuth.Client{}).Return(
+					func(login, password, name, surname, email, inviteCode string, client domAuth.Client) (*domAuth.Tokens, error) {
 						return &domAuth.Tokens{}, usecase.ErrLoginInUse
 					},
 				).Once()
@@ -102,11 +107,11 @@ func TestAuthHandler_Register(t *testing.T) {
 
 			setupValidator: func(m *mocks.Validator) {
 				m.On("Struct", common.RegisterRequest{
-					Login:    "the_real_slim_shady",
-					Password: "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
-					Name:     "Slim",
-					Surname:  "Shady",
-					Role:     "admin",
+					Login:      "the_real_slim_shady",
+					Password:   "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+					Name:       "Slim",
+					Surname:    "Shady",
+					InviteCode: "K7QX2MPA",
 				}).Return(nil).Once()
 			},
 
@@ -114,19 +119,49 @@ func TestAuthHandler_Register(t *testing.T) {
 			wantErrorText:  "login already in use",
 		},
 
+		{
+			name: "invalid invitation",
+			requestBody: map[string]string{
+				"login":       "the_real_slim_shady",
+				"password":    "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+				"name":        "Slim",
+				"surname":     "Shady",
+				"invite_code": "K7QX2MPA",
+			},
+
+			setupAuthUC: func(m *mocks.AuthUseCase) {
+				m.On("Register", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", "Slim", "Shady", "", "K7QX2MPA", domAuth.Client{}).Return(
+					nil, usecase.ErrInvitationInvalid,
+				).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", common.RegisterRequest{
+					Login:      "the_real_slim_shady",
+					Password:   "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+					Name:       "Slim",
+					Surname:    "Shady",
+					InviteCode: "K7QX2MPA",
+				}).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusBadRequest,
+			wantErrorText:  "invalid invitation code",
+		},
+
 		{
 			name: "internal server error",
 			requestBody: map[string]string{
-				"login":    "the_real_slim_shady",
-				"password": "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
-				"name":     "Slim",
-				"surname":  "Shady",
-				"role":     "admin",
+				"login":       "the_real_slim_shady",
+				"password":    "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+				"name":        "Slim",
+				"surname":     "Shady",
+				"invite_code": "K7QX2MPA",
 			},
 
 			setupAuthUC: func(m *mocks.AuthUseCase) {
-				m.On("Register", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", "Slim", "Shady", "admin").Return(
-					func(login, password, name, surname, role string) (*domAuth.Tokens, error) {
+				m.On("Register", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", "Slim", "Shady", "", "K7QX2MPA", domAuth.Client{}).Return(
+					func(login, password, name, surname, email, inviteCode string, client domAuth.Client) (*domAuth.Tokens, error) {
 						return &domAuth.Tokens{}, errors.New("error")
 					},
 				).Once()
@@ -134,11 +169,11 @@ func TestAuthHandler_Register(t *testing.T) {
 
 			setupValidator: func(m *mocks.Validator) {
 				m.On("Struct", common.RegisterRequest{
-					Login:    "the_real_slim_shady",
-					Password: "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
-					Name:     "Slim",
-					Surname:  "Shady",
-					Role:     "admin",
+					Login:      "the_real_slim_shady",
+					Password:   "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+					Name:       "Slim",
+					Surname:    "Shady",
+					InviteCode: "K7QX2MPA",
 				}).Return(nil).Once()
 			},
 
@@ -149,20 +184,20 @@ func TestAuthHandler_Register(t *testing.T) {
 		{
 			name: "valiadtion error",
 			requestBody: map[string]string{
-				"login":    "the_real_slim_shady",
-				"password": "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
-				"name":     "Slim",
-				"surname":  "Shady",
-				"role":     "admin",
+				"login":       "the_real_slim_shady",
+				"password":    "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+				"name":        "Slim",
+				"surname":     "Shady",
+				"invite_code": "K7QX2MPA",
 			},
 
 			setupValidator: func(m *mocks.Validator) {
 				m.On("Struct", common.RegisterRequest{
-					Login:    "the_real_slim_shady",
-					Password: "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
-					Name:     "Slim",
-					Surname:  "Shady",
-					Role:     "admin",
+					Login:      "the_real_slim_shady",
+					Password:   "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+					Name:       "Slim",
+					Surname:    "Shady",
+					InviteCode: "K7QX2MPA",
 				}).Return(common.ErrValidationError).Once()
 			},
 
@@ -185,7 +220,7 @@ func TestAuthHandler_Register(t *testing.T) {
 				tc.setupValidator(validator)
 			}
 
-			router := setupRouterWithAuthUseCase(authUC, time.Duration(30), validator)
+			router := setupRouterWithAuthUseCase(authUC, time.Duration(30), newTestTokenService(), validator)
 
 			bodyBytes, err := json.Marshal(tc.requestBody)
 			req, err := http.NewRequest(http.MethodPost, "/api/auth/register", bytes.NewReader(bodyBytes))
@@ -243,7 +278,7 @@ func TestAuthHandler_Login(t *testing.T) {
 			},
 
 			setupAuthUC: func(m *mocks.AuthUseCase) {
-				m.On("Login", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj").Return(
+				m.On("Login", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", domAuth.Client{UserAgent: "canteen-test"}).Return(
 					&domAuth.Tokens{
 						AccessToken:  "access_token",
 						RefreshToken: "refresh_token",
@@ -279,8 +314,8 @@ func TestAuthHandler_Login(t *testing.T) {
 			},
 
 			setupAuthUC: func(m *mocks.AuthUseCase) {
-				m.On("Login", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj").Return(
-					func(login, password string) (*domAuth.Tokens, error) {
+				m.On("Login", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", domAuth.Client{UserAgent: "canteen-test"}).Return(
+					func(login, password string, client domAuth.Client) (*domAuth.Tokens, error) {
 						return &domAuth.Tokens{}, usecase.ErrInvalidCredentials
 					},
 				).Once()
@@ -297,6 +332,52 @@ func TestAuthHandler_Login(t *testing.T) {
 			wantErrorText:  "invalid credentials",
 		},
 
+		{
+			name: "account locked",
+			requestBody: map[string]string{
+				"login":    "the_real_slim_shady",
+				"password": "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+			},
+
+			setupAuthUC: func(m *mocks.AuthUseCase) {
+				m.On("Login", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", domAuth.Client{UserAgent: "canteen-test"}).Return(
+					nil, usecase.ErrAccountLocked).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", common.LoginRequest{
+					Login:    "the_real_slim_shady",
+					Password: "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+				}).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusLocked,
+			wantErrorText:  "account temporarily locked",
+		},
+
+		{
+			name: "too many attempts",
+			requestBody: map[string]string{
+				"login":    "the_real_slim_shady",
+				"password": "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+			},
+
+			setupAuthUC: func(m *mocks.AuthUseCase) {
+				m.On("Login", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", domAuth.Client{UserAgent: "canteen-test"}).Return(
+					nil, usecase.ErrTooManyAttempts).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", common.LoginRequest{
+					Login:    "the_real_slim_shady",
+					Password: "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj",
+				}).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusTooManyRequests,
+			wantErrorText:  "too many sign-in attempts, try again later",
+		},
+
 		{
 			name: "internal server error",
 			requestBody: map[string]string{
@@ -305,8 +386,8 @@ func TestAuthHandler_Login(t *testing.T) {
 			},
 
 			setupAuthUC: func(m *mocks.AuthUseCase) {
-				m.On("Login", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj").Return(
-					func(login, password string) (*domAuth.Tokens, error) {
+				m.On("Login", "the_real_slim_shady", "sdf3kJIS2FgiwefiJCiSJ5#@KJFKj", domAuth.Client{UserAgent: "canteen-test"}).Return(
+					func(login, password string, client domAuth.Client) (*domAuth.Tokens, error) {
 						return &domAuth.Tokens{}, errors.New("error")
 					},
 				).Once()
@@ -356,12 +437,13 @@ func TestAuthHandler_Login(t *testing.T) {
 				tc.setupValidator(validator)
 			}
 
-			router := setupRouterWithAuthUseCase(authUC, time.Duration(30), validator)
+			router := setupRouterWithAuthUseCase(authUC, time.Duration(30), newTestTokenService(), validator)
 
 			bodyBytes, err := json.Marshal(tc.requestBody)
 			req, err := http.NewRequest(http.MethodPost, "/api/auth/login", bytes.NewReader(bodyBytes))
 			require.NoError(t, err)
 			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("User-Agent", "canteen-test")
 
 			w := httptest.NewRecorder()
 			router.ServeHTTP(w, req)
@@ -423,7 +505,7 @@ func TestAuthHandler_Refresh(t *testing.T) {
 			},
 
 			setupAuthUC: func(m *mocks.AuthUseCase) {
-				m.On("Refresh", "refresh_token_old").Return(
+				m.On("Refresh", "refresh_token_old", domAuth.Client{}).Return(
 					&domAuth.Tokens{
 						AccessToken:  "access_token",
 						RefreshToken: "refresh_token",
@@ -456,13 +538,35 @@ func TestAuthHandler_Refresh(t *testing.T) {
 			},
 
 			setupAuthUC: func(m *mocks.AuthUseCase) {
-				m.On("Refresh", "refresh_token_old").Return(&domAuth.Tokens{}, usecase.ErrInvalidRefresh).Once()
+				m.On("Refresh", "refresh_token_old", domAuth.Client{}).Return(&domAuth.Tokens{}, usecase.ErrInvalidRefresh).Once()
 			},
 
 			wantStatusCode: http.StatusUnauthorized,
 			wantErrorText:  "refresh token error",
 		},
 
+		{
+			name: "reused refresh token",
+
+			cookie: http.Cookie{
+				Name:     "refresh_token",
+				Value:    "refresh_token_old",
+				Path:     "/",
+				Domain:   "",
+				Expires:  time.Now().Add(refreshTTL),
+				HttpOnly: true,
+				Secure:   false,
+				SameSite: http.SameSiteLaxMode,
+			},
+
+			setupAuthUC: func(m *mocks.AuthUseCase) {
+				m.On("Refresh", "refresh_token_old", domAuth.Client{}).Return(&domAuth.Tokens{}, usecase.ErrRefreshReused).Once()
+			},
+
+			wantStatusCode: http.StatusUnauthorized,
+			wantErrorText:  "refresh token reused",
+		},
+
 		{
 			name: "internal server error",
 
@@ -478,8 +582,8 @@ func TestAuthHandler_Refresh(t *testing.T) {
 			},
 
 			setupAuthUC: func(m *mocks.AuthUseCase) {
-				m.On("Refresh", "refresh_token_old").Return(
-					func(refreshToken string) (*domAuth.Tokens, error) {
+				m.On("Refresh", "refresh_token_old", domAuth.Client{}).Return(
+					func(refreshToken string, client domAuth.Client) (*domAuth.Tokens, error) {
 						return &domAuth.Tokens{}, errors.New("error")
 					},
 				).Once()
@@ -500,7 +604,7 @@ func TestAuthHandler_Refresh(t *testing.T) {
 
 			validator := mocks.NewValidator(t)
 
-			router := setupRouterWithAuthUseCase(authUC, time.Duration(30), validator)
+			router := setupRouterWithAuthUseCase(authUC, time.Duration(30), newTestTokenService(), validator)
 
 			bodyBytes, err := json.Marshal(tc.requestBody)
 			req, err := http.NewRequest(http.MethodGet, "/api/auth/refresh", bytes.NewReader(bodyBytes))
@@ -615,7 +719,7 @@ func TestAuthHandler_Logout(t *testing.T) {
 
 			validator := mocks.NewValidator(t)
 
-			router := setupRouterWithAuthUseCase(authUC, time.Duration(30), validator)
+			router := setupRouterWithAuthUseCase(authUC, time.Duration(30), newTestTokenService(), validator)
 
 			bodyBytes, err := json.Marshal(tc.requestBody)
 			req, err := http.NewRequest(http.MethodPost, "/api/auth/logout", bytes.NewReader(bodyBytes))
@@ -646,3 +750,230 @@ func TestAuthHandler_Logout(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthHandler_SecureCookies(t *testing.T) {
+	for _, secure := range []bool{false, true} {
+		t.Run(fmt.Sprintf("secure=%v", secure), func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			authUC := mocks.NewAuthUseCase(t)
+			authUC.On("RevokeRefreshToken", "refresh_token").Return(nil).Once()
+
+			r := gin.New()
+			r.Use(common.SecureCookies(secure))
+			NewAuthHandler(r, authUC, time.Hour, noLimit, newTestTokenService(), mocks.NewValidator(t))
+
+			req, err := http.NewRequest(http.MethodPost, "/api/auth/logout", nil)
+			require.NoError(t, err)
+			req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "refresh_token"})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			cookies := w.Result().Cookies()
+			require.NotEmpty(t, cookies)
+			assert.Equal(t, secure, cookies[0].Secure)
+		})
+	}
+}
+
+func TestAuthHandler_ListSessions(t *testing.T) {
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		cookie         string
+		setupAuthUC    func(m *mocks.AuthUseCase)
+		wantStatusCode int
+		wantCurrent    []bool
+	}{
+		{
+			name:   "current session is marked",
+			cookie: "refresh_token",
+
+			setupAuthUC: func(m *mocks.AuthUseCase) {
+				m.On("ListSessions", domUser.UserID(1), "refresh_token").Return([]domAuth.Session{
+					{ID: "laptop", UserID: 1, Client: domAuth.Client{UserAgent: "Firefox", IP: "192.0.2.1"}, CreatedAt: created, LastUsedAt: created, ExpiresAt: created.Add(time.Hour), Current: true},
+					{ID: "phone", UserID: 1, CreatedAt: created, LastUsedAt: created, ExpiresAt: created.Add(time.Hour)},
+				}, nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+			wantCurrent:    []bool{true, false},
+		},
+
+		{
+			name: "without refresh cookie",
+
+			setupAuthUC: func(m *mocks.AuthUseCase) {
+				m.On("ListSessions", domUser.UserID(1), "").Return([]domAuth.Session{}, nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+			wantCurrent:    []bool{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			authUC := mocks.NewAuthUseCase(t)
+
+			if tc.setupAuthUC != nil {
+				tc.setupAuthUC(authUC)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithAuthUseCase(authUC, time.Hour, tokenSvc, mocks.NewValidator(t))
+
+			req, err := http.NewRequest(http.MethodGet, "/api/auth/sessions", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", bearer(t, tokenSvc, "student"))
+			if tc.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: "refresh_token", Value: tc.cookie})
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp []SessionResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			current := make([]bool, 0, len(resp))
+			for _, session := range resp {
+				current = append(current, session.Current)
+			}
+			assert.Equal(t, tc.wantCurrent, current)
+
+			authUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthHandler_RevokeSessions(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		role           string
+		setupAuthUC    func(m *mocks.AuthUseCase)
+		wantStatusCode int
+		wantErrorText  string
+		wantCleared    bool
+	}{
+		{
+			name:   "revoke one session",
+			method: http.MethodDelete,
+			path:   "/api/auth/sessions/phone",
+			role:   "student",
+
+			setupAuthUC: func(m *mocks.AuthUseCase) {
+				m.On("RevokeSession", domUser.UserID(1), "phone").Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusNoContent,
+		},
+
+		{
+			name:   "session of another user",
+			method: http.MethodDelete,
+			path:   "/api/auth/sessions/foreign",
+			role:   "student",
+
+			setupAuthUC: func(m *mocks.AuthUseCase) {
+				m.On("RevokeSession", domUser.UserID(1), "foreign").Return(usecase.ErrSessionNotFound).Once()
+			},
+
+			wantStatusCode: http.StatusNotFound,
+			wantErrorText:  "session not found",
+		},
+
+		{
+			name:   "log out everywhere",
+			method: http.MethodDelete,
+			path:   "/api/auth/sessions",
+			role:   "student",
+
+			setupAuthUC: func(m *mocks.AuthUseCase) {
+				m.On("RevokeAllSessions", domUser.UserID(1)).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusNoContent,
+			wantCleared:    true,
+		},
+
+		{
+			name:   "admin revokes sessions of a user",
+			method: http.MethodDelete,
+			path:   "/api/admin/users/5/sessions",
+			role:   "admin",
+
+			setupAuthUC: func(m *mocks.AuthUseCase) {
+				m.On("RevokeAllSessions", domUser.UserID(5)).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusNoContent,
+		},
+
+		{
+			name:   "admin revokes sessions of an unknown user",
+			method: http.MethodDelete,
+			path:   "/api/admin/users/404/sessions",
+			role:   "admin",
+
+			setupAuthUC: func(m *mocks.AuthUseCase) {
+				m.On("RevokeAllSessions", domUser.UserID(404)).Return(usecase.ErrUserNotFound).Once()
+			},
+
+			wantStatusCode: http.StatusNotFound,
+			wantErrorText:  "user not found",
+		},
+
+		{
+			name:   "student cannot revoke sessions of others",
+			method: http.MethodDelete,
+			path:   "/api/admin/users/5/sessions",
+			role:   "student",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			authUC := mocks.NewAuthUseCase(t)
+
+			if tc.setupAuthUC != nil {
+				tc.setupAuthUC(authUC)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithAuthUseCase(authUC, time.Hour, tokenSvc, mocks.NewValidator(t))
+
+			req, err := http.NewRequest(tc.method, tc.path, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			if tc.wantErrorText != "" {
+				var resp map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			}
+
+			cleared := false
+			for _, cookie := range w.Result().Cookies() {
+				cleared = cleared || (cookie.Name == "refresh_token" && cookie.MaxAge < 0)
+			}
+			assert.Equal(t, tc.wantCleared, cleared)
+
+			authUC.AssertExpectations(t)
+		})
+	}
+}
@@ -23,6 +23,162 @@ type RefreshTokenErrorResponse struct {
 	Error string `json:"error" example:"refresh token error"`
 }
 
+type SessionNotFoundErrorResponse struct {
+	Error string `json:"error" example:"session not found"`
+}
+
+type EmailInUseErrorResponse struct {
+	Error string `json:"error" example:"email already in use"`
+}
+
+type UserBlockedErrorResponse struct {
+	Error string `json:"error" example:"user blocked"`
+}
+
+type TooManyAttemptsErrorResponse struct {
+	Error string `json:"error" example:"too many sign-in attempts, try again later"`
+}
+
+type AccountLockedErrorResponse struct {
+	Error string `json:"error" example:"account temporarily locked"`
+}
+
+type TooManyRequestsErrorResponse struct {
+	Error string `json:"error" example:"too many requests"`
+}
+
+type OwnAccountErrorResponse struct {
+	Error string `json:"error" example:"cannot block, demote or delete your own account"`
+}
+
+type InvitationInvalidErrorResponse struct {
+	Error string `json:"error" example:"invalid invitation code"`
+}
+
+type InvitationNotFoundErrorResponse struct {
+	Error string `json:"error" example:"invitation not found"`
+}
+
+type PasswordTokenErrorResponse struct {
+	Error string `json:"error" example:"invalid or expired set-password token"`
+}
+
 type ValidationErrorResponse struct {
 	Error string `json:"error" example:"validation error"`
 }
+
+type UnauthorizedErrorResponse struct {
+	Error string `json:"error" example:"invalid token"`
+}
+
+type ForbiddenErrorResponse struct {
+	Error string `json:"error" example:"forbidden"`
+}
+
+type DishNotFoundErrorResponse struct {
+	Error string `json:"error" example:"dish not found"`
+}
+
+type MenuNotFoundErrorResponse struct {
+	Error string `json:"error" example:"menu not found"`
+}
+
+type MenuExistsErrorResponse struct {
+	Error string `json:"error" example:"menu already exists"`
+}
+
+type OrderNotFoundErrorResponse struct {
+	Error string `json:"error" example:"order not found"`
+}
+
+type OrderStatusErrorResponse struct {
+	Error string `json:"error" example:"invalid order status transition"`
+}
+
+type DishNotInMenuErrorResponse struct {
+	Error string `json:"error" example:"dish is not in menu"`
+}
+
+type MenuClosedErrorResponse struct {
+	Error string `json:"error" example:"menu is closed for orders"`
+}
+
+type InsufficientFundsErrorResponse struct {
+	Error string `json:"error" example:"insufficient funds"`
+}
+
+type InvalidAmountErrorResponse struct {
+	Error string `json:"error" example:"invalid amount"`
+}
+
+type UserNotFoundErrorResponse struct {
+	Error string `json:"error" example:"user not found"`
+}
+
+type PaymentNotFoundErrorResponse struct {
+	Error string `json:"error" example:"payment not found"`
+}
+
+type InvalidSignatureErrorResponse struct {
+	Error string `json:"error" example:"invalid webhook signature"`
+}
+
+type InvalidWebhookErrorResponse struct {
+	Error string `json:"error" example:"invalid webhook payload"`
+}
+
+type PlanNotFoundErrorResponse struct {
+	Error string `json:"error" example:"subscription plan not found"`
+}
+
+type InvalidPlanErrorResponse struct {
+	Error string `json:"error" example:"invalid subscription plan"`
+}
+
+type PlanExpiredErrorResponse struct {
+	Error string `json:"error" example:"subscription plan has expired"`
+}
+
+type UnknownAllergenErrorResponse struct {
+	Error string `json:"error" example:"unknown allergen"`
+}
+
+type AllergenConflictErrorResponse struct {
+	Error string `json:"error" example:"order contains allergens from dietary profile, confirmation required"`
+}
+
+type ReviewNotFoundErrorResponse struct {
+	Error string `json:"error" example:"review not found"`
+}
+
+type ReviewNotAllowedErrorResponse struct {
+	Error string `json:"error" example:"only dishes from issued orders can be reviewed"`
+}
+
+type AlreadyReviewedErrorResponse struct {
+	Error string `json:"error" example:"dish already reviewed for this order"`
+}
+
+type ProductNotFoundErrorResponse struct {
+	Error string `json:"error" example:"product not found"`
+}
+
+type ProductExistsErrorResponse struct {
+	Error string `json:"error" example:"product already exists"`
+}
+
+type InsufficientStockErrorResponse struct {
+	Error string `json:"error" example:"insufficient stock"`
+}
+
+type PurchaseRequestNotFoundErrorResponse struct {
+	Error string `json:"error" example:"purchase request not found"`
+}
+
+type PurchaseStatusErrorResponse struct {
+	Error string `json:"error" example:"invalid purchase request status transition"`
+}
+
+type NotRequestAuthorErrorResponse struct {
+	Error string `json:"error" example:"only the author can change a purchase request"`
+}
@@ -1,7 +1,10 @@
 package api
 
 import (
+	"strconv"
+
 	"canteen-app/internal/adapter/http/common"
+	domUser "canteen-app/internal/domain/user"
 
 	"github.com/gin-gonic/gin"
 )
@@ -10,3 +13,24 @@ func writeError(c *gin.Context, err error) {
 	status, msg := common.ErrorToHTTP(err)
 	c.JSON(status, gin.H{"error": msg})
 }
+
+func parseIDParam(c *gin.Context, name string) (int64, error) {
+	id, err := strconv.ParseInt(c.Param(name), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, common.ErrInvalidRequest
+	}
+	return id, nil
+}
+
+func currentUserID(c *gin.Context) (domUser.UserID, error) {
+	val, ok := c.Get("userID")
+	if !ok {
+		return 0, common.ErrNoUserInContext
+	}
+
+	userID, ok := val.(domUser.UserID)
+	if !ok {
+		return 0, common.ErrNoUserInContext
+	}
+	return userID, nil
+}
@@ -0,0 +1,353 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+	domInventory "canteen-app/internal/domain/inventory"
+	domMenu "canteen-app/internal/domain/menu"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InventoryHandler struct {
+	inventory common.InventoryUseCase
+	validator common.Validator
+}
+
+func NewInventoryHandler(router *gin.Engine, inventory common.InventoryUseCase, tokenSvc usecase.TokenService, validator common.Validator) {
+	handler := &InventoryHandler{
+		inventory: inventory,
+		validator: validator,
+	}
+
+	{
+		inventory := router.Group("/api/inventory", AuthMiddleware(tokenSvc), common.RequirePermission(domUser.PermInventoryManage))
+		inventory.GET("/products", handler.ListProducts)
+		inventory.POST("/products", handler.CreateProduct)
+		inventory.GET("/products/:id", handler.GetProduct)
+		inventory.PUT("/products/:id", handler.UpdateProduct)
+		inventory.GET("/products/:id/movements", handler.ListMovements)
+		inventory.POST("/products/:id/movements", handler.RecordMovement)
+		inventory.GET("/low-stock", handler.LowStock)
+	}
+}
+
+type ProductResponse struct {
+	ID          int64   `json:"id" example:"1"`
+	Name        string  `json:"name" example:"Мука пшеничная"`
+	Unit        string  `json:"unit" example:"kg"`
+	Quantity    float64 `json:"quantity" example:"42.5"`
+	MinQuantity float64 `json:"min_quantity" example:"10"`
+	Low         bool    `json:"low" example:"false"`
+}
+
+type MovementResponse struct {
+	ID        int64     `json:"id" example:"1"`
+	ProductID int64     `json:"product_id" example:"1"`
+	Kind      string    `json:"kind" example:"receipt"`
+	Quantity  float64   `json:"quantity" example:"25.5"`
+	DishID    int64     `json:"dish_id,omitempty" example:"0"`
+	AuthorID  int64     `json:"author_id" example:"1"`
+	Comment   string    `json:"comment" example:"Поставка от 19.10"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func toProductResponse(product domInventory.Product) ProductResponse {
+	return ProductResponse{
+		ID:          int64(product.ID),
+		Name:        product.Name,
+		Unit:        string(product.Unit),
+		Quantity:    product.Quantity,
+		MinQuantity: product.MinQuantity,
+		Low:         product.IsLow(),
+	}
+}
+
+func toProductResponses(products []domInventory.Product) []ProductResponse {
+	resp := make([]ProductResponse, 0, len(products))
+	for _, product := range products {
+		resp = append(resp, toProductResponse(product))
+	}
+	return resp
+}
+
+func toMovementResponse(movement domInventory.Movement) MovementResponse {
+	return MovementResponse{
+		ID:        int64(movement.ID),
+		ProductID: int64(movement.ProductID),
+		Kind:      string(movement.Kind),
+		Quantity:  movement.Quantity,
+		DishID:    int64(movement.DishID),
+		AuthorID:  int64(movement.AuthorID),
+		Comment:   movement.Comment,
+		CreatedAt: movement.CreatedAt,
+	}
+}
+
+// ListProducts godoc
+//
+//	@Summary		Склад
+//	@Description	Возвращает все продукты на складе с текущими остатками. Доступно сотрудникам столовой и администраторам.
+//	@Tags			inventory
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		ProductResponse				"Список продуктов"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/inventory/products [get]
+func (ih *InventoryHandler) ListProducts(c *gin.Context) {
+	products, err := ih.inventory.ListProducts()
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toProductResponses(products))
+}
+
+// LowStock godoc
+//
+//	@Summary		Заканчивающиеся продукты
+//	@Description	Возвращает продукты, остаток которых не превышает минимальный. Доступно сотрудникам столовой и администраторам.
+//	@Tags			inventory
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		ProductResponse				"Список продуктов"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/inventory/low-stock [get]
+func (ih *InventoryHandler) LowStock(c *gin.Context) {
+	products, err := ih.inventory.LowStock()
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toProductResponses(products))
+}
+
+// GetProduct godoc
+//
+//	@Summary		Продукт
+//	@Description	Возвращает продукт и его текущий остаток. Доступно сотрудникам столовой и администраторам.
+//	@Tags			inventory
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int								true	"Идентификатор продукта"
+//	@Success		200	{object}	ProductResponse					"Продукт"
+//	@Failure		400	{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		404	{object}	ProductNotFoundErrorResponse	"Продукт не найден"
+//	@Failure		500	{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/inventory/products/{id} [get]
+func (ih *InventoryHandler) GetProduct(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	product, err := ih.inventory.GetProduct(domInventory.ProductID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toProductResponse(*product))
+}
+
+// CreateProduct godoc
+//
+//	@Summary		Добавление продукта
+//	@Description	Добавляет продукт на склад с нулевым остатком. Остаток пополняется движением receipt. Доступно сотрудникам столовой и администраторам.
+//	@Tags			inventory
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			input	body		common.ProductRequest			true	"Название, единица измерения и минимальный остаток"
+//	@Success		201		{object}	ProductResponse					"Продукт добавлен"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		409		{object}	ProductExistsErrorResponse		"Продукт с таким названием уже есть"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/inventory/products [post]
+func (ih *InventoryHandler) CreateProduct(c *gin.Context) {
+	var req common.ProductRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ih.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	product, err := ih.inventory.CreateProduct(domInventory.Product{
+		Name:        req.Name,
+		Unit:        domInventory.Unit(req.Unit),
+		MinQuantity: req.MinQuantity,
+	})
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toProductResponse(*product))
+}
+
+// UpdateProduct godoc
+//
+//	@Summary		Редактирование продукта
+//	@Description	Изменяет название, единицу измерения или минимальный остаток продукта. Доступно сотрудникам столовой и администраторам.
+//	@Tags			inventory
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int								true	"Идентификатор продукта"
+//	@Param			input	body		common.ProductRequest			true	"Название, единица измерения и минимальный остаток"
+//	@Success		200		{object}	ProductResponse					"Продукт обновлен"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		404		{object}	ProductNotFoundErrorResponse	"Продукт не найден"
+//	@Failure		409		{object}	ProductExistsErrorResponse		"Продукт с таким названием уже есть"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/inventory/products/{id} [put]
+func (ih *InventoryHandler) UpdateProduct(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.ProductRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ih.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	product, err := ih.inventory.UpdateProduct(domInventory.Product{
+		ID:          domInventory.ProductID(id),
+		Name:        req.Name,
+		Unit:        domInventory.Unit(req.Unit),
+		MinQuantity: req.MinQuantity,
+	})
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toProductResponse(*product))
+}
+
+// ListMovements godoc
+//
+//	@Summary		Движения продукта
+//	@Description	Возвращает историю поступлений, списаний и расхода продукта. Доступно сотрудникам столовой и администраторам.
+//	@Tags			inventory
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int								true	"Идентификатор продукта"
+//	@Success		200	{array}		MovementResponse				"Список движений"
+//	@Failure		400	{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		404	{object}	ProductNotFoundErrorResponse	"Продукт не найден"
+//	@Failure		500	{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/inventory/products/{id}/movements [get]
+func (ih *InventoryHandler) ListMovements(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	movements, err := ih.inventory.ListMovements(domInventory.ProductID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	resp := make([]MovementResponse, 0, len(movements))
+	for _, movement := range movements {
+		resp = append(resp, toMovementResponse(movement))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// RecordMovement godoc
+//
+//	@Summary		Движение продукта
+//	@Description	Проводит поступление (receipt), списание (write_off) или расход на блюдо (consumption). Количество указывается положительным числом. Доступно сотрудникам столовой и администраторам.
+//	@Tags			inventory
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int								true	"Идентификатор продукта"
+//	@Param			input	body		common.MovementRequest			true	"Вид движения, количество и блюдо для расхода"
+//	@Success		201		{object}	MovementResponse				"Движение проведено"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		404		{object}	ProductNotFoundErrorResponse	"Продукт не найден"
+//	@Failure		404		{object}	DishNotFoundErrorResponse		"Блюдо не найдено"
+//	@Failure		409		{object}	InsufficientStockErrorResponse	"Недостаточно продукта на складе"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/inventory/products/{id}/movements [post]
+func (ih *InventoryHandler) RecordMovement(c *gin.Context) {
+	authorID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.MovementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ih.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	movement, err := ih.inventory.RecordMovement(domInventory.Movement{
+		ProductID: domInventory.ProductID(id),
+		Kind:      domInventory.MovementKind(req.Kind),
+		Quantity:  req.Quantity,
+		DishID:    domMenu.DishID(req.DishID),
+		AuthorID:  authorID,
+		Comment:   req.Comment,
+	})
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toMovementResponse(*movement))
+}
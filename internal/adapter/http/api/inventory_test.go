@@ -0,0 +1,154 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"canteen-app/internal/adapter/http/api/mocks"
+	"canteen-app/internal/adapter/http/common"
+	domInventory "canteen-app/internal/domain/inventory"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRouterWithInventoryUseCase(inventoryUC *mocks.InventoryUseCase, tokenSvc usecase.TokenService, validator *mocks.Validator) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	NewInventoryHandler(r, inventoryUC, tokenSvc, validator)
+
+	return r
+}
+
+func TestInventoryHandler_RecordMovement(t *testing.T) {
+	requestBody := map[string]interface{}{
+		"kind":     "write_off",
+		"quantity": 2.5,
+		"comment":  "spoiled",
+	}
+	validRequest := common.MovementRequest{Kind: "write_off", Quantity: 2.5, Comment: "spoiled"}
+	movement := domInventory.Movement{
+		ProductID: 3,
+		Kind:      domInventory.WriteOff,
+		Quantity:  2.5,
+		AuthorID:  domUser.UserID(1),
+		Comment:   "spoiled",
+	}
+
+	tests := []struct {
+		name             string
+		role             string
+		setupInventoryUC func(m *mocks.InventoryUseCase)
+		setupValidator   func(m *mocks.Validator)
+		wantStatusCode   int
+		wantErrorText    string
+	}{
+		{
+			name: "success",
+			role: "employee",
+
+			setupInventoryUC: func(m *mocks.InventoryUseCase) {
+				recorded := movement
+				recorded.ID = 1
+				recorded.Quantity = -2.5
+				m.On("RecordMovement", movement).Return(&recorded, nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusCreated,
+		},
+
+		{
+			name: "insufficient stock",
+			role: "admin",
+
+			setupInventoryUC: func(m *mocks.InventoryUseCase) {
+				m.On("RecordMovement", movement).Return(nil, usecase.ErrInsufficientStock).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "insufficient stock",
+		},
+
+		{
+			name: "product not found",
+			role: "employee",
+
+			setupInventoryUC: func(m *mocks.InventoryUseCase) {
+				m.On("RecordMovement", movement).Return(nil, usecase.ErrProductNotFound).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusNotFound,
+			wantErrorText:  "product not found",
+		},
+
+		{
+			name: "student is forbidden",
+			role: "student",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			inventoryUC := mocks.NewInventoryUseCase(t)
+
+			if tc.setupInventoryUC != nil {
+				tc.setupInventoryUC(inventoryUC)
+			}
+
+			validator := mocks.NewValidator(t)
+
+			if tc.setupValidator != nil {
+				tc.setupValidator(validator)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithInventoryUseCase(inventoryUC, tokenSvc, validator)
+
+			bodyBytes, err := json.Marshal(requestBody)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/api/inventory/products/3/movements", bytes.NewReader(bodyBytes))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, "write_off", resp["kind"])
+				assert.Equal(t, -2.5, resp["quantity"])
+			}
+
+			inventoryUC.AssertExpectations(t)
+		})
+	}
+}
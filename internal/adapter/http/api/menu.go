@@ -0,0 +1,489 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+	domMenu "canteen-app/internal/domain/menu"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MenuHandler struct {
+	menu      common.MenuUseCase
+	profiles  common.ProfileUseCase
+	reviews   common.ReviewUseCase
+	validator common.Validator
+}
+
+func NewMenuHandler(
+	router *gin.Engine,
+	menu common.MenuUseCase,
+	profiles common.ProfileUseCase,
+	reviews common.ReviewUseCase,
+	tokenSvc usecase.TokenService,
+	validator common.Validator,
+) {
+	handler := &MenuHandler{
+		menu:      menu,
+		profiles:  profiles,
+		reviews:   reviews,
+		validator: validator,
+	}
+
+	{
+		menu := router.Group("/api/menu", AuthMiddleware(tokenSvc))
+		menu.GET("", handler.GetDayMenu)
+		menu.GET("/:id", handler.GetMenu)
+		menu.GET("/dishes", handler.ListDishes)
+
+		edit := menu.Group("", common.RequirePermission(domUser.PermMenuEdit))
+		edit.POST("", handler.CreateMenu)
+		edit.PUT("/:id", handler.UpdateMenu)
+		edit.POST("/dishes", handler.CreateDish)
+		edit.PUT("/dishes/:id", handler.UpdateDish)
+	}
+}
+
+type DishResponse struct {
+	ID          int64    `json:"id" example:"1"`
+	Name        string   `json:"name" example:"Борщ"`
+	Description string   `json:"description" example:"Борщ со сметаной"`
+	Price       int64    `json:"price" example:"12000"`
+	Weight      int      `json:"weight" example:"250"`
+	Allergens   []string `json:"allergens" example:"gluten,lactose"`
+	// Conflicts lists the dish allergens from the dietary profile of the
+	// current student.
+	Conflicts []string `json:"conflicts,omitempty" example:"lactose"`
+	// Rating is omitted for dishes without reviews.
+	Rating *RatingResponse `json:"rating,omitempty"`
+	// PlannedPortions is the number of portions the kitchen plans to cook
+	// for the menu.
+	PlannedPortions int `json:"planned_portions,omitempty" example:"120"`
+}
+
+type MenuResponse struct {
+	ID       int64          `json:"id" example:"1"`
+	Date     string         `json:"date" example:"2026-10-19"`
+	MealType string         `json:"meal_type" example:"lunch"`
+	Dishes   []DishResponse `json:"dishes"`
+}
+
+func toDishResponse(dish domMenu.Dish) DishResponse {
+	return DishResponse{
+		ID:          int64(dish.ID),
+		Name:        dish.Name,
+		Description: dish.Description,
+		Price:       dish.Price,
+		Weight:      dish.Weight,
+		Allergens:   toAllergenStrings(dish.Allergens),
+	}
+}
+
+// toMenuResponse flags the dishes that conflict with the given allergens or
+// drops them when hideConflicting is set.
+func toMenuResponse(menu domMenu.Menu, allergens []domMenu.Allergen, hideConflicting bool) MenuResponse {
+	dishes := make([]DishResponse, 0, len(menu.Dishes))
+	for _, dish := range menu.Dishes {
+		conflicts := dish.Conflicts(allergens)
+		if len(conflicts) > 0 && hideConflicting {
+			continue
+		}
+
+		resp := toDishResponse(dish)
+		resp.PlannedPortions = menu.Portions[dish.ID]
+		if len(conflicts) > 0 {
+			resp.Conflicts = toAllergenStrings(conflicts)
+		}
+		dishes = append(dishes, resp)
+	}
+
+	return MenuResponse{
+		ID:       int64(menu.ID),
+		Date:     menu.Date.Format(common.DateLayout),
+		MealType: string(menu.MealType),
+		Dishes:   dishes,
+	}
+}
+
+func toAllergenStrings(allergens []domMenu.Allergen) []string {
+	resp := make([]string, 0, len(allergens))
+	for _, a := range allergens {
+		resp = append(resp, string(a))
+	}
+	return resp
+}
+
+func toAllergens(raw []string) []domMenu.Allergen {
+	allergens := make([]domMenu.Allergen, 0, len(raw))
+	for _, a := range raw {
+		allergens = append(allergens, domMenu.Allergen(a))
+	}
+	return allergens
+}
+
+// studentAllergens returns the allergens from the dietary profile of the
+// current user if the role keeps one.
+func (mh *MenuHandler) studentAllergens(c *gin.Context) ([]domMenu.Allergen, error) {
+	if !common.CurrentRole(c).Can(domUser.PermProfileEdit) {
+		return nil, nil
+	}
+
+	userID, err := currentUserID(c)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := mh.profiles.GetProfile(userID)
+	if err != nil {
+		return nil, err
+	}
+	return profile.Allergens, nil
+}
+
+// attachRatings fills in the aggregate ratings of the dishes in place.
+func (mh *MenuHandler) attachRatings(lists ...[]DishResponse) error {
+	var ids []domMenu.DishID
+	for _, dishes := range lists {
+		for _, dish := range dishes {
+			ids = append(ids, domMenu.DishID(dish.ID))
+		}
+	}
+
+	ratings, err := mh.reviews.Ratings(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, dishes := range lists {
+		for i := range dishes {
+			if rating, ok := ratings[domMenu.DishID(dishes[i].ID)]; ok {
+				dishes[i].Rating = &RatingResponse{Average: rating.Average, Count: rating.Count}
+			}
+		}
+	}
+	return nil
+}
+
+func toDishIDs(ids []int64) []domMenu.DishID {
+	dishIDs := make([]domMenu.DishID, 0, len(ids))
+	for _, id := range ids {
+		dishIDs = append(dishIDs, domMenu.DishID(id))
+	}
+	return dishIDs
+}
+
+// GetDayMenu godoc
+//
+//	@Summary		Меню на день
+//	@Description	Возвращает меню завтрака и обеда на указанную дату (по умолчанию — на сегодня) со средней оценкой блюд. Для учеников блюда с аллергенами из профиля питания помечаются полем conflicts или скрываются.
+//	@Tags			menu
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			date				query		string						false	"Дата в формате YYYY-MM-DD"
+//	@Param			hide_conflicting	query		bool						false	"Скрыть блюда с аллергенами из профиля питания"
+//	@Success		200		{array}		MenuResponse				"Меню на день"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/menu [get]
+func (mh *MenuHandler) GetDayMenu(c *gin.Context) {
+	date := time.Now()
+	if raw := c.Query("date"); raw != "" {
+		parsed, err := time.Parse(common.DateLayout, raw)
+		if err != nil {
+			writeError(c, common.ErrInvalidRequest)
+			return
+		}
+		date = parsed
+	}
+
+	menus, err := mh.menu.GetDayMenu(date)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	allergens, err := mh.studentAllergens(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	hide := c.Query("hide_conflicting") == "true"
+	resp := make([]MenuResponse, 0, len(menus))
+	lists := make([][]DishResponse, 0, len(menus))
+	for _, menu := range menus {
+		resp = append(resp, toMenuResponse(menu, allergens, hide))
+		lists = append(lists, resp[len(resp)-1].Dishes)
+	}
+
+	if err := mh.attachRatings(lists...); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetMenu godoc
+//
+//	@Summary		Меню по идентификатору
+//	@Description	Возвращает меню завтрака или обеда по его идентификатору со средней оценкой блюд. Для учеников блюда с аллергенами из профиля питания помечаются полем conflicts или скрываются.
+//	@Tags			menu
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id					path		int							true	"Идентификатор меню"
+//	@Param			hide_conflicting	query		bool						false	"Скрыть блюда с аллергенами из профиля питания"
+//	@Success		200	{object}	MenuResponse				"Меню"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		404	{object}	MenuNotFoundErrorResponse	"Меню не найдено"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/menu/{id} [get]
+func (mh *MenuHandler) GetMenu(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	menu, err := mh.menu.GetMenu(domMenu.MenuID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	allergens, err := mh.studentAllergens(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	resp := toMenuResponse(*menu, allergens, c.Query("hide_conflicting") == "true")
+	if err := mh.attachRatings(resp.Dishes); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateMenu godoc
+//
+//	@Summary		Создание меню
+//	@Description	Создает меню завтрака или обеда на указанную дату. Доступно сотрудникам столовой и администраторам.
+//	@Tags			menu
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			input	body		common.MenuRequest			true	"Дата, тип приема пищи и блюда"
+//	@Success		201		{object}	MenuResponse				"Меню создано"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse		"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404		{object}	DishNotFoundErrorResponse	"Блюдо не найдено"
+//	@Failure		409		{object}	MenuExistsErrorResponse		"Меню на эту дату уже существует"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/menu [post]
+func (mh *MenuHandler) CreateMenu(c *gin.Context) {
+	var req common.MenuRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := mh.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	date, err := time.Parse(common.DateLayout, req.Date)
+	if err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	menu, err := mh.menu.CreateMenu(date, domMenu.MealType(req.MealType), toDishIDs(req.DishIDs))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toMenuResponse(*menu, nil, false))
+}
+
+// UpdateMenu godoc
+//
+//	@Summary		Редактирование меню
+//	@Description	Заменяет список блюд в меню. Доступно сотрудникам столовой и администраторам.
+//	@Tags			menu
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int							true	"Идентификатор меню"
+//	@Param			input	body		common.UpdateMenuRequest	true	"Блюда"
+//	@Success		200		{object}	MenuResponse				"Меню обновлено"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse		"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404		{object}	MenuNotFoundErrorResponse	"Меню или блюдо не найдено"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/menu/{id} [put]
+func (mh *MenuHandler) UpdateMenu(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.UpdateMenuRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := mh.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	menu, err := mh.menu.UpdateMenu(domMenu.MenuID(id), toDishIDs(req.DishIDs))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toMenuResponse(*menu, nil, false))
+}
+
+// ListDishes godoc
+//
+//	@Summary		Список блюд
+//	@Description	Возвращает все блюда, из которых составляется меню, со средней оценкой по отзывам.
+//	@Tags			menu
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		DishResponse				"Список блюд"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/menu/dishes [get]
+func (mh *MenuHandler) ListDishes(c *gin.Context) {
+	dishes, err := mh.menu.ListDishes()
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	resp := make([]DishResponse, 0, len(dishes))
+	for _, dish := range dishes {
+		resp = append(resp, toDishResponse(dish))
+	}
+
+	if err := mh.attachRatings(resp); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateDish godoc
+//
+//	@Summary		Создание блюда
+//	@Description	Добавляет блюдо, которое затем можно включить в меню. Доступно сотрудникам столовой и администраторам.
+//	@Tags			menu
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			input	body		common.DishRequest			true	"Данные блюда"
+//	@Success		201		{object}	DishResponse				"Блюдо создано"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse		"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/menu/dishes [post]
+func (mh *MenuHandler) CreateDish(c *gin.Context) {
+	var req common.DishRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := mh.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	dish, err := mh.menu.CreateDish(domMenu.Dish{
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		Weight:      req.Weight,
+		Allergens:   toAllergens(req.Allergens),
+	})
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toDishResponse(*dish))
+}
+
+// UpdateDish godoc
+//
+//	@Summary		Редактирование блюда
+//	@Description	Изменяет название, описание, цену, вес порции или аллергены блюда. Доступно сотрудникам столовой и администраторам.
+//	@Tags			menu
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int							true	"Идентификатор блюда"
+//	@Param			input	body		common.DishRequest			true	"Данные блюда"
+//	@Success		200		{object}	DishResponse				"Блюдо обновлено"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse		"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404		{object}	DishNotFoundErrorResponse	"Блюдо не найдено"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/menu/dishes/{id} [put]
+func (mh *MenuHandler) UpdateDish(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.DishRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := mh.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	dish, err := mh.menu.UpdateDish(domMenu.Dish{
+		ID:          domMenu.DishID(id),
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		Weight:      req.Weight,
+		Allergens:   toAllergens(req.Allergens),
+	})
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toDishResponse(*dish))
+}
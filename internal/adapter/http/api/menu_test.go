@@ -0,0 +1,368 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"canteen-app/internal/adapter/http/api/mocks"
+	"canteen-app/internal/adapter/http/common"
+	jwtadapter "canteen-app/internal/adapter/jwt"
+	domMenu "canteen-app/internal/domain/menu"
+	domReview "canteen-app/internal/domain/review"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTokenService() *jwtadapter.JWTTokenService {
+	return jwtadapter.NewJWTTokenService([]byte("access"), []byte("refresh"), time.Hour, time.Hour, "test")
+}
+
+func bearer(t *testing.T, tokenSvc *jwtadapter.JWTTokenService, role string) string {
+	token, err := tokenSvc.GenerateAccessToken(1, domUser.Role(role))
+	require.NoError(t, err)
+	return "Bearer " + token
+}
+
+func setupRouterWithMenuUseCase(
+	menuUC *mocks.MenuUseCase,
+	profileUC *mocks.ProfileUseCase,
+	reviewUC *mocks.ReviewUseCase,
+	tokenSvc usecase.TokenService,
+	validator *mocks.Validator,
+) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	NewMenuHandler(r, menuUC, profileUC, reviewUC, tokenSvc, validator)
+
+	return r
+}
+
+func TestMenuHandler_GetDayMenu(t *testing.T) {
+	date := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	dayMenu := []domMenu.Menu{
+		{
+			ID:       1,
+			Date:     date,
+			MealType: domMenu.Lunch,
+			Dishes: []domMenu.Dish{
+				{ID: 1, Name: "Борщ", Price: 12000, Weight: 250, Allergens: []domMenu.Allergen{domMenu.Lactose}},
+				{ID: 2, Name: "Компот", Price: 3000, Weight: 200},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		role           string
+		setupMenuUC    func(m *mocks.MenuUseCase)
+		setupProfileUC func(m *mocks.ProfileUseCase)
+		ratings        map[domMenu.DishID]domReview.Rating
+		wantStatusCode int
+		wantErrorText  string
+		wantDishes     []string
+		wantConflicts  []string
+		wantRating     *RatingResponse
+	}{
+		{
+			name:  "success",
+			query: "?date=2026-10-19",
+			role:  "student",
+
+			setupMenuUC: func(m *mocks.MenuUseCase) {
+				m.On("GetDayMenu", date).Return(dayMenu, nil).Once()
+			},
+
+			setupProfileUC: func(m *mocks.ProfileUseCase) {
+				m.On("GetProfile", domUser.UserID(1)).Return(&domUser.DietaryProfile{UserID: 1}, nil).Once()
+			},
+
+			ratings: map[domMenu.DishID]domReview.Rating{1: {DishID: 1, Average: 4.5, Count: 2}},
+
+			wantStatusCode: http.StatusOK,
+			wantDishes:     []string{"Борщ", "Компот"},
+			wantRating:     &RatingResponse{Average: 4.5, Count: 2},
+		},
+
+		{
+			name:  "conflicting dish flagged",
+			query: "?date=2026-10-19",
+			role:  "student",
+
+			setupMenuUC: func(m *mocks.MenuUseCase) {
+				m.On("GetDayMenu", date).Return(dayMenu, nil).Once()
+			},
+
+			setupProfileUC: func(m *mocks.ProfileUseCase) {
+				m.On("GetProfile", domUser.UserID(1)).Return(&domUser.DietaryProfile{
+					UserID:    1,
+					Allergens: []domMenu.Allergen{domMenu.Lactose, domMenu.Nuts},
+				}, nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+			wantDishes:     []string{"Борщ", "Компот"},
+			wantConflicts:  []string{"lactose"},
+		},
+
+		{
+			name:  "conflicting dish hidden",
+			query: "?date=2026-10-19&hide_conflicting=true",
+			role:  "student",
+
+			setupMenuUC: func(m *mocks.MenuUseCase) {
+				m.On("GetDayMenu", date).Return(dayMenu, nil).Once()
+			},
+
+			setupProfileUC: func(m *mocks.ProfileUseCase) {
+				m.On("GetProfile", domUser.UserID(1)).Return(&domUser.DietaryProfile{
+					UserID:    1,
+					Allergens: []domMenu.Allergen{domMenu.Lactose},
+				}, nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+			wantDishes:     []string{"Компот"},
+		},
+
+		{
+			name:  "staff is not filtered",
+			query: "?date=2026-10-19&hide_conflicting=true",
+			role:  "employee",
+
+			setupMenuUC: func(m *mocks.MenuUseCase) {
+				m.On("GetDayMenu", date).Return(dayMenu, nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+			wantDishes:     []string{"Борщ", "Компот"},
+		},
+
+		{
+			name:  "invalid date",
+			query: "?date=19.10.2026",
+			role:  "student",
+
+			wantStatusCode: http.StatusBadRequest,
+			wantErrorText:  "invalid request",
+		},
+
+		{
+			name:  "no auth header",
+			query: "?date=2026-10-19",
+
+			wantStatusCode: http.StatusUnauthorized,
+			wantErrorText:  "missing auth header",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			menuUC := mocks.NewMenuUseCase(t)
+
+			if tc.setupMenuUC != nil {
+				tc.setupMenuUC(menuUC)
+			}
+
+			profileUC := mocks.NewProfileUseCase(t)
+
+			if tc.setupProfileUC != nil {
+				tc.setupProfileUC(profileUC)
+			}
+
+			reviewUC := mocks.NewReviewUseCase(t)
+
+			if tc.wantDishes != nil {
+				reviewUC.On("Ratings", mock.Anything).Return(tc.ratings, nil).Once()
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithMenuUseCase(menuUC, profileUC, reviewUC, tokenSvc, mocks.NewValidator(t))
+
+			req, err := http.NewRequest(http.MethodGet, "/api/menu"+tc.query, nil)
+			require.NoError(t, err)
+			if tc.role != "" {
+				req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			if tc.wantErrorText != "" {
+				var resp map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				var resp []MenuResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				require.Len(t, resp, 1)
+				assert.Equal(t, "2026-10-19", resp[0].Date)
+				assert.Equal(t, "lunch", resp[0].MealType)
+
+				var dishes []string
+				for _, dish := range resp[0].Dishes {
+					dishes = append(dishes, dish.Name)
+				}
+				assert.Equal(t, tc.wantDishes, dishes)
+				assert.Equal(t, tc.wantConflicts, resp[0].Dishes[0].Conflicts)
+				assert.Equal(t, tc.wantRating, resp[0].Dishes[0].Rating)
+			}
+
+			menuUC.AssertExpectations(t)
+			profileUC.AssertExpectations(t)
+			reviewUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMenuHandler_CreateMenu(t *testing.T) {
+	date := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	requestBody := map[string]interface{}{
+		"date":      "2026-10-19",
+		"meal_type": "lunch",
+		"dish_ids":  []int64{1, 2},
+	}
+	validRequest := common.MenuRequest{Date: "2026-10-19", MealType: "lunch", DishIDs: []int64{1, 2}}
+
+	tests := []struct {
+		name           string
+		role           string
+		requestBody    map[string]interface{}
+		setupMenuUC    func(m *mocks.MenuUseCase)
+		setupValidator func(m *mocks.Validator)
+		wantStatusCode int
+		wantErrorText  string
+	}{
+		{
+			name:        "success",
+			role:        "employee",
+			requestBody: requestBody,
+
+			setupMenuUC: func(m *mocks.MenuUseCase) {
+				m.On("CreateMenu", date, domMenu.Lunch, []domMenu.DishID{1, 2}).Return(&domMenu.Menu{
+					ID:       1,
+					Date:     date,
+					MealType: domMenu.Lunch,
+					Dishes:   []domMenu.Dish{{ID: 1}, {ID: 2}},
+				}, nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusCreated,
+		},
+
+		{
+			name:        "student is forbidden",
+			role:        "student",
+			requestBody: requestBody,
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+
+		{
+			name:        "validation error",
+			role:        "admin",
+			requestBody: requestBody,
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(common.ErrValidationError).Once()
+			},
+
+			wantStatusCode: http.StatusBadRequest,
+			wantErrorText:  "validation error",
+		},
+
+		{
+			name:        "menu exists",
+			role:        "admin",
+			requestBody: requestBody,
+
+			setupMenuUC: func(m *mocks.MenuUseCase) {
+				m.On("CreateMenu", date, domMenu.Lunch, []domMenu.DishID{1, 2}).Return(nil, usecase.ErrMenuExists).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "menu already exists",
+		},
+
+		{
+			name:        "dish not found",
+			role:        "admin",
+			requestBody: requestBody,
+
+			setupMenuUC: func(m *mocks.MenuUseCase) {
+				m.On("CreateMenu", date, domMenu.Lunch, []domMenu.DishID{1, 2}).Return(nil, usecase.ErrDishNotFound).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusNotFound,
+			wantErrorText:  "dish not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			menuUC := mocks.NewMenuUseCase(t)
+
+			if tc.setupMenuUC != nil {
+				tc.setupMenuUC(menuUC)
+			}
+
+			validator := mocks.NewValidator(t)
+
+			if tc.setupValidator != nil {
+				tc.setupValidator(validator)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithMenuUseCase(menuUC, mocks.NewProfileUseCase(t), mocks.NewReviewUseCase(t), tokenSvc, validator)
+
+			bodyBytes, err := json.Marshal(tc.requestBody)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/api/menu", bytes.NewReader(bodyBytes))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, "2026-10-19", resp["date"])
+				assert.Len(t, resp["dishes"], 2)
+			}
+
+			menuUC.AssertExpectations(t)
+		})
+	}
+}
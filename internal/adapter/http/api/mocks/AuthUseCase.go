@@ -162,9 +162,77 @@ func (_c *AuthUseCase_GetUserByLogin_Call) RunAndReturn(run func(login string) (
 	return _c
 }
 
+// ListSessions provides a mock function for the type AuthUseCase
+func (_mock *AuthUseCase) ListSessions(userID user.UserID, refreshToken string) ([]auth.Session, error) {
+	ret := _mock.Called(userID, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []auth.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(user.UserID, string) ([]auth.Session, error)); ok {
+		return returnFunc(userID, refreshToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(user.UserID, string) []auth.Session); ok {
+		r0 = returnFunc(userID, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]auth.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(user.UserID, string) error); ok {
+		r1 = returnFunc(userID, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthUseCase_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type AuthUseCase_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - userID user.UserID
+//   - refreshToken string
+func (_e *AuthUseCase_Expecter) ListSessions(userID interface{}, refreshToken interface{}) *AuthUseCase_ListSessions_Call {
+	return &AuthUseCase_ListSessions_Call{Call: _e.mock.On("ListSessions", userID, refreshToken)}
+}
+
+func (_c *AuthUseCase_ListSessions_Call) Run(run func(userID user.UserID, refreshToken string)) *AuthUseCase_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 user.UserID
+		if args[0] != nil {
+			arg0 = args[0].(user.UserID)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_ListSessions_Call) Return(sessions []auth.Session, err error) *AuthUseCase_ListSessions_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *AuthUseCase_ListSessions_Call) RunAndReturn(run func(userID user.UserID, refreshToken string) ([]auth.Session, error)) *AuthUseCase_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Login provides a mock function for the type AuthUseCase
-func (_mock *AuthUseCase) Login(login string, password string) (*auth.Tokens, error) {
-	ret := _mock.Called(login, password)
+func (_mock *AuthUseCase) Login(login string, password string, client auth.Client) (*auth.Tokens, error) {
+	ret := _mock.Called(login, password, client)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
@@ -172,18 +240,18 @@ func (_mock *AuthUseCase) Login(login string, password string) (*auth.Tokens, er
 
 	var r0 *auth.Tokens
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) (*auth.Tokens, error)); ok {
-		return returnFunc(login, password)
+	if returnFunc, ok := ret.Get(0).(func(string, string, auth.Client) (*auth.Tokens, error)); ok {
+		return returnFunc(login, password, client)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) *auth.Tokens); ok {
-		r0 = returnFunc(login, password)
+	if returnFunc, ok := ret.Get(0).(func(string, string, auth.Client) *auth.Tokens); ok {
+		r0 = returnFunc(login, password, client)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*auth.Tokens)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = returnFunc(login, password)
+	if returnFunc, ok := ret.Get(1).(func(string, string, auth.Client) error); ok {
+		r1 = returnFunc(login, password, client)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -198,11 +266,12 @@ type AuthUseCase_Login_Call struct {
 // Login is a helper method to define mock.On call
 //   - login string
 //   - password string
-func (_e *AuthUseCase_Expecter) Login(login interface{}, password interface{}) *AuthUseCase_Login_Call {
-	return &AuthUseCase_Login_Call{Call: _e.mock.On("Login", login, password)}
+//   - client auth.Client
+func (_e *AuthUseCase_Expecter) Login(login interface{}, password interface{}, client interface{}) *AuthUseCase_Login_Call {
+	return &AuthUseCase_Login_Call{Call: _e.mock.On("Login", login, password, client)}
 }
 
-func (_c *AuthUseCase_Login_Call) Run(run func(login string, password string)) *AuthUseCase_Login_Call {
+func (_c *AuthUseCase_Login_Call) Run(run func(login string, password string, client auth.Client)) *AuthUseCase_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -212,9 +281,14 @@ func (_c *AuthUseCase_Login_Call) Run(run func(login string, password string)) *
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 auth.Client
+		if args[2] != nil {
+			arg2 = args[2].(auth.Client)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -225,14 +299,14 @@ func (_c *AuthUseCase_Login_Call) Return(tokens *auth.Tokens, err error) *AuthUs
 	return _c
 }
 
-func (_c *AuthUseCase_Login_Call) RunAndReturn(run func(login string, password string) (*auth.Tokens, error)) *AuthUseCase_Login_Call {
+func (_c *AuthUseCase_Login_Call) RunAndReturn(run func(login string, password string, client auth.Client) (*auth.Tokens, error)) *AuthUseCase_Login_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Refresh provides a mock function for the type AuthUseCase
-func (_mock *AuthUseCase) Refresh(refreshToken string) (*auth.Tokens, error) {
-	ret := _mock.Called(refreshToken)
+func (_mock *AuthUseCase) Refresh(refreshToken string, client auth.Client) (*auth.Tokens, error) {
+	ret := _mock.Called(refreshToken, client)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Refresh")
@@ -240,18 +314,18 @@ func (_mock *AuthUseCase) Refresh(refreshToken string) (*auth.Tokens, error) {
 
 	var r0 *auth.Tokens
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*auth.Tokens, error)); ok {
-		return returnFunc(refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(string, auth.Client) (*auth.Tokens, error)); ok {
+		return returnFunc(refreshToken, client)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *auth.Tokens); ok {
-		r0 = returnFunc(refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(string, auth.Client) *auth.Tokens); ok {
+		r0 = returnFunc(refreshToken, client)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*auth.Tokens)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(refreshToken)
+	if returnFunc, ok := ret.Get(1).(func(string, auth.Client) error); ok {
+		r1 = returnFunc(refreshToken, client)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -265,18 +339,24 @@ type AuthUseCase_Refresh_Call struct {
 
 // Refresh is a helper method to define mock.On call
 //   - refreshToken string
-func (_e *AuthUseCase_Expecter) Refresh(refreshToken interface{}) *AuthUseCase_Refresh_Call {
-	return &AuthUseCase_Refresh_Call{Call: _e.mock.On("Refresh", refreshToken)}
+//   - client auth.Client
+func (_e *AuthUseCase_Expecter) Refresh(refreshToken interface{}, client interface{}) *AuthUseCase_Refresh_Call {
+	return &AuthUseCase_Refresh_Call{Call: _e.mock.On("Refresh", refreshToken, client)}
 }
 
-func (_c *AuthUseCase_Refresh_Call) Run(run func(refreshToken string)) *AuthUseCase_Refresh_Call {
+func (_c *AuthUseCase_Refresh_Call) Run(run func(refreshToken string, client auth.Client)) *AuthUseCase_Refresh_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 auth.Client
+		if args[1] != nil {
+			arg1 = args[1].(auth.Client)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -287,14 +367,14 @@ func (_c *AuthUseCase_Refresh_Call) Return(tokens *auth.Tokens, err error) *Auth
 	return _c
 }
 
-func (_c *AuthUseCase_Refresh_Call) RunAndReturn(run func(refreshToken string) (*auth.Tokens, error)) *AuthUseCase_Refresh_Call {
+func (_c *AuthUseCase_Refresh_Call) RunAndReturn(run func(refreshToken string, client auth.Client) (*auth.Tokens, error)) *AuthUseCase_Refresh_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Register provides a mock function for the type AuthUseCase
-func (_mock *AuthUseCase) Register(login string, password string, name string, surname string, role string) (*auth.Tokens, error) {
-	ret := _mock.Called(login, password, name, surname, role)
+func (_mock *AuthUseCase) Register(login string, password string, name string, surname string, email string, inviteCode string, client auth.Client) (*auth.Tokens, error) {
+	ret := _mock.Called(login, password, name, surname, email, inviteCode, client)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Register")
@@ -302,18 +382,18 @@ func (_mock *AuthUseCase) Register(login string, password string, name string, s
 
 	var r0 *auth.Tokens
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string, string, string, string) (*auth.Tokens, error)); ok {
-		return returnFunc(login, password, name, surname, role)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string, string, string, auth.Client) (*auth.Tokens, error)); ok {
+		return returnFunc(login, password, name, surname, email, inviteCode, client)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string, string, string, string) *auth.Tokens); ok {
-		r0 = returnFunc(login, password, name, surname, role)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string, string, string, auth.Client) *auth.Tokens); ok {
+		r0 = returnFunc(login, password, name, surname, email, inviteCode, client)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*auth.Tokens)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string, string, string, string) error); ok {
-		r1 = returnFunc(login, password, name, surname, role)
+	if returnFunc, ok := ret.Get(1).(func(string, string, string, string, string, string, auth.Client) error); ok {
+		r1 = returnFunc(login, password, name, surname, email, inviteCode, client)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -330,12 +410,14 @@ type AuthUseCase_Register_Call struct {
 //   - password string
 //   - name string
 //   - surname string
-//   - role string
-func (_e *AuthUseCase_Expecter) Register(login interface{}, password interface{}, name interface{}, surname interface{}, role interface{}) *AuthUseCase_Register_Call {
-	return &AuthUseCase_Register_Call{Call: _e.mock.On("Register", login, password, name, surname, role)}
+//   - email string
+//   - inviteCode string
+//   - client auth.Client
+func (_e *AuthUseCase_Expecter) Register(login interface{}, password interface{}, name interface{}, surname interface{}, email interface{}, inviteCode interface{}, client interface{}) *AuthUseCase_Register_Call {
+	return &AuthUseCase_Register_Call{Call: _e.mock.On("Register", login, password, name, surname, email, inviteCode, client)}
 }
 
-func (_c *AuthUseCase_Register_Call) Run(run func(login string, password string, name string, surname string, role string)) *AuthUseCase_Register_Call {
+func (_c *AuthUseCase_Register_Call) Run(run func(login string, password string, name string, surname string, email string, inviteCode string, client auth.Client)) *AuthUseCase_Register_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -357,12 +439,22 @@ func (_c *AuthUseCase_Register_Call) Run(run func(login string, password string,
 		if args[4] != nil {
 			arg4 = args[4].(string)
 		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		var arg6 auth.Client
+		if args[6] != nil {
+			arg6 = args[6].(auth.Client)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
 			arg4,
+			arg5,
+			arg6,
 		)
 	})
 	return _c
@@ -373,7 +465,58 @@ func (_c *AuthUseCase_Register_Call) Return(tokens *auth.Tokens, err error) *Aut
 	return _c
 }
 
-func (_c *AuthUseCase_Register_Call) RunAndReturn(run func(login string, password string, name string, surname string, role string) (*auth.Tokens, error)) *AuthUseCase_Register_Call {
+func (_c *AuthUseCase_Register_Call) RunAndReturn(run func(login string, password string, name string, surname string, email string, inviteCode string, client auth.Client) (*auth.Tokens, error)) *AuthUseCase_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllSessions provides a mock function for the type AuthUseCase
+func (_mock *AuthUseCase) RevokeAllSessions(userID user.UserID) error {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllSessions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(user.UserID) error); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthUseCase_RevokeAllSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllSessions'
+type AuthUseCase_RevokeAllSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeAllSessions is a helper method to define mock.On call
+//   - userID user.UserID
+func (_e *AuthUseCase_Expecter) RevokeAllSessions(userID interface{}) *AuthUseCase_RevokeAllSessions_Call {
+	return &AuthUseCase_RevokeAllSessions_Call{Call: _e.mock.On("RevokeAllSessions", userID)}
+}
+
+func (_c *AuthUseCase_RevokeAllSessions_Call) Run(run func(userID user.UserID)) *AuthUseCase_RevokeAllSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 user.UserID
+		if args[0] != nil {
+			arg0 = args[0].(user.UserID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_RevokeAllSessions_Call) Return(err error) *AuthUseCase_RevokeAllSessions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthUseCase_RevokeAllSessions_Call) RunAndReturn(run func(userID user.UserID) error) *AuthUseCase_RevokeAllSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -428,3 +571,60 @@ func (_c *AuthUseCase_RevokeRefreshToken_Call) RunAndReturn(run func(refreshToke
 	_c.Call.Return(run)
 	return _c
 }
+
+// RevokeSession provides a mock function for the type AuthUseCase
+func (_mock *AuthUseCase) RevokeSession(userID user.UserID, sessionID string) error {
+	ret := _mock.Called(userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(user.UserID, string) error); ok {
+		r0 = returnFunc(userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthUseCase_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type AuthUseCase_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - userID user.UserID
+//   - sessionID string
+func (_e *AuthUseCase_Expecter) RevokeSession(userID interface{}, sessionID interface{}) *AuthUseCase_RevokeSession_Call {
+	return &AuthUseCase_RevokeSession_Call{Call: _e.mock.On("RevokeSession", userID, sessionID)}
+}
+
+func (_c *AuthUseCase_RevokeSession_Call) Run(run func(userID user.UserID, sessionID string)) *AuthUseCase_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 user.UserID
+		if args[0] != nil {
+			arg0 = args[0].(user.UserID)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthUseCase_RevokeSession_Call) Return(err error) *AuthUseCase_RevokeSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthUseCase_RevokeSession_Call) RunAndReturn(run func(userID user.UserID, sessionID string) error) *AuthUseCase_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,357 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OrderHandler struct {
+	orders    common.OrderUseCase
+	validator common.Validator
+}
+
+func NewOrderHandler(router *gin.Engine, orders common.OrderUseCase, tokenSvc usecase.TokenService, validator common.Validator) {
+	handler := &OrderHandler{
+		orders:    orders,
+		validator: validator,
+	}
+
+	{
+		orders := router.Group("/api/orders", AuthMiddleware(tokenSvc))
+
+		student := orders.Group("", common.RequirePermission(domUser.PermOrdersPlace))
+		student.POST("", handler.PlaceOrder)
+		student.GET("", handler.ListOrders)
+		student.GET("/:id", handler.GetOrder)
+		student.POST("/:id/pay", handler.PayOrder)
+		student.POST("/:id/cancel", handler.CancelOrder)
+
+		staff := orders.Group("", common.RequirePermission(domUser.PermOrdersIssue))
+		staff.GET("/queue", handler.Queue)
+		staff.POST("/:id/prepare", handler.MarkPrepared)
+		staff.POST("/:id/issue", handler.MarkIssued)
+	}
+}
+
+type OrderItemResponse struct {
+	DishID   int64  `json:"dish_id" example:"1"`
+	Name     string `json:"name" example:"Борщ"`
+	Price    int64  `json:"price" example:"12000"`
+	Quantity int    `json:"quantity" example:"1"`
+}
+
+type OrderResponse struct {
+	ID        int64               `json:"id" example:"1"`
+	StudentID int64               `json:"student_id" example:"1"`
+	MenuID    int64               `json:"menu_id" example:"1"`
+	Date      string              `json:"date" example:"2026-10-19"`
+	MealType  string              `json:"meal_type" example:"lunch"`
+	Items     []OrderItemResponse `json:"items"`
+	Total     int64               `json:"total" example:"12000"`
+	Status    string              `json:"status" example:"placed"`
+	// SubscriptionID is set when the order was paid by a subscription.
+	SubscriptionID int64 `json:"subscription_id,omitempty" example:"1"`
+	// ConfirmedAllergens lists the allergens the student accepted when
+	// placing the order.
+	ConfirmedAllergens []string  `json:"confirmed_allergens,omitempty" example:"lactose"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+func toOrderResponse(order domOrder.Order) OrderResponse {
+	items := make([]OrderItemResponse, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, OrderItemResponse{
+			DishID:   int64(item.DishID),
+			Name:     item.Name,
+			Price:    item.Price,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return OrderResponse{
+		ID:                 int64(order.ID),
+		StudentID:          int64(order.StudentID),
+		MenuID:             int64(order.MenuID),
+		Date:               order.Date.Format(common.DateLayout),
+		MealType:           string(order.MealType),
+		Items:              items,
+		Total:              order.Total,
+		Status:             string(order.Status),
+		SubscriptionID:     int64(order.SubscriptionID),
+		ConfirmedAllergens: toAllergenStrings(order.ConfirmedAllergens),
+		CreatedAt:          order.CreatedAt,
+		UpdatedAt:          order.UpdatedAt,
+	}
+}
+
+func toOrderResponses(orders []domOrder.Order) []OrderResponse {
+	resp := make([]OrderResponse, 0, len(orders))
+	for _, order := range orders {
+		resp = append(resp, toOrderResponse(order))
+	}
+	return resp
+}
+
+// PlaceOrder godoc
+//
+//	@Summary		Оформление заказа
+//	@Description	Создает заказ ученика на блюда из меню. Заказ создается в статусе placed. Если блюда содержат аллергены из профиля питания ученика, заказ нужно подтвердить флагом confirm_allergens.
+//	@Tags			orders
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			input	body		common.OrderRequest			true	"Меню и выбранные блюда"
+//	@Success		201		{object}	OrderResponse				"Заказ создан"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse		"Данные невалидны"
+//	@Failure		400		{object}	DishNotInMenuErrorResponse	"Блюда нет в меню"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404		{object}	MenuNotFoundErrorResponse	"Меню не найдено"
+//	@Failure		409		{object}	MenuClosedErrorResponse			"Прием заказов на это меню закрыт"
+//	@Failure		409		{object}	AllergenConflictErrorResponse	"Заказ содержит аллергены из профиля питания и не подтвержден"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/orders [post]
+func (oh *OrderHandler) PlaceOrder(c *gin.Context) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.OrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := oh.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	items := make([]domOrder.Item, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, domOrder.Item{DishID: domMenu.DishID(item.DishID), Quantity: item.Quantity})
+	}
+
+	order, err := oh.orders.PlaceOrder(studentID, domMenu.MenuID(req.MenuID), items, req.ConfirmAllergens)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toOrderResponse(*order))
+}
+
+// ListOrders godoc
+//
+//	@Summary		Заказы ученика
+//	@Description	Возвращает все заказы текущего ученика.
+//	@Tags			orders
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		OrderResponse				"Список заказов"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/orders [get]
+func (oh *OrderHandler) ListOrders(c *gin.Context) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	orders, err := oh.orders.ListStudentOrders(studentID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOrderResponses(orders))
+}
+
+// GetOrder godoc
+//
+//	@Summary		Заказ ученика
+//	@Description	Возвращает заказ текущего ученика по идентификатору.
+//	@Tags			orders
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int							true	"Идентификатор заказа"
+//	@Success		200	{object}	OrderResponse				"Заказ"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	OrderNotFoundErrorResponse	"Заказ не найден"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/orders/{id} [get]
+func (oh *OrderHandler) GetOrder(c *gin.Context) {
+	oh.studentAction(c, oh.orders.GetStudentOrder)
+}
+
+// PayOrder godoc
+//
+//	@Summary		Оплата заказа
+//	@Description	Переводит заказ текущего ученика в статус paid. Если прием пищи покрыт действующим абонементом, заказ оплачивается абонементом, иначе стоимость списывается с баланса.
+//	@Tags			orders
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int							true	"Идентификатор заказа"
+//	@Success		200	{object}	OrderResponse				"Заказ оплачен"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	OrderNotFoundErrorResponse	"Заказ не найден"
+//	@Failure		409	{object}	OrderStatusErrorResponse		"Заказ нельзя оплатить в текущем статусе"
+//	@Failure		409	{object}	InsufficientFundsErrorResponse	"Недостаточно средств на балансе"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/orders/{id}/pay [post]
+func (oh *OrderHandler) PayOrder(c *gin.Context) {
+	oh.studentAction(c, oh.orders.PayOrder)
+}
+
+// CancelOrder godoc
+//
+//	@Summary		Отмена заказа
+//	@Description	Отменяет заказ текущего ученика, если он еще не приготовлен. Оплаченный заказ возвращается на баланс.
+//	@Tags			orders
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int							true	"Идентификатор заказа"
+//	@Success		200	{object}	OrderResponse				"Заказ отменен"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	OrderNotFoundErrorResponse	"Заказ не найден"
+//	@Failure		409	{object}	OrderStatusErrorResponse	"Заказ нельзя отменить в текущем статусе"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/orders/{id}/cancel [post]
+func (oh *OrderHandler) CancelOrder(c *gin.Context) {
+	oh.studentAction(c, oh.orders.CancelOrder)
+}
+
+// Queue godoc
+//
+//	@Summary		Очередь заказов
+//	@Description	Возвращает оплаченные и приготовленные заказы на указанную дату (по умолчанию — на сегодня). Доступно сотрудникам столовой и администраторам.
+//	@Tags			orders
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			date	query		string						false	"Дата в формате YYYY-MM-DD"
+//	@Success		200		{array}		OrderResponse				"Очередь заказов"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/orders/queue [get]
+func (oh *OrderHandler) Queue(c *gin.Context) {
+	date := time.Now()
+	if raw := c.Query("date"); raw != "" {
+		parsed, err := time.Parse(common.DateLayout, raw)
+		if err != nil {
+			writeError(c, common.ErrInvalidRequest)
+			return
+		}
+		date = parsed
+	}
+
+	orders, err := oh.orders.Queue(date)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOrderResponses(orders))
+}
+
+// MarkPrepared godoc
+//
+//	@Summary		Заказ приготовлен
+//	@Description	Переводит оплаченный заказ в статус prepared. Доступно сотрудникам столовой и администраторам.
+//	@Tags			orders
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int							true	"Идентификатор заказа"
+//	@Success		200	{object}	OrderResponse				"Заказ приготовлен"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	OrderNotFoundErrorResponse	"Заказ не найден"
+//	@Failure		409	{object}	OrderStatusErrorResponse	"Недопустимая смена статуса"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/orders/{id}/prepare [post]
+func (oh *OrderHandler) MarkPrepared(c *gin.Context) {
+	oh.staffAction(c, oh.orders.MarkPrepared)
+}
+
+// MarkIssued godoc
+//
+//	@Summary		Заказ выдан
+//	@Description	Переводит приготовленный заказ в статус issued. Доступно сотрудникам столовой и администраторам.
+//	@Tags			orders
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int							true	"Идентификатор заказа"
+//	@Success		200	{object}	OrderResponse				"Заказ выдан"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	OrderNotFoundErrorResponse	"Заказ не найден"
+//	@Failure		409	{object}	OrderStatusErrorResponse	"Недопустимая смена статуса"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/orders/{id}/issue [post]
+func (oh *OrderHandler) MarkIssued(c *gin.Context) {
+	oh.staffAction(c, oh.orders.MarkIssued)
+}
+
+func (oh *OrderHandler) studentAction(
+	c *gin.Context,
+	action func(studentID domUser.UserID, orderID domOrder.OrderID) (*domOrder.Order, error),
+) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	order, err := action(studentID, domOrder.OrderID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOrderResponse(*order))
+}
+
+func (oh *OrderHandler) staffAction(c *gin.Context, action func(orderID domOrder.OrderID) (*domOrder.Order, error)) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	order, err := action(domOrder.OrderID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOrderResponse(*order))
+}
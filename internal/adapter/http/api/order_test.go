@@ -0,0 +1,244 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"canteen-app/internal/adapter/http/api/mocks"
+	"canteen-app/internal/adapter/http/common"
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRouterWithOrderUseCase(orderUC *mocks.OrderUseCase, tokenSvc usecase.TokenService, validator *mocks.Validator) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	NewOrderHandler(r, orderUC, tokenSvc, validator)
+
+	return r
+}
+
+func TestOrderHandler_PlaceOrder(t *testing.T) {
+	requestBody := map[string]interface{}{
+		"menu_id": 1,
+		"items":   []map[string]int{{"dish_id": 2, "quantity": 1}},
+	}
+	validRequest := common.OrderRequest{
+		MenuID: 1,
+		Items:  []common.OrderItemRequest{{DishID: 2, Quantity: 1}},
+	}
+	items := []domOrder.Item{{DishID: 2, Quantity: 1}}
+
+	tests := []struct {
+		name           string
+		role           string
+		setupOrderUC   func(m *mocks.OrderUseCase)
+		setupValidator func(m *mocks.Validator)
+		wantStatusCode int
+		wantErrorText  string
+	}{
+		{
+			name: "success",
+			role: "student",
+
+			setupOrderUC: func(m *mocks.OrderUseCase) {
+				m.On("PlaceOrder", domUser.UserID(1), domMenu.MenuID(1), items, false).Return(&domOrder.Order{
+					ID:     1,
+					MenuID: 1,
+					Items:  []domOrder.Item{{DishID: 2, Name: "Борщ", Price: 12000, Quantity: 1}},
+					Total:  12000,
+					Status: domOrder.Placed,
+				}, nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusCreated,
+		},
+
+		{
+			name: "employee is forbidden",
+			role: "employee",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+
+		{
+			name: "dish not in menu",
+			role: "student",
+
+			setupOrderUC: func(m *mocks.OrderUseCase) {
+				m.On("PlaceOrder", domUser.UserID(1), domMenu.MenuID(1), items, false).Return(nil, usecase.ErrDishNotInMenu).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusBadRequest,
+			wantErrorText:  "dish is not in menu",
+		},
+
+		{
+			name: "menu closed",
+			role: "student",
+
+			setupOrderUC: func(m *mocks.OrderUseCase) {
+				m.On("PlaceOrder", domUser.UserID(1), domMenu.MenuID(1), items, false).Return(nil, usecase.ErrMenuClosed).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "menu is closed for orders",
+		},
+
+		{
+			name: "allergen conflict not confirmed",
+			role: "student",
+
+			setupOrderUC: func(m *mocks.OrderUseCase) {
+				m.On("PlaceOrder", domUser.UserID(1), domMenu.MenuID(1), items, false).Return(nil, usecase.ErrAllergenConflict).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "order contains allergens from dietary profile, confirmation required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			orderUC := mocks.NewOrderUseCase(t)
+
+			if tc.setupOrderUC != nil {
+				tc.setupOrderUC(orderUC)
+			}
+
+			validator := mocks.NewValidator(t)
+
+			if tc.setupValidator != nil {
+				tc.setupValidator(validator)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithOrderUseCase(orderUC, tokenSvc, validator)
+
+			bodyBytes, err := json.Marshal(requestBody)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(bodyBytes))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, "placed", resp["status"])
+				assert.Equal(t, float64(12000), resp["total"])
+			}
+
+			orderUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOrderHandler_MarkIssued(t *testing.T) {
+	tests := []struct {
+		name           string
+		role           string
+		setupOrderUC   func(m *mocks.OrderUseCase)
+		wantStatusCode int
+		wantErrorText  string
+	}{
+		{
+			name: "success",
+			role: "employee",
+
+			setupOrderUC: func(m *mocks.OrderUseCase) {
+				m.On("MarkIssued", domOrder.OrderID(7)).Return(&domOrder.Order{ID: 7, Status: domOrder.Issued}, nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+		},
+
+		{
+			name: "not prepared yet",
+			role: "employee",
+
+			setupOrderUC: func(m *mocks.OrderUseCase) {
+				m.On("MarkIssued", domOrder.OrderID(7)).Return(nil, usecase.ErrOrderStatus).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "invalid order status transition",
+		},
+
+		{
+			name: "student is forbidden",
+			role: "student",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			orderUC := mocks.NewOrderUseCase(t)
+
+			if tc.setupOrderUC != nil {
+				tc.setupOrderUC(orderUC)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithOrderUseCase(orderUC, tokenSvc, mocks.NewValidator(t))
+
+			req, err := http.NewRequest(http.MethodPost, "/api/orders/7/issue", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, "issued", resp["status"])
+			}
+
+			orderUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"net/http"
+
+	"canteen-app/internal/adapter/http/common"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PasswordResetHandler struct {
+	resets    common.PasswordResetUseCase
+	validator common.Validator
+}
+
+// NewPasswordResetHandler registers the route to ask for a password reset
+// link; the new password is then set with /api/auth/set-password.
+// signInLimit keeps the route from being used to flood mailboxes.
+func NewPasswordResetHandler(router *gin.Engine, resets common.PasswordResetUseCase, signInLimit gin.HandlerFunc, validator common.Validator) {
+	handler := &PasswordResetHandler{
+		resets:    resets,
+		validator: validator,
+	}
+
+	router.POST("/api/auth/forgot-password", signInLimit, handler.ForgotPassword)
+}
+
+// ForgotPassword godoc
+//
+//	@Summary		Сброс забытого пароля
+//	@Description	Отправляет на почту пользователя одноразовую ссылку для нового пароля; пароль задается через /api/auth/set-password. Ответ одинаков для любых логинов, чтобы по нему нельзя было узнать, какие учетные записи существуют.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			input	body	common.ForgotPasswordRequest	true	"Логин или почта"
+//	@Success		202		"Если учетная запись с почтой существует, ссылка отправлена"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		429		{object}	TooManyRequestsErrorResponse	"Превышен лимит запросов"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/auth/forgot-password [post]
+func (ph *PasswordResetHandler) ForgotPassword(c *gin.Context) {
+	var req common.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ph.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	if err := ph.resets.RequestReset(req.Login); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
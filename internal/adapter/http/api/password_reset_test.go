@@ -0,0 +1,104 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"canteen-app/internal/adapter/http/api/mocks"
+	"canteen-app/internal/adapter/http/common"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordResetHandler_ForgotPassword(t *testing.T) {
+	validRequest := common.ForgotPasswordRequest{Login: "slim@example.com"}
+
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		setupResetUC   func(m *mocks.PasswordResetUseCase)
+		setupValidator func(m *mocks.Validator)
+		wantStatusCode int
+		wantErrorText  string
+	}{
+		{
+			name:        "success",
+			requestBody: map[string]interface{}{"login": "slim@example.com"},
+
+			setupResetUC: func(m *mocks.PasswordResetUseCase) {
+				m.On("RequestReset", "slim@example.com").Return(nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusAccepted,
+		},
+
+		{
+			name:        "missing login",
+			requestBody: map[string]interface{}{},
+
+			wantStatusCode: http.StatusBadRequest,
+			wantErrorText:  "invalid request",
+		},
+
+		{
+			name:        "mail not sent",
+			requestBody: map[string]interface{}{"login": "slim@example.com"},
+
+			setupResetUC: func(m *mocks.PasswordResetUseCase) {
+				m.On("RequestReset", "slim@example.com").Return(errors.New("outbox is full")).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusInternalServerError,
+			wantErrorText:  "internal server error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resetUC := mocks.NewPasswordResetUseCase(t)
+			if tc.setupResetUC != nil {
+				tc.setupResetUC(resetUC)
+			}
+
+			validator := mocks.NewValidator(t)
+			if tc.setupValidator != nil {
+				tc.setupValidator(validator)
+			}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			NewPasswordResetHandler(router, resetUC, noLimit, validator)
+
+			bodyBytes, err := json.Marshal(tc.requestBody)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/api/auth/forgot-password", bytes.NewReader(bodyBytes))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			if tc.wantErrorText != "" {
+				var resp map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			}
+		})
+	}
+}
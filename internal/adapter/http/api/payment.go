@@ -0,0 +1,169 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+	domPayment "canteen-app/internal/domain/payment"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+const signatureHeader = "X-Signature"
+
+type PaymentHandler struct {
+	payments  common.PaymentUseCase
+	validator common.Validator
+}
+
+func NewPaymentHandler(router *gin.Engine, payments common.PaymentUseCase, tokenSvc usecase.TokenService, validator common.Validator) {
+	handler := &PaymentHandler{
+		payments:  payments,
+		validator: validator,
+	}
+
+	{
+		payments := router.Group("/api/payments")
+		payments.POST("/webhook", handler.Webhook)
+
+		student := payments.Group("", AuthMiddleware(tokenSvc), common.RequirePermission(domUser.PermPaymentsTopUp))
+		student.POST("/top-up", handler.CreateTopUp)
+		student.GET("/:id", handler.GetPayment)
+	}
+}
+
+type PaymentResponse struct {
+	ID        string    `json:"id" example:"0f8fad5b-d9cb-469f-a165-70867728950e"`
+	Amount    int64     `json:"amount" example:"50000"`
+	Status    string    `json:"status" example:"pending"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TopUpResponse struct {
+	Payment     PaymentResponse `json:"payment"`
+	RedirectURL string          `json:"redirect_url" example:"/fake-gateway/checkout/0f8fad5b-d9cb-469f-a165-70867728950e"`
+}
+
+type WebhookResponse struct {
+	Status string `json:"status" example:"ok"`
+}
+
+func toPaymentResponse(payment domPayment.Payment) PaymentResponse {
+	return PaymentResponse{
+		ID:        string(payment.ID),
+		Amount:    payment.Amount,
+		Status:    string(payment.Status),
+		CreatedAt: payment.CreatedAt,
+		UpdatedAt: payment.UpdatedAt,
+	}
+}
+
+// CreateTopUp godoc
+//
+//	@Summary		Пополнение баланса картой
+//	@Description	Создает платеж на пополнение баланса текущего ученика и возвращает адрес страницы оплаты. Баланс пополняется только после подтверждения платежа через webhook.
+//	@Tags			payments
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			input	body		common.TopUpRequest			true	"Сумма пополнения в копейках"
+//	@Success		201		{object}	TopUpResponse				"Платеж создан"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse		"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/payments/top-up [post]
+func (ph *PaymentHandler) CreateTopUp(c *gin.Context) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.TopUpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ph.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	payment, redirectURL, err := ph.payments.CreateTopUp(studentID, req.Amount)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, TopUpResponse{
+		Payment:     toPaymentResponse(*payment),
+		RedirectURL: redirectURL,
+	})
+}
+
+// GetPayment godoc
+//
+//	@Summary		Статус платежа
+//	@Description	Возвращает платеж текущего ученика по идентификатору.
+//	@Tags			payments
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string							true	"Идентификатор платежа"
+//	@Success		200	{object}	PaymentResponse					"Платеж"
+//	@Failure		401	{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		404	{object}	PaymentNotFoundErrorResponse	"Платеж не найден"
+//	@Failure		500	{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/payments/{id} [get]
+func (ph *PaymentHandler) GetPayment(c *gin.Context) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	payment, err := ph.payments.GetPayment(studentID, domPayment.PaymentID(c.Param("id")))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toPaymentResponse(*payment))
+}
+
+// Webhook godoc
+//
+//	@Summary		Уведомление платежного шлюза
+//	@Description	Принимает уведомление о результате платежа. Тело подписывается HMAC-SHA256, подпись передается в заголовке X-Signature. Повторные уведомления обрабатываются идемпотентно.
+//	@Tags			payments
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Signature	header		string							true	"HMAC-SHA256 тела запроса в hex"
+//	@Success		200			{object}	WebhookResponse					"Уведомление обработано"
+//	@Failure		400			{object}	InvalidWebhookErrorResponse		"Некорректное уведомление"
+//	@Failure		401			{object}	InvalidSignatureErrorResponse	"Неверная подпись"
+//	@Failure		404			{object}	PaymentNotFoundErrorResponse	"Платеж не найден"
+//	@Failure		500			{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/payments/webhook [post]
+func (ph *PaymentHandler) Webhook(c *gin.Context) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, 1<<16))
+	if err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ph.payments.HandleWebhook(body, c.GetHeader(signatureHeader)); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, WebhookResponse{Status: "ok"})
+}
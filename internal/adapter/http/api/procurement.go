@@ -0,0 +1,444 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+	domInventory "canteen-app/internal/domain/inventory"
+	domProcurement "canteen-app/internal/domain/procurement"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProcurementHandler struct {
+	procurement common.ProcurementUseCase
+	validator   common.Validator
+}
+
+func NewProcurementHandler(router *gin.Engine, procurement common.ProcurementUseCase, tokenSvc usecase.TokenService, validator common.Validator) {
+	handler := &ProcurementHandler{
+		procurement: procurement,
+		validator:   validator,
+	}
+
+	{
+		requests := router.Group("/api/procurement/requests", AuthMiddleware(tokenSvc), common.RequirePermission(domUser.PermProcurementRequest))
+		requests.GET("", handler.ListRequests)
+		requests.POST("", handler.CreateRequest)
+		requests.GET("/:id", handler.GetRequest)
+		requests.PUT("/:id/items", handler.UpdateItems)
+		requests.POST("/:id/submit", handler.Submit)
+		requests.POST("/:id/comments", handler.AddComment)
+		requests.POST("/:id/fulfill", handler.Fulfill)
+		requests.POST("/:id/approve", common.RequirePermission(domUser.PermProcurementApprove), handler.Approve)
+		requests.POST("/:id/reject", common.RequirePermission(domUser.PermProcurementApprove), handler.Reject)
+	}
+}
+
+type PurchaseItemResponse struct {
+	ProductID     int64   `json:"product_id" example:"1"`
+	Name          string  `json:"name" example:"Мука пшеничная"`
+	Unit          string  `json:"unit" example:"kg"`
+	Quantity      float64 `json:"quantity" example:"50"`
+	EstimatedCost int64   `json:"estimated_cost" example:"250000"`
+}
+
+type PurchaseCommentResponse struct {
+	AuthorID  int64     `json:"author_id" example:"1"`
+	Text      string    `json:"text" example:"Согласовано с бухгалтерией"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type PurchaseRequestResponse struct {
+	ID            int64                     `json:"id" example:"1"`
+	AuthorID      int64                     `json:"author_id" example:"2"`
+	Items         []PurchaseItemResponse    `json:"items"`
+	EstimatedCost int64                     `json:"estimated_cost" example:"250000"`
+	Status        string                    `json:"status" example:"submitted"`
+	ApproverID    int64                     `json:"approver_id,omitempty" example:"1"`
+	Comments      []PurchaseCommentResponse `json:"comments"`
+	CreatedAt     time.Time                 `json:"created_at"`
+	UpdatedAt     time.Time                 `json:"updated_at"`
+}
+
+func toPurchaseRequestResponse(request domProcurement.Request) PurchaseRequestResponse {
+	items := make([]PurchaseItemResponse, 0, len(request.Items))
+	for _, item := range request.Items {
+		items = append(items, PurchaseItemResponse{
+			ProductID:     int64(item.ProductID),
+			Name:          item.Name,
+			Unit:          string(item.Unit),
+			Quantity:      item.Quantity,
+			EstimatedCost: item.EstimatedCost,
+		})
+	}
+
+	comments := make([]PurchaseCommentResponse, 0, len(request.Comments))
+	for _, comment := range request.Comments {
+		comments = append(comments, PurchaseCommentResponse{
+			AuthorID:  int64(comment.AuthorID),
+			Text:      comment.Text,
+			CreatedAt: comment.CreatedAt,
+		})
+	}
+
+	return PurchaseRequestResponse{
+		ID:            int64(request.ID),
+		AuthorID:      int64(request.AuthorID),
+		Items:         items,
+		EstimatedCost: request.EstimatedCost,
+		Status:        string(request.Status),
+		ApproverID:    int64(request.ApproverID),
+		Comments:      comments,
+		CreatedAt:     request.CreatedAt,
+		UpdatedAt:     request.UpdatedAt,
+	}
+}
+
+func toPurchaseItems(req []common.PurchaseItemRequest) []domProcurement.Item {
+	items := make([]domProcurement.Item, 0, len(req))
+	for _, item := range req {
+		items = append(items, domProcurement.Item{
+			ProductID:     domInventory.ProductID(item.ProductID),
+			Quantity:      item.Quantity,
+			EstimatedCost: item.EstimatedCost,
+		})
+	}
+	return items
+}
+
+// ListRequests godoc
+//
+//	@Summary		Заявки на закупку
+//	@Description	Возвращает заявки на закупку продуктов. Параметр status можно передать несколько раз. Доступно сотрудникам столовой и администраторам.
+//	@Tags			procurement
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			status	query		[]string					false	"Статусы заявок (draft, submitted, approved, rejected, fulfilled)"	collectionFormat(multi)
+//	@Success		200		{array}		PurchaseRequestResponse		"Список заявок"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/procurement/requests [get]
+func (ph *ProcurementHandler) ListRequests(c *gin.Context) {
+	statuses := make([]domProcurement.Status, 0)
+	for _, value := range c.QueryArray("status") {
+		status := domProcurement.Status(value)
+		if !status.Valid() {
+			writeError(c, common.ErrInvalidRequest)
+			return
+		}
+		statuses = append(statuses, status)
+	}
+
+	requests, err := ph.procurement.ListRequests(statuses...)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	resp := make([]PurchaseRequestResponse, 0, len(requests))
+	for _, request := range requests {
+		resp = append(resp, toPurchaseRequestResponse(request))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetRequest godoc
+//
+//	@Summary		Заявка на закупку
+//	@Description	Возвращает заявку на закупку с позициями и комментариями. Доступно сотрудникам столовой и администраторам.
+//	@Tags			procurement
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int										true	"Идентификатор заявки"
+//	@Success		200	{object}	PurchaseRequestResponse					"Заявка"
+//	@Failure		400	{object}	InvalidRequestErrorResponse				"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse				"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse					"Недостаточно прав"
+//	@Failure		404	{object}	PurchaseRequestNotFoundErrorResponse	"Заявка не найдена"
+//	@Failure		500	{object}	InternalServerErrorResponse				"Внутренняя ошибка сервера"
+//	@Router			/api/procurement/requests/{id} [get]
+func (ph *ProcurementHandler) GetRequest(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	request, err := ph.procurement.GetRequest(domProcurement.RequestID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toPurchaseRequestResponse(*request))
+}
+
+// CreateRequest godoc
+//
+//	@Summary		Создание заявки на закупку
+//	@Description	Создает черновик заявки на закупку продуктов со склада. Позиции одного продукта объединяются. Доступно сотрудникам столовой и администраторам.
+//	@Tags			procurement
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			input	body		common.PurchaseRequestRequest	true	"Позиции заявки и комментарий"
+//	@Success		201		{object}	PurchaseRequestResponse			"Черновик создан"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		404		{object}	ProductNotFoundErrorResponse	"Продукт не найден"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/procurement/requests [post]
+func (ph *ProcurementHandler) CreateRequest(c *gin.Context) {
+	authorID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.PurchaseRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ph.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	request, err := ph.procurement.CreateRequest(authorID, toPurchaseItems(req.Items), req.Comment)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toPurchaseRequestResponse(*request))
+}
+
+// UpdateItems godoc
+//
+//	@Summary		Редактирование заявки на закупку
+//	@Description	Заменяет позиции черновика заявки. Изменить заявку может только ее автор до отправки на согласование.
+//	@Tags			procurement
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int										true	"Идентификатор заявки"
+//	@Param			input	body		common.PurchaseItemsRequest				true	"Позиции заявки"
+//	@Success		200		{object}	PurchaseRequestResponse					"Заявка обновлена"
+//	@Failure		400		{object}	InvalidRequestErrorResponse				"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse					"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse				"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	NotRequestAuthorErrorResponse			"Заявку может изменить только автор"
+//	@Failure		404		{object}	PurchaseRequestNotFoundErrorResponse	"Заявка не найдена"
+//	@Failure		404		{object}	ProductNotFoundErrorResponse			"Продукт не найден"
+//	@Failure		409		{object}	PurchaseStatusErrorResponse				"Заявка уже отправлена"
+//	@Failure		500		{object}	InternalServerErrorResponse				"Внутренняя ошибка сервера"
+//	@Router			/api/procurement/requests/{id}/items [put]
+func (ph *ProcurementHandler) UpdateItems(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.PurchaseItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ph.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	request, err := ph.procurement.UpdateItems(userID, domProcurement.RequestID(id), toPurchaseItems(req.Items))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toPurchaseRequestResponse(*request))
+}
+
+// Submit godoc
+//
+//	@Summary		Отправка заявки на согласование
+//	@Description	Переводит черновик заявки в статус submitted. Отправить заявку может только ее автор.
+//	@Tags			procurement
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int										true	"Идентификатор заявки"
+//	@Success		200	{object}	PurchaseRequestResponse					"Заявка отправлена"
+//	@Failure		400	{object}	InvalidRequestErrorResponse				"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse				"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	NotRequestAuthorErrorResponse			"Заявку может отправить только автор"
+//	@Failure		404	{object}	PurchaseRequestNotFoundErrorResponse	"Заявка не найдена"
+//	@Failure		409	{object}	PurchaseStatusErrorResponse				"Недопустимая смена статуса"
+//	@Failure		500	{object}	InternalServerErrorResponse				"Внутренняя ошибка сервера"
+//	@Router			/api/procurement/requests/{id}/submit [post]
+func (ph *ProcurementHandler) Submit(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	request, err := ph.procurement.Submit(userID, domProcurement.RequestID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toPurchaseRequestResponse(*request))
+}
+
+// Approve godoc
+//
+//	@Summary		Согласование заявки
+//	@Description	Одобряет отправленную заявку на закупку. Доступно только администраторам.
+//	@Tags			procurement
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int										true	"Идентификатор заявки"
+//	@Param			input	body		common.CommentRequest					false	"Комментарий"
+//	@Success		200		{object}	PurchaseRequestResponse					"Заявка одобрена"
+//	@Failure		400		{object}	InvalidRequestErrorResponse				"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse					"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse				"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse					"Недостаточно прав"
+//	@Failure		404		{object}	PurchaseRequestNotFoundErrorResponse	"Заявка не найдена"
+//	@Failure		409		{object}	PurchaseStatusErrorResponse				"Недопустимая смена статуса"
+//	@Failure		500		{object}	InternalServerErrorResponse				"Внутренняя ошибка сервера"
+//	@Router			/api/procurement/requests/{id}/approve [post]
+func (ph *ProcurementHandler) Approve(c *gin.Context) {
+	ph.resolve(c, ph.procurement.Approve)
+}
+
+// Reject godoc
+//
+//	@Summary		Отклонение заявки
+//	@Description	Отклоняет отправленную заявку на закупку. Доступно только администраторам.
+//	@Tags			procurement
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int										true	"Идентификатор заявки"
+//	@Param			input	body		common.CommentRequest					false	"Причина отклонения"
+//	@Success		200		{object}	PurchaseRequestResponse					"Заявка отклонена"
+//	@Failure		400		{object}	InvalidRequestErrorResponse				"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse					"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse				"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse					"Недостаточно прав"
+//	@Failure		404		{object}	PurchaseRequestNotFoundErrorResponse	"Заявка не найдена"
+//	@Failure		409		{object}	PurchaseStatusErrorResponse				"Недопустимая смена статуса"
+//	@Failure		500		{object}	InternalServerErrorResponse				"Внутренняя ошибка сервера"
+//	@Router			/api/procurement/requests/{id}/reject [post]
+func (ph *ProcurementHandler) Reject(c *gin.Context) {
+	ph.resolve(c, ph.procurement.Reject)
+}
+
+// Fulfill godoc
+//
+//	@Summary		Исполнение заявки
+//	@Description	Отмечает одобренную заявку как исполненную и проводит поступление всех позиций на склад. Доступно сотрудникам столовой и администраторам.
+//	@Tags			procurement
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int										true	"Идентификатор заявки"
+//	@Param			input	body		common.CommentRequest					false	"Комментарий"
+//	@Success		200		{object}	PurchaseRequestResponse					"Заявка исполнена"
+//	@Failure		400		{object}	InvalidRequestErrorResponse				"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse					"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse				"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse					"Недостаточно прав"
+//	@Failure		404		{object}	PurchaseRequestNotFoundErrorResponse	"Заявка не найдена"
+//	@Failure		409		{object}	PurchaseStatusErrorResponse				"Заявка не одобрена"
+//	@Failure		500		{object}	InternalServerErrorResponse				"Внутренняя ошибка сервера"
+//	@Router			/api/procurement/requests/{id}/fulfill [post]
+func (ph *ProcurementHandler) Fulfill(c *gin.Context) {
+	ph.resolve(c, ph.procurement.Fulfill)
+}
+
+// AddComment godoc
+//
+//	@Summary		Комментарий к заявке
+//	@Description	Добавляет комментарий к заявке на закупку. Доступно сотрудникам столовой и администраторам.
+//	@Tags			procurement
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int										true	"Идентификатор заявки"
+//	@Param			input	body		common.CommentRequest					true	"Комментарий"
+//	@Success		200		{object}	PurchaseRequestResponse					"Комментарий добавлен"
+//	@Failure		400		{object}	InvalidRequestErrorResponse				"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse					"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse				"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse					"Недостаточно прав"
+//	@Failure		404		{object}	PurchaseRequestNotFoundErrorResponse	"Заявка не найдена"
+//	@Failure		500		{object}	InternalServerErrorResponse				"Внутренняя ошибка сервера"
+//	@Router			/api/procurement/requests/{id}/comments [post]
+func (ph *ProcurementHandler) AddComment(c *gin.Context) {
+	ph.resolve(c, ph.procurement.AddComment)
+}
+
+// resolve handles the actions that take the request id from the path and an
+// optional comment from the body.
+func (ph *ProcurementHandler) resolve(c *gin.Context, action func(userID domUser.UserID, id domProcurement.RequestID, comment string) (*domProcurement.Request, error)) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.CommentRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			writeError(c, common.ErrInvalidRequest)
+			return
+		}
+
+		if err := ph.validator.Struct(req); err != nil {
+			writeError(c, common.ErrValidationError)
+			return
+		}
+	}
+
+	request, err := action(userID, domProcurement.RequestID(id), req.Comment)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toPurchaseRequestResponse(*request))
+}
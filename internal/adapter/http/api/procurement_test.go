@@ -0,0 +1,221 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"canteen-app/internal/adapter/http/api/mocks"
+	"canteen-app/internal/adapter/http/common"
+	domProcurement "canteen-app/internal/domain/procurement"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRouterWithProcurementUseCase(procurementUC *mocks.ProcurementUseCase, tokenSvc usecase.TokenService, validator *mocks.Validator) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	NewProcurementHandler(r, procurementUC, tokenSvc, validator)
+
+	return r
+}
+
+func TestProcurementHandler_Approve(t *testing.T) {
+	validRequest := common.CommentRequest{Comment: "ok"}
+
+	tests := []struct {
+		name               string
+		role               string
+		setupProcurementUC func(m *mocks.ProcurementUseCase)
+		setupValidator     func(m *mocks.Validator)
+		wantStatusCode     int
+		wantErrorText      string
+	}{
+		{
+			name: "success",
+			role: "admin",
+
+			setupProcurementUC: func(m *mocks.ProcurementUseCase) {
+				m.On("Approve", domUser.UserID(1), domProcurement.RequestID(3), "ok").Return(&domProcurement.Request{
+					ID:         3,
+					AuthorID:   2,
+					Status:     domProcurement.Approved,
+					ApproverID: 1,
+				}, nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+		},
+
+		{
+			name: "employee is forbidden",
+			role: "employee",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+
+		{
+			name: "not submitted",
+			role: "admin",
+
+			setupProcurementUC: func(m *mocks.ProcurementUseCase) {
+				m.On("Approve", domUser.UserID(1), domProcurement.RequestID(3), "ok").Return(nil, usecase.ErrPurchaseStatus).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "invalid purchase request status transition",
+		},
+
+		{
+			name: "request not found",
+			role: "admin",
+
+			setupProcurementUC: func(m *mocks.ProcurementUseCase) {
+				m.On("Approve", domUser.UserID(1), domProcurement.RequestID(3), "ok").Return(nil, usecase.ErrPurchaseRequestNotFound).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusNotFound,
+			wantErrorText:  "purchase request not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			procurementUC := mocks.NewProcurementUseCase(t)
+
+			if tc.setupProcurementUC != nil {
+				tc.setupProcurementUC(procurementUC)
+			}
+
+			validator := mocks.NewValidator(t)
+
+			if tc.setupValidator != nil {
+				tc.setupValidator(validator)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithProcurementUseCase(procurementUC, tokenSvc, validator)
+
+			bodyBytes, err := json.Marshal(map[string]string{"comment": "ok"})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/api/procurement/requests/3/approve", bytes.NewReader(bodyBytes))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, "approved", resp["status"])
+				assert.Equal(t, float64(1), resp["approver_id"])
+			}
+
+			procurementUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProcurementHandler_Fulfill(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		setupProcurementUC func(m *mocks.ProcurementUseCase)
+		wantStatusCode     int
+		wantErrorText      string
+	}{
+		{
+			name: "success without comment",
+			role: "employee",
+
+			setupProcurementUC: func(m *mocks.ProcurementUseCase) {
+				m.On("Fulfill", domUser.UserID(1), domProcurement.RequestID(3), "").Return(&domProcurement.Request{
+					ID:     3,
+					Status: domProcurement.Fulfilled,
+				}, nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+		},
+
+		{
+			name: "not approved",
+			role: "employee",
+
+			setupProcurementUC: func(m *mocks.ProcurementUseCase) {
+				m.On("Fulfill", domUser.UserID(1), domProcurement.RequestID(3), "").Return(nil, usecase.ErrPurchaseStatus).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "invalid purchase request status transition",
+		},
+
+		{
+			name: "student is forbidden",
+			role: "student",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			procurementUC := mocks.NewProcurementUseCase(t)
+
+			if tc.setupProcurementUC != nil {
+				tc.setupProcurementUC(procurementUC)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithProcurementUseCase(procurementUC, tokenSvc, mocks.NewValidator(t))
+
+			req, err := http.NewRequest(http.MethodPost, "/api/procurement/requests/3/fulfill", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, "fulfilled", resp["status"])
+			}
+
+			procurementUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProfileHandler struct {
+	profiles  common.ProfileUseCase
+	validator common.Validator
+}
+
+func NewProfileHandler(router *gin.Engine, profiles common.ProfileUseCase, tokenSvc usecase.TokenService, validator common.Validator) {
+	handler := &ProfileHandler{
+		profiles:  profiles,
+		validator: validator,
+	}
+
+	{
+		profile := router.Group("/api/profile", AuthMiddleware(tokenSvc), common.RequirePermission(domUser.PermProfileEdit))
+		profile.GET("/diet", handler.GetDietaryProfile)
+		profile.PUT("/diet", handler.UpdateDietaryProfile)
+	}
+}
+
+type DietaryProfileResponse struct {
+	Allergens    []string  `json:"allergens" example:"nuts,lactose"`
+	Restrictions string    `json:"restrictions" example:"Вегетарианец"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func toDietaryProfileResponse(profile domUser.DietaryProfile) DietaryProfileResponse {
+	return DietaryProfileResponse{
+		Allergens:    toAllergenStrings(profile.Allergens),
+		Restrictions: profile.Restrictions,
+		UpdatedAt:    profile.UpdatedAt,
+	}
+}
+
+// GetDietaryProfile godoc
+//
+//	@Summary		Профиль питания
+//	@Description	Возвращает аллергены и ограничения в питании текущего ученика.
+//	@Tags			profile
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	DietaryProfileResponse		"Профиль питания"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/profile/diet [get]
+func (ph *ProfileHandler) GetDietaryProfile(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	profile, err := ph.profiles.GetProfile(userID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toDietaryProfileResponse(*profile))
+}
+
+// UpdateDietaryProfile godoc
+//
+//	@Summary		Изменение профиля питания
+//	@Description	Заменяет список аллергенов и ограничения в питании текущего ученика.
+//	@Tags			profile
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			input	body		common.DietaryProfileRequest	true	"Аллергены и ограничения"
+//	@Success		200		{object}	DietaryProfileResponse			"Профиль питания обновлен"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		400		{object}	UnknownAllergenErrorResponse	"Неизвестный аллерген"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/profile/diet [put]
+func (ph *ProfileHandler) UpdateDietaryProfile(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.DietaryProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := ph.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	profile, err := ph.profiles.UpdateProfile(domUser.DietaryProfile{
+		UserID:       userID,
+		Allergens:    toAllergens(req.Allergens),
+		Restrictions: req.Restrictions,
+	})
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toDietaryProfileResponse(*profile))
+}
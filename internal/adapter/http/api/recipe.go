@@ -0,0 +1,295 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"canteen-app/internal/adapter/http/common"
+	domInventory "canteen-app/internal/domain/inventory"
+	domMenu "canteen-app/internal/domain/menu"
+	domRecipe "canteen-app/internal/domain/recipe"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecipeHandler struct {
+	recipes   common.RecipeUseCase
+	validator common.Validator
+}
+
+func NewRecipeHandler(router *gin.Engine, recipes common.RecipeUseCase, tokenSvc usecase.TokenService, validator common.Validator) {
+	handler := &RecipeHandler{
+		recipes:   recipes,
+		validator: validator,
+	}
+
+	{
+		menu := router.Group("/api/menu", AuthMiddleware(tokenSvc), common.RequirePermission(domUser.PermRecipesManage))
+		menu.GET("/dishes/:id/recipe", handler.GetRecipe)
+		menu.PUT("/dishes/:id/recipe", handler.SetRecipe)
+		menu.GET("/:id/coverage", handler.Coverage)
+		menu.PUT("/:id/portions", handler.PlanPortions)
+	}
+}
+
+type IngredientResponse struct {
+	ProductID int64   `json:"product_id" example:"1"`
+	Quantity  float64 `json:"quantity" example:"0.08"`
+}
+
+type RecipeResponse struct {
+	DishID      int64                `json:"dish_id" example:"1"`
+	Ingredients []IngredientResponse `json:"ingredients"`
+}
+
+type DishCoverageResponse struct {
+	DishID  int64  `json:"dish_id" example:"1"`
+	Name    string `json:"name" example:"Борщ"`
+	Planned int    `json:"planned" example:"120"`
+	// Available is the number of portions the stock is enough for; it is
+	// null for dishes without a recipe.
+	Available *int `json:"available" example:"80"`
+	Covered   bool `json:"covered" example:"false"`
+}
+
+type ShortageResponse struct {
+	ProductID int64   `json:"product_id" example:"1"`
+	Name      string  `json:"name" example:"Свекла"`
+	Unit      string  `json:"unit" example:"kg"`
+	Required  float64 `json:"required" example:"9.6"`
+	Available float64 `json:"available" example:"6.4"`
+}
+
+type CoverageResponse struct {
+	MenuID    int64                  `json:"menu_id" example:"1"`
+	Covered   bool                   `json:"covered" example:"false"`
+	Dishes    []DishCoverageResponse `json:"dishes"`
+	Shortages []ShortageResponse     `json:"shortages"`
+	Warnings  []string               `json:"warnings" example:"Борщ: запланировано 120 порций, продуктов хватит на 80"`
+}
+
+func toRecipeResponse(recipe domRecipe.Recipe) RecipeResponse {
+	ingredients := make([]IngredientResponse, 0, len(recipe.Ingredients))
+	for _, ingredient := range recipe.Ingredients {
+		ingredients = append(ingredients, IngredientResponse{
+			ProductID: int64(ingredient.ProductID),
+			Quantity:  ingredient.Quantity,
+		})
+	}
+	return RecipeResponse{DishID: int64(recipe.DishID), Ingredients: ingredients}
+}
+
+func toCoverageResponse(coverage domRecipe.Coverage) CoverageResponse {
+	resp := CoverageResponse{
+		MenuID:    int64(coverage.MenuID),
+		Covered:   coverage.Covered(),
+		Dishes:    make([]DishCoverageResponse, 0, len(coverage.Dishes)),
+		Shortages: make([]ShortageResponse, 0, len(coverage.Shortages)),
+		Warnings:  make([]string, 0),
+	}
+
+	for _, dish := range coverage.Dishes {
+		item := DishCoverageResponse{
+			DishID:  int64(dish.DishID),
+			Name:    dish.Name,
+			Planned: dish.Planned,
+			Covered: dish.Covered(),
+		}
+		if dish.Available >= 0 {
+			available := dish.Available
+			item.Available = &available
+		}
+		if !item.Covered {
+			resp.Warnings = append(resp.Warnings, fmt.Sprintf(
+				"%s: запланировано %d порций, продуктов хватит на %d", dish.Name, dish.Planned, dish.Available))
+		}
+		resp.Dishes = append(resp.Dishes, item)
+	}
+
+	for _, shortage := range coverage.Shortages {
+		resp.Shortages = append(resp.Shortages, ShortageResponse{
+			ProductID: int64(shortage.ProductID),
+			Name:      shortage.Name,
+			Unit:      string(shortage.Unit),
+			Required:  shortage.Required,
+			Available: shortage.Available,
+		})
+		resp.Warnings = append(resp.Warnings, fmt.Sprintf(
+			"%s: нужно %s %s, на складе %s %s",
+			shortage.Name,
+			strconv.FormatFloat(shortage.Required, 'f', -1, 64), shortage.Unit,
+			strconv.FormatFloat(shortage.Available, 'f', -1, 64), shortage.Unit))
+	}
+
+	return resp
+}
+
+// GetRecipe godoc
+//
+//	@Summary		Рецептура блюда
+//	@Description	Возвращает продукты со склада, которые расходуются на одну порцию блюда. Доступно сотрудникам столовой и администраторам.
+//	@Tags			menu
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int							true	"Идентификатор блюда"
+//	@Success		200	{object}	RecipeResponse				"Рецептура"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	DishNotFoundErrorResponse	"Блюдо не найдено"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/menu/dishes/{id}/recipe [get]
+func (rh *RecipeHandler) GetRecipe(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	recipe, err := rh.recipes.GetRecipe(domMenu.DishID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toRecipeResponse(*recipe))
+}
+
+// SetRecipe godoc
+//
+//	@Summary		Изменение рецептуры блюда
+//	@Description	Заменяет продукты, которые расходуются на одну порцию блюда. При выдаче заказа они списываются со склада. Пустой список удаляет рецептуру. Доступно сотрудникам столовой и администраторам.
+//	@Tags			menu
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int								true	"Идентификатор блюда"
+//	@Param			input	body		common.RecipeRequest			true	"Продукты на порцию"
+//	@Success		200		{object}	RecipeResponse					"Рецептура обновлена"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		404		{object}	DishNotFoundErrorResponse		"Блюдо не найдено"
+//	@Failure		404		{object}	ProductNotFoundErrorResponse	"Продукт не найден"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/menu/dishes/{id}/recipe [put]
+func (rh *RecipeHandler) SetRecipe(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.RecipeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := rh.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	ingredients := make([]domRecipe.Ingredient, 0, len(req.Ingredients))
+	for _, ingredient := range req.Ingredients {
+		ingredients = append(ingredients, domRecipe.Ingredient{
+			ProductID: domInventory.ProductID(ingredient.ProductID),
+			Quantity:  ingredient.Quantity,
+		})
+	}
+
+	recipe, err := rh.recipes.SetRecipe(domRecipe.Recipe{DishID: domMenu.DishID(id), Ingredients: ingredients})
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toRecipeResponse(*recipe))
+}
+
+// Coverage godoc
+//
+//	@Summary		Обеспеченность меню продуктами
+//	@Description	Сравнивает запланированные порции блюд меню с остатками на складе. Поле warnings перечисляет блюда и продукты, которых не хватает. Доступно сотрудникам столовой и администраторам.
+//	@Tags			menu
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int							true	"Идентификатор меню"
+//	@Success		200	{object}	CoverageResponse			"Обеспеченность меню"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	MenuNotFoundErrorResponse	"Меню не найдено"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/menu/{id}/coverage [get]
+func (rh *RecipeHandler) Coverage(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	coverage, err := rh.recipes.Coverage(domMenu.MenuID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCoverageResponse(*coverage))
+}
+
+// PlanPortions godoc
+//
+//	@Summary		План порций
+//	@Description	Задает количество порций блюд меню, которое планируется приготовить, и возвращает обеспеченность плана продуктами. Блюда, не указанные в запросе, не планируются. Доступно сотрудникам столовой и администраторам.
+//	@Tags			menu
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int								true	"Идентификатор меню"
+//	@Param			input	body		common.PortionsRequest			true	"Порции по блюдам"
+//	@Success		200		{object}	CoverageResponse				"План сохранен"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		400		{object}	DishNotInMenuErrorResponse		"Блюда нет в меню"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		404		{object}	MenuNotFoundErrorResponse		"Меню не найдено"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/menu/{id}/portions [put]
+func (rh *RecipeHandler) PlanPortions(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.PortionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := rh.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	portions := make(map[domMenu.DishID]int, len(req.Dishes))
+	for _, dish := range req.Dishes {
+		portions[domMenu.DishID(dish.DishID)] += dish.Portions
+	}
+
+	coverage, err := rh.recipes.PlanPortions(domMenu.MenuID(id), portions)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCoverageResponse(*coverage))
+}
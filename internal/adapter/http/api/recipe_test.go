@@ -0,0 +1,137 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"canteen-app/internal/adapter/http/api/mocks"
+	"canteen-app/internal/adapter/http/common"
+	domMenu "canteen-app/internal/domain/menu"
+	domRecipe "canteen-app/internal/domain/recipe"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRouterWithRecipeUseCase(recipeUC *mocks.RecipeUseCase, tokenSvc usecase.TokenService, validator *mocks.Validator) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	NewRecipeHandler(r, recipeUC, tokenSvc, validator)
+
+	return r
+}
+
+func TestRecipeHandler_PlanPortions(t *testing.T) {
+	requestBody := map[string]interface{}{
+		"dishes": []map[string]int{{"dish_id": 2, "portions": 120}},
+	}
+	validRequest := common.PortionsRequest{
+		Dishes: []common.DishPortionsRequest{{DishID: 2, Portions: 120}},
+	}
+	portions := map[domMenu.DishID]int{2: 120}
+
+	tests := []struct {
+		name           string
+		role           string
+		setupRecipeUC  func(m *mocks.RecipeUseCase)
+		setupValidator func(m *mocks.Validator)
+		wantStatusCode int
+		wantErrorText  string
+		wantWarnings   int
+	}{
+		{
+			name: "stock does not cover the plan",
+			role: "employee",
+
+			setupRecipeUC: func(m *mocks.RecipeUseCase) {
+				m.On("PlanPortions", domMenu.MenuID(1), portions).Return(&domRecipe.Coverage{
+					MenuID: 1,
+					Dishes: []domRecipe.DishCoverage{{DishID: 2, Name: "Борщ", Planned: 120, Available: 80}},
+					Shortages: []domRecipe.Shortage{
+						{ProductID: 1, Name: "Свекла", Unit: "kg", Required: 9.6, Available: 6.4},
+					},
+				}, nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+			wantWarnings:   2,
+		},
+
+		{
+			name: "dish not in menu",
+			role: "admin",
+
+			setupRecipeUC: func(m *mocks.RecipeUseCase) {
+				m.On("PlanPortions", domMenu.MenuID(1), portions).Return(nil, usecase.ErrDishNotInMenu).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusBadRequest,
+			wantErrorText:  "dish is not in menu",
+		},
+
+		{
+			name: "student is forbidden",
+			role: "student",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			recipeUC := mocks.NewRecipeUseCase(t)
+
+			if tc.setupRecipeUC != nil {
+				tc.setupRecipeUC(recipeUC)
+			}
+
+			validator := mocks.NewValidator(t)
+
+			if tc.setupValidator != nil {
+				tc.setupValidator(validator)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithRecipeUseCase(recipeUC, tokenSvc, validator)
+
+			bodyBytes, err := json.Marshal(requestBody)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPut, "/api/menu/1/portions", bytes.NewReader(bodyBytes))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, false, resp["covered"])
+				assert.Len(t, resp["warnings"], tc.wantWarnings)
+			}
+
+			recipeUC.AssertExpectations(t)
+		})
+	}
+}
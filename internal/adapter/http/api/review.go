@@ -0,0 +1,233 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domReview "canteen-app/internal/domain/review"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewHandler struct {
+	reviews   common.ReviewUseCase
+	validator common.Validator
+}
+
+func NewReviewHandler(router *gin.Engine, reviews common.ReviewUseCase, tokenSvc usecase.TokenService, validator common.Validator) {
+	handler := &ReviewHandler{
+		reviews:   reviews,
+		validator: validator,
+	}
+
+	{
+		reviews := router.Group("/api/reviews", AuthMiddleware(tokenSvc))
+		reviews.GET("", handler.ListDishReviews)
+		reviews.POST("", common.RequirePermission(domUser.PermReviewsWrite), handler.CreateReview)
+
+		moderation := reviews.Group("", common.RequirePermission(domUser.PermReviewsModerate))
+		moderation.GET("/moderation", handler.ListAllReviews)
+		moderation.POST("/:id/hide", handler.HideReview)
+		moderation.POST("/:id/unhide", handler.UnhideReview)
+	}
+}
+
+type ReviewResponse struct {
+	ID        int64     `json:"id" example:"1"`
+	StudentID int64     `json:"student_id" example:"1"`
+	OrderID   int64     `json:"order_id" example:"1"`
+	DishID    int64     `json:"dish_id" example:"1"`
+	Rating    int       `json:"rating" example:"5"`
+	Comment   string    `json:"comment" example:"Очень вкусно"`
+	Hidden    bool      `json:"hidden" example:"false"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type RatingResponse struct {
+	Average float64 `json:"average" example:"4.5"`
+	Count   int     `json:"count" example:"12"`
+}
+
+func toReviewResponse(review domReview.Review) ReviewResponse {
+	return ReviewResponse{
+		ID:        int64(review.ID),
+		StudentID: int64(review.StudentID),
+		OrderID:   int64(review.OrderID),
+		DishID:    int64(review.DishID),
+		Rating:    review.Rating,
+		Comment:   review.Comment,
+		Hidden:    review.Hidden,
+		CreatedAt: review.CreatedAt,
+	}
+}
+
+func toReviewResponses(reviews []domReview.Review) []ReviewResponse {
+	resp := make([]ReviewResponse, 0, len(reviews))
+	for _, review := range reviews {
+		resp = append(resp, toReviewResponse(review))
+	}
+	return resp
+}
+
+// CreateReview godoc
+//
+//	@Summary		Отзыв о блюде
+//	@Description	Ставит оценку от 1 до 5 и оставляет комментарий к блюду из выданного заказа текущего ученика. Каждое блюдо заказа можно оценить один раз.
+//	@Tags			reviews
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			input	body		common.ReviewRequest			true	"Заказ, блюдо, оценка и комментарий"
+//	@Success		201		{object}	ReviewResponse					"Отзыв создан"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		403		{object}	ReviewNotAllowedErrorResponse	"Заказ еще не выдан или не содержит блюдо"
+//	@Failure		404		{object}	OrderNotFoundErrorResponse		"Заказ не найден"
+//	@Failure		409		{object}	AlreadyReviewedErrorResponse	"Блюдо из этого заказа уже оценено"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/reviews [post]
+func (rh *ReviewHandler) CreateReview(c *gin.Context) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.ReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := rh.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	review, err := rh.reviews.CreateReview(domReview.Review{
+		StudentID: studentID,
+		OrderID:   domOrder.OrderID(req.OrderID),
+		DishID:    domMenu.DishID(req.DishID),
+		Rating:    req.Rating,
+		Comment:   req.Comment,
+	})
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toReviewResponse(*review))
+}
+
+// ListDishReviews godoc
+//
+//	@Summary		Отзывы о блюде
+//	@Description	Возвращает отзывы о блюде, кроме скрытых модератором.
+//	@Tags			reviews
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			dish_id	query		int							true	"Идентификатор блюда"
+//	@Success		200		{array}		ReviewResponse				"Список отзывов"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/reviews [get]
+func (rh *ReviewHandler) ListDishReviews(c *gin.Context) {
+	dishID, err := strconv.ParseInt(c.Query("dish_id"), 10, 64)
+	if err != nil || dishID <= 0 {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	reviews, err := rh.reviews.ListDishReviews(domMenu.DishID(dishID))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toReviewResponses(reviews))
+}
+
+// ListAllReviews godoc
+//
+//	@Summary		Модерация отзывов
+//	@Description	Возвращает все отзывы, включая скрытые. Доступно администраторам.
+//	@Tags			reviews
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		ReviewResponse				"Список отзывов"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/reviews/moderation [get]
+func (rh *ReviewHandler) ListAllReviews(c *gin.Context) {
+	reviews, err := rh.reviews.ListAllReviews()
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toReviewResponses(reviews))
+}
+
+// HideReview godoc
+//
+//	@Summary		Скрытие отзыва
+//	@Description	Скрывает комментарий отзыва от других пользователей. Оценка продолжает учитываться в рейтинге блюда. Доступно администраторам.
+//	@Tags			reviews
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int							true	"Идентификатор отзыва"
+//	@Success		200	{object}	ReviewResponse				"Отзыв скрыт"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	ReviewNotFoundErrorResponse	"Отзыв не найден"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/reviews/{id}/hide [post]
+func (rh *ReviewHandler) HideReview(c *gin.Context) {
+	rh.setHidden(c, true)
+}
+
+// UnhideReview godoc
+//
+//	@Summary		Восстановление отзыва
+//	@Description	Снова показывает скрытый отзыв. Доступно администраторам.
+//	@Tags			reviews
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int							true	"Идентификатор отзыва"
+//	@Success		200	{object}	ReviewResponse				"Отзыв восстановлен"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		404	{object}	ReviewNotFoundErrorResponse	"Отзыв не найден"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/reviews/{id}/unhide [post]
+func (rh *ReviewHandler) UnhideReview(c *gin.Context) {
+	rh.setHidden(c, false)
+}
+
+func (rh *ReviewHandler) setHidden(c *gin.Context, hidden bool) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	review, err := rh.reviews.SetHidden(domReview.ReviewID(id), hidden)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toReviewResponse(*review))
+}
@@ -0,0 +1,221 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"canteen-app/internal/adapter/http/api/mocks"
+	"canteen-app/internal/adapter/http/common"
+	domReview "canteen-app/internal/domain/review"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRouterWithReviewUseCase(reviewUC *mocks.ReviewUseCase, tokenSvc usecase.TokenService, validator *mocks.Validator) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	NewReviewHandler(r, reviewUC, tokenSvc, validator)
+
+	return r
+}
+
+func TestReviewHandler_CreateReview(t *testing.T) {
+	requestBody := map[string]interface{}{
+		"order_id": 3,
+		"dish_id":  2,
+		"rating":   5,
+		"comment":  "tasty",
+	}
+	validRequest := common.ReviewRequest{OrderID: 3, DishID: 2, Rating: 5, Comment: "tasty"}
+	review := domReview.Review{StudentID: 1, OrderID: 3, DishID: 2, Rating: 5, Comment: "tasty"}
+
+	tests := []struct {
+		name           string
+		role           string
+		setupReviewUC  func(m *mocks.ReviewUseCase)
+		setupValidator func(m *mocks.Validator)
+		wantStatusCode int
+		wantErrorText  string
+	}{
+		{
+			name: "success",
+			role: "student",
+
+			setupReviewUC: func(m *mocks.ReviewUseCase) {
+				created := review
+				created.ID = 1
+				m.On("CreateReview", review).Return(&created, nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusCreated,
+		},
+
+		{
+			name: "order not issued",
+			role: "student",
+
+			setupReviewUC: func(m *mocks.ReviewUseCase) {
+				m.On("CreateReview", review).Return(nil, usecase.ErrReviewNotAllowed).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "only dishes from issued orders can be reviewed",
+		},
+
+		{
+			name: "already reviewed",
+			role: "student",
+
+			setupReviewUC: func(m *mocks.ReviewUseCase) {
+				m.On("CreateReview", review).Return(nil, usecase.ErrAlreadyReviewed).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "dish already reviewed for this order",
+		},
+
+		{
+			name: "employee is forbidden",
+			role: "employee",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			reviewUC := mocks.NewReviewUseCase(t)
+
+			if tc.setupReviewUC != nil {
+				tc.setupReviewUC(reviewUC)
+			}
+
+			validator := mocks.NewValidator(t)
+
+			if tc.setupValidator != nil {
+				tc.setupValidator(validator)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithReviewUseCase(reviewUC, tokenSvc, validator)
+
+			bodyBytes, err := json.Marshal(requestBody)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/api/reviews", bytes.NewReader(bodyBytes))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, float64(1), resp["id"])
+				assert.Equal(t, float64(5), resp["rating"])
+			}
+
+			reviewUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewHandler_HideReview(t *testing.T) {
+	tests := []struct {
+		name           string
+		role           string
+		setupReviewUC  func(m *mocks.ReviewUseCase)
+		wantStatusCode int
+		wantErrorText  string
+	}{
+		{
+			name: "success",
+			role: "admin",
+
+			setupReviewUC: func(m *mocks.ReviewUseCase) {
+				m.On("SetHidden", domReview.ReviewID(4), true).Return(&domReview.Review{ID: 4, Rating: 1, Hidden: true}, nil).Once()
+			},
+
+			wantStatusCode: http.StatusOK,
+		},
+
+		{
+			name: "review not found",
+			role: "admin",
+
+			setupReviewUC: func(m *mocks.ReviewUseCase) {
+				m.On("SetHidden", domReview.ReviewID(4), true).Return(nil, usecase.ErrReviewNotFound).Once()
+			},
+
+			wantStatusCode: http.StatusNotFound,
+			wantErrorText:  "review not found",
+		},
+
+		{
+			name: "employee is forbidden",
+			role: "employee",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			reviewUC := mocks.NewReviewUseCase(t)
+
+			if tc.setupReviewUC != nil {
+				tc.setupReviewUC(reviewUC)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithReviewUseCase(reviewUC, tokenSvc, mocks.NewValidator(t))
+
+			req, err := http.NewRequest(http.MethodPost, "/api/reviews/4/hide", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, true, resp["hidden"])
+			}
+
+			reviewUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+	domMenu "canteen-app/internal/domain/menu"
+	domSubscription "canteen-app/internal/domain/subscription"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SubscriptionHandler struct {
+	subscriptions common.SubscriptionUseCase
+	validator     common.Validator
+}
+
+func NewSubscriptionHandler(router *gin.Engine, subscriptions common.SubscriptionUseCase, tokenSvc usecase.TokenService, validator common.Validator) {
+	handler := &SubscriptionHandler{
+		subscriptions: subscriptions,
+		validator:     validator,
+	}
+
+	{
+		subscriptions := router.Group("/api/subscriptions", AuthMiddleware(tokenSvc))
+		subscriptions.GET("/plans", handler.ListPlans)
+		subscriptions.POST("/plans", common.RequirePermission(domUser.PermPlansEdit), handler.CreatePlan)
+
+		student := subscriptions.Group("", common.RequirePermission(domUser.PermSubscriptionsBuy))
+		student.GET("", handler.ListSubscriptions)
+		student.POST("/plans/:id/purchase", handler.Purchase)
+	}
+}
+
+type PlanResponse struct {
+	ID           int64  `json:"id" example:"1"`
+	Name         string `json:"name" example:"Обеды, будни, октябрь"`
+	MealType     string `json:"meal_type" example:"lunch"`
+	StartDate    string `json:"start_date" example:"2026-10-01"`
+	EndDate      string `json:"end_date" example:"2026-10-31"`
+	WeekdaysOnly bool   `json:"weekdays_only" example:"true"`
+	Price        int64  `json:"price" example:"250000"`
+}
+
+type SubscriptionResponse struct {
+	ID             int64        `json:"id" example:"1"`
+	Plan           PlanResponse `json:"plan"`
+	RemainingMeals int          `json:"remaining_meals" example:"22"`
+	PurchasedAt    time.Time    `json:"purchased_at"`
+}
+
+func toPlanResponse(plan domSubscription.Plan) PlanResponse {
+	return PlanResponse{
+		ID:           int64(plan.ID),
+		Name:         plan.Name,
+		MealType:     string(plan.MealType),
+		StartDate:    plan.StartDate.Format(common.DateLayout),
+		EndDate:      plan.EndDate.Format(common.DateLayout),
+		WeekdaysOnly: plan.WeekdaysOnly,
+		Price:        plan.Price,
+	}
+}
+
+func toSubscriptionResponse(sub domSubscription.Subscription) SubscriptionResponse {
+	return SubscriptionResponse{
+		ID:             int64(sub.ID),
+		Plan:           toPlanResponse(sub.Plan),
+		RemainingMeals: sub.RemainingMeals,
+		PurchasedAt:    sub.PurchasedAt,
+	}
+}
+
+// ListPlans godoc
+//
+//	@Summary		Абонементы
+//	@Description	Возвращает все абонементы, доступные для покупки.
+//	@Tags			subscriptions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		PlanResponse				"Список абонементов"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/subscriptions/plans [get]
+func (sh *SubscriptionHandler) ListPlans(c *gin.Context) {
+	plans, err := sh.subscriptions.ListPlans()
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	resp := make([]PlanResponse, 0, len(plans))
+	for _, plan := range plans {
+		resp = append(resp, toPlanResponse(plan))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreatePlan godoc
+//
+//	@Summary		Создание абонемента
+//	@Description	Создает абонемент на завтраки или обеды за период. Доступно администраторам.
+//	@Tags			subscriptions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			input	body		common.PlanRequest			true	"Параметры абонемента"
+//	@Success		201		{object}	PlanResponse				"Абонемент создан"
+//	@Failure		400		{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse		"Данные невалидны"
+//	@Failure		400		{object}	InvalidPlanErrorResponse	"Некорректный период или цена"
+//	@Failure		401		{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500		{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/subscriptions/plans [post]
+func (sh *SubscriptionHandler) CreatePlan(c *gin.Context) {
+	var req common.PlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := sh.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	start, err := time.Parse(common.DateLayout, req.StartDate)
+	if err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	end, err := time.Parse(common.DateLayout, req.EndDate)
+	if err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	plan, err := sh.subscriptions.CreatePlan(domSubscription.Plan{
+		Name:         req.Name,
+		MealType:     domMenu.MealType(req.MealType),
+		StartDate:    start,
+		EndDate:      end,
+		WeekdaysOnly: req.WeekdaysOnly,
+		Price:        req.Price,
+	})
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toPlanResponse(*plan))
+}
+
+// Purchase godoc
+//
+//	@Summary		Покупка абонемента
+//	@Description	Списывает стоимость абонемента с баланса текущего ученика. Абонемент покрывает оставшиеся дни периода.
+//	@Tags			subscriptions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int								true	"Идентификатор абонемента"
+//	@Success		201	{object}	SubscriptionResponse			"Абонемент куплен"
+//	@Failure		400	{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		404	{object}	PlanNotFoundErrorResponse		"Абонемент не найден"
+//	@Failure		409	{object}	PlanExpiredErrorResponse		"Период абонемента закончился"
+//	@Failure		409	{object}	InsufficientFundsErrorResponse	"Недостаточно средств на балансе"
+//	@Failure		500	{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/subscriptions/plans/{id}/purchase [post]
+func (sh *SubscriptionHandler) Purchase(c *gin.Context) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	sub, err := sh.subscriptions.Purchase(studentID, domSubscription.PlanID(id))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toSubscriptionResponse(*sub))
+}
+
+// ListSubscriptions godoc
+//
+//	@Summary		Абонементы ученика
+//	@Description	Возвращает все купленные абонементы текущего ученика с количеством оставшихся приемов пищи.
+//	@Tags			subscriptions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		SubscriptionResponse		"Список абонементов"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/subscriptions [get]
+func (sh *SubscriptionHandler) ListSubscriptions(c *gin.Context) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	subs, err := sh.subscriptions.ListStudentSubscriptions(studentID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	resp := make([]SubscriptionResponse, 0, len(subs))
+	for _, sub := range subs {
+		resp = append(resp, toSubscriptionResponse(sub))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,184 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WalletHandler struct {
+	wallet    common.WalletUseCase
+	validator common.Validator
+}
+
+func NewWalletHandler(router *gin.Engine, wallet common.WalletUseCase, tokenSvc usecase.TokenService, validator common.Validator) {
+	handler := &WalletHandler{
+		wallet:    wallet,
+		validator: validator,
+	}
+
+	{
+		wallet := router.Group("/api/wallet", AuthMiddleware(tokenSvc))
+
+		student := wallet.Group("", common.RequirePermission(domUser.PermWalletView))
+		student.GET("", handler.GetWallet)
+
+		admin := wallet.Group("/students", common.RequirePermission(domUser.PermWalletsManage))
+		admin.GET("/:id", handler.GetStudentWallet)
+		admin.POST("/:id/entries", handler.PostEntry)
+	}
+}
+
+type WalletEntryResponse struct {
+	ID        int64     `json:"id" example:"1"`
+	Kind      string    `json:"kind" example:"charge"`
+	Amount    int64     `json:"amount" example:"-12000"`
+	OrderID   int64     `json:"order_id,omitempty" example:"1"`
+	PaymentID string    `json:"payment_id,omitempty" example:""`
+	AuthorID  int64     `json:"author_id" example:"1"`
+	Comment   string    `json:"comment,omitempty" example:""`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WalletResponse struct {
+	StudentID int64                 `json:"student_id" example:"1"`
+	Balance   int64                 `json:"balance" example:"50000"`
+	Entries   []WalletEntryResponse `json:"entries"`
+}
+
+func toWalletEntryResponse(entry domWallet.Entry) WalletEntryResponse {
+	return WalletEntryResponse{
+		ID:        int64(entry.ID),
+		Kind:      string(entry.Kind),
+		Amount:    entry.Amount,
+		OrderID:   int64(entry.OrderID),
+		PaymentID: string(entry.PaymentID),
+		AuthorID:  int64(entry.AuthorID),
+		Comment:   entry.Comment,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+func (wh *WalletHandler) writeWallet(c *gin.Context, studentID domUser.UserID) {
+	entries, err := wh.wallet.History(studentID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	resp := WalletResponse{
+		StudentID: int64(studentID),
+		Balance:   domWallet.Balance(entries),
+		Entries:   make([]WalletEntryResponse, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, toWalletEntryResponse(entry))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetWallet godoc
+//
+//	@Summary		Баланс ученика
+//	@Description	Возвращает баланс текущего ученика и историю операций по его счету.
+//	@Tags			wallet
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	WalletResponse				"Баланс и история операций"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/wallet [get]
+func (wh *WalletHandler) GetWallet(c *gin.Context) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	wh.writeWallet(c, studentID)
+}
+
+// GetStudentWallet godoc
+//
+//	@Summary		Баланс ученика (администратор)
+//	@Description	Возвращает баланс и историю операций указанного ученика. Доступно администраторам.
+//	@Tags			wallet
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int							true	"Идентификатор ученика"
+//	@Success		200	{object}	WalletResponse				"Баланс и история операций"
+//	@Failure		400	{object}	InvalidRequestErrorResponse	"Некорректный запрос"
+//	@Failure		401	{object}	UnauthorizedErrorResponse	"Пользователь не аутентифицирован"
+//	@Failure		403	{object}	ForbiddenErrorResponse		"Недостаточно прав"
+//	@Failure		500	{object}	InternalServerErrorResponse	"Внутренняя ошибка сервера"
+//	@Router			/api/wallet/students/{id} [get]
+func (wh *WalletHandler) GetStudentWallet(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	wh.writeWallet(c, domUser.UserID(id))
+}
+
+// PostEntry godoc
+//
+//	@Summary		Корректировка баланса
+//	@Description	Добавляет в историю операций ученика пополнение (top_up) или корректировку (correction). Доступно администраторам.
+//	@Tags			wallet
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int								true	"Идентификатор ученика"
+//	@Param			input	body		common.WalletEntryRequest		true	"Тип операции, сумма и комментарий"
+//	@Success		201		{object}	WalletEntryResponse				"Операция добавлена"
+//	@Failure		400		{object}	InvalidRequestErrorResponse		"Некорректный запрос"
+//	@Failure		400		{object}	ValidationErrorResponse			"Данные невалидны"
+//	@Failure		400		{object}	InvalidAmountErrorResponse		"Некорректная сумма"
+//	@Failure		401		{object}	UnauthorizedErrorResponse		"Пользователь не аутентифицирован"
+//	@Failure		403		{object}	ForbiddenErrorResponse			"Недостаточно прав"
+//	@Failure		404		{object}	UserNotFoundErrorResponse		"Ученик не найден"
+//	@Failure		409		{object}	InsufficientFundsErrorResponse	"Баланс ученика стал бы отрицательным"
+//	@Failure		500		{object}	InternalServerErrorResponse		"Внутренняя ошибка сервера"
+//	@Router			/api/wallet/students/{id}/entries [post]
+func (wh *WalletHandler) PostEntry(c *gin.Context) {
+	adminID, err := currentUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req common.WalletEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, common.ErrInvalidRequest)
+		return
+	}
+
+	if err := wh.validator.Struct(req); err != nil {
+		writeError(c, common.ErrValidationError)
+		return
+	}
+
+	entry, err := wh.wallet.PostAdminEntry(adminID, domUser.UserID(id), domWallet.EntryKind(req.Kind), req.Amount, req.Comment)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toWalletEntryResponse(*entry))
+}
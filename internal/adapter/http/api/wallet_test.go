@@ -0,0 +1,130 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"canteen-app/internal/adapter/http/api/mocks"
+	"canteen-app/internal/adapter/http/common"
+	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRouterWithWalletUseCase(walletUC *mocks.WalletUseCase, tokenSvc usecase.TokenService, validator *mocks.Validator) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	NewWalletHandler(r, walletUC, tokenSvc, validator)
+
+	return r
+}
+
+func TestWalletHandler_PostEntry(t *testing.T) {
+	requestBody := map[string]interface{}{
+		"kind":    "correction",
+		"amount":  -5000,
+		"comment": "wrong charge",
+	}
+	validRequest := common.WalletEntryRequest{Kind: "correction", Amount: -5000, Comment: "wrong charge"}
+
+	tests := []struct {
+		name           string
+		role           string
+		setupWalletUC  func(m *mocks.WalletUseCase)
+		setupValidator func(m *mocks.Validator)
+		wantStatusCode int
+		wantErrorText  string
+	}{
+		{
+			name: "success",
+			role: "admin",
+
+			setupWalletUC: func(m *mocks.WalletUseCase) {
+				m.On("PostAdminEntry", domUser.UserID(1), domUser.UserID(5), domWallet.Correction, int64(-5000), "wrong charge").Return(
+					&domWallet.Entry{ID: 3, StudentID: 5, Kind: domWallet.Correction, Amount: -5000, AuthorID: 1}, nil).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusCreated,
+		},
+
+		{
+			name: "insufficient funds",
+			role: "admin",
+
+			setupWalletUC: func(m *mocks.WalletUseCase) {
+				m.On("PostAdminEntry", domUser.UserID(1), domUser.UserID(5), domWallet.Correction, int64(-5000), "wrong charge").Return(
+					nil, usecase.ErrInsufficientFunds).Once()
+			},
+
+			setupValidator: func(m *mocks.Validator) {
+				m.On("Struct", validRequest).Return(nil).Once()
+			},
+
+			wantStatusCode: http.StatusConflict,
+			wantErrorText:  "insufficient funds",
+		},
+
+		{
+			name: "student is forbidden",
+			role: "student",
+
+			wantStatusCode: http.StatusForbidden,
+			wantErrorText:  "forbidden",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			walletUC := mocks.NewWalletUseCase(t)
+
+			if tc.setupWalletUC != nil {
+				tc.setupWalletUC(walletUC)
+			}
+
+			validator := mocks.NewValidator(t)
+
+			if tc.setupValidator != nil {
+				tc.setupValidator(validator)
+			}
+
+			tokenSvc := newTestTokenService()
+			router := setupRouterWithWalletUseCase(walletUC, tokenSvc, validator)
+
+			bodyBytes, err := json.Marshal(requestBody)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/api/wallet/students/5/entries", bytes.NewReader(bodyBytes))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", bearer(t, tokenSvc, tc.role))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantStatusCode, w.Code)
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tc.wantErrorText != "" {
+				assert.Equal(t, tc.wantErrorText, resp["error"])
+			} else {
+				assert.Equal(t, "correction", resp["kind"])
+				assert.Equal(t, float64(-5000), resp["amount"])
+			}
+
+			walletUC.AssertExpectations(t)
+		})
+	}
+}
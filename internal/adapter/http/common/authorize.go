@@ -0,0 +1,30 @@
+package common
+
+import (
+	"net/http"
+
+	domUser "canteen-app/internal/domain/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CurrentRole returns the role the auth middleware put into the context, or
+// the zero role, which has no permissions, for anonymous requests.
+func CurrentRole(c *gin.Context) domUser.Role {
+	role, _ := c.Get("userRole")
+	r, _ := role.(domUser.Role)
+	return r
+}
+
+// RequirePermission aborts with 403 unless the role of the user is granted
+// the permission. It goes after the auth middleware of the api or web
+// adapter.
+func RequirePermission(permission domUser.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !CurrentRole(c).Can(permission) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "forbidden"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,17 @@
+package common
+
+import (
+	domAuth "canteen-app/internal/domain/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Client describes the device of the request for the session list. The IP is
+// the peer address unless the peer is one of http.trusted_proxies, none by
+// default, in which case X-Forwarded-For is believed.
+func Client(c *gin.Context) domAuth.Client {
+	return domAuth.Client{
+		UserAgent: c.Request.UserAgent(),
+		IP:        c.ClientIP(),
+	}
+}
@@ -0,0 +1,20 @@
+package common
+
+import "github.com/gin-gonic/gin"
+
+const secureCookiesKey = "secureCookies"
+
+// SecureCookies is the router middleware that tells the handlers whether the
+// cookies they set must be marked Secure.
+func SecureCookies(secure bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(secureCookiesKey, secure)
+		c.Next()
+	}
+}
+
+// CookieSecure reports the Secure flag for cookies set in this request; it is
+// false when the SecureCookies middleware is not installed.
+func CookieSecure(c *gin.Context) bool {
+	return c.GetBool(secureCookiesKey)
+}
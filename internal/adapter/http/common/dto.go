@@ -1,14 +1,194 @@
 package common
 
+import domUser "canteen-app/internal/domain/user"
+
+const DateLayout = "2006-01-02"
+
 type RegisterRequest struct {
 	Login    string `json:"login" binding:"required" validate:"required,max=50,min=2" example:"the_real_slim_shady"`
 	Password string `json:"password" binding:"required" validate:"required,max=100,min=8" example:"password1234"`
 	Name     string `json:"name" binding:"required" validate:"required,max=100,alpha" example:"Slim"`
 	Surname  string `json:"surname" binding:"required" validate:"required,max=100,alpha" example:"Shady"`
-	Role     string `json:"role" binding:"required" validate:"required,oneof=admin employee student" example:"admin"`
+	// Email is optional; it is where password reset links are sent.
+	Email string `json:"email" validate:"omitempty,max=254,email" example:"slim@example.com"`
+	// InviteCode is the class invitation a student registers with.
+	InviteCode string `json:"invite_code" binding:"required" validate:"required,max=64" example:"K7QX2MPA"`
+}
+
+type InvitationRequest struct {
+	Class     string `json:"class" binding:"required" validate:"required,max=50" example:"7Б"`
+	MaxUses   int    `json:"max_uses" binding:"required" validate:"required,min=1,max=100" example:"30"`
+	ValidDays int    `json:"valid_days" binding:"required" validate:"required,min=1,max=90" example:"14"`
+}
+
+type CreateAccountRequest struct {
+	Login   string       `json:"login" binding:"required" validate:"required,max=50,min=2" example:"cook_maria"`
+	Name    string       `json:"name" binding:"required" validate:"required,max=100,alpha" example:"Maria"`
+	Surname string       `json:"surname" binding:"required" validate:"required,max=100,alpha" example:"Ivanova"`
+	Email   string       `json:"email" validate:"omitempty,max=254,email" example:"maria@example.com"`
+	Role    domUser.Role `json:"role" binding:"required" validate:"required,role" swaggertype:"string" enums:"admin,employee,student" example:"employee"`
+}
+
+// DefaultPerPage is the page size of user lists when none is asked for.
+const DefaultPerPage = 20
+
+// UserListRequest selects a page of users, counting pages from 1.
+type UserListRequest struct {
+	Search  string       `form:"search" validate:"max=100"`
+	Role    domUser.Role `form:"role" validate:"omitempty,role"`
+	Page    int          `form:"page" validate:"min=0"`
+	PerPage int          `form:"per_page" validate:"min=0,max=100"`
+}
+
+// WithDefaults fills in the first page and DefaultPerPage.
+func (r UserListRequest) WithDefaults() UserListRequest {
+	if r.Page == 0 {
+		r.Page = 1
+	}
+	if r.PerPage == 0 {
+		r.PerPage = DefaultPerPage
+	}
+	return r
+}
+
+func (r UserListRequest) ListQuery() domUser.ListQuery {
+	r = r.WithDefaults()
+	return domUser.ListQuery{
+		Search: r.Search,
+		Role:   r.Role,
+		Offset: (r.Page - 1) * r.PerPage,
+		Limit:  r.PerPage,
+	}
+}
+
+type ChangeRoleRequest struct {
+	Role domUser.Role `json:"role" binding:"required" validate:"required,role" swaggertype:"string" enums:"admin,employee,student" example:"employee"`
+}
+
+type SetPasswordRequest struct {
+	Token    string `json:"token" binding:"required" validate:"required,max=100"`
+	Password string `json:"password" binding:"required" validate:"required,max=100,min=8" example:"password1234"`
+}
+
+// ForgotPasswordRequest names the account by its login or email.
+type ForgotPasswordRequest struct {
+	Login string `json:"login" binding:"required" validate:"required,max=254" example:"the_real_slim_shady"`
 }
 
 type LoginRequest struct {
 	Login    string `json:"login" binding:"required" validate:"required,max=50" example:"the_real_slim_shady"`
 	Password string `json:"password" binding:"required" validate:"required,max=100" example:"password1234"`
 }
+
+type DishRequest struct {
+	Name        string   `json:"name" binding:"required" validate:"required,max=100" example:"Борщ"`
+	Description string   `json:"description" validate:"max=500" example:"Борщ со сметаной"`
+	Price       int64    `json:"price" binding:"required" validate:"required,min=1" example:"12000"`
+	Weight      int      `json:"weight" binding:"required" validate:"required,min=1,max=5000" example:"250"`
+	Allergens   []string `json:"allergens" validate:"max=20,dive,oneof=gluten lactose nuts peanuts eggs fish shellfish soy sesame celery mustard" example:"gluten,lactose"`
+}
+
+type MenuRequest struct {
+	Date     string  `json:"date" binding:"required" validate:"required,datetime=2006-01-02" example:"2026-10-19"`
+	MealType string  `json:"meal_type" binding:"required" validate:"required,oneof=breakfast lunch" example:"lunch"`
+	DishIDs  []int64 `json:"dish_ids" binding:"required" validate:"required,min=1,dive,min=1" example:"1,2"`
+}
+
+type UpdateMenuRequest struct {
+	DishIDs []int64 `json:"dish_ids" binding:"required" validate:"required,min=1,dive,min=1" example:"1,2"`
+}
+
+type OrderItemRequest struct {
+	DishID   int64 `json:"dish_id" binding:"required" validate:"required,min=1" example:"1"`
+	Quantity int   `json:"quantity" binding:"required" validate:"required,min=1,max=10" example:"1"`
+}
+
+type OrderRequest struct {
+	MenuID int64              `json:"menu_id" binding:"required" validate:"required,min=1" example:"1"`
+	Items  []OrderItemRequest `json:"items" binding:"required" validate:"required,min=1,max=20,dive"`
+	// ConfirmAllergens must be set to order dishes that contain allergens
+	// from the student's dietary profile.
+	ConfirmAllergens bool `json:"confirm_allergens" example:"false"`
+}
+
+type WalletEntryRequest struct {
+	Kind    string `json:"kind" binding:"required" validate:"required,oneof=top_up correction" example:"correction"`
+	Amount  int64  `json:"amount" binding:"required" validate:"required,ne=0,min=-10000000,max=10000000" example:"-5000"`
+	Comment string `json:"comment" binding:"required" validate:"required,max=200" example:"Ошибочное списание"`
+}
+
+type TopUpRequest struct {
+	Amount int64 `json:"amount" binding:"required" validate:"required,min=100,max=1000000" example:"50000"`
+}
+
+type PlanRequest struct {
+	Name         string `json:"name" binding:"required" validate:"required,max=100" example:"Обеды, будни, октябрь"`
+	MealType     string `json:"meal_type" binding:"required" validate:"required,oneof=breakfast lunch" example:"lunch"`
+	StartDate    string `json:"start_date" binding:"required" validate:"required,datetime=2006-01-02" example:"2026-10-01"`
+	EndDate      string `json:"end_date" binding:"required" validate:"required,datetime=2006-01-02" example:"2026-10-31"`
+	WeekdaysOnly bool   `json:"weekdays_only" example:"true"`
+	Price        int64  `json:"price" binding:"required" validate:"required,min=1" example:"250000"`
+}
+
+type DietaryProfileRequest struct {
+	Allergens    []string `json:"allergens" validate:"max=20,dive,oneof=gluten lactose nuts peanuts eggs fish shellfish soy sesame celery mustard" example:"nuts,lactose"`
+	Restrictions string   `json:"restrictions" validate:"max=500" example:"Вегетарианец"`
+}
+
+type ReviewRequest struct {
+	OrderID int64  `json:"order_id" binding:"required" validate:"required,min=1" example:"1"`
+	DishID  int64  `json:"dish_id" binding:"required" validate:"required,min=1" example:"1"`
+	Rating  int    `json:"rating" binding:"required" validate:"required,min=1,max=5" example:"5"`
+	Comment string `json:"comment" validate:"max=1000" example:"Очень вкусно"`
+}
+
+type ProductRequest struct {
+	Name        string  `json:"name" binding:"required" validate:"required,max=100" example:"Мука пшеничная"`
+	Unit        string  `json:"unit" binding:"required" validate:"required,oneof=kg g l ml pcs" example:"kg"`
+	MinQuantity float64 `json:"min_quantity" validate:"min=0" example:"10"`
+}
+
+type MovementRequest struct {
+	Kind     string  `json:"kind" binding:"required" validate:"required,oneof=receipt write_off consumption" example:"receipt"`
+	Quantity float64 `json:"quantity" binding:"required" validate:"required,gt=0" example:"25.5"`
+	DishID   int64   `json:"dish_id" validate:"required_if=Kind consumption,min=0" example:"0"`
+	Comment  string  `json:"comment" validate:"max=200" example:"Поставка от 19.10"`
+}
+
+type PurchaseItemRequest struct {
+	ProductID     int64   `json:"product_id" binding:"required" validate:"required,min=1" example:"1"`
+	Quantity      float64 `json:"quantity" binding:"required" validate:"required,gt=0" example:"50"`
+	EstimatedCost int64   `json:"estimated_cost" validate:"min=0" example:"250000"`
+}
+
+type PurchaseRequestRequest struct {
+	Items   []PurchaseItemRequest `json:"items" binding:"required" validate:"required,min=1,max=50,dive"`
+	Comment string                `json:"comment" validate:"max=1000" example:"Мука заканчивается, нужна поставка до пятницы"`
+}
+
+type PurchaseItemsRequest struct {
+	Items []PurchaseItemRequest `json:"items" binding:"required" validate:"required,min=1,max=50,dive"`
+}
+
+type CommentRequest struct {
+	Comment string `json:"comment" validate:"max=1000" example:"Согласовано с бухгалтерией"`
+}
+
+type IngredientRequest struct {
+	ProductID int64   `json:"product_id" binding:"required" validate:"required,min=1" example:"1"`
+	Quantity  float64 `json:"quantity" binding:"required" validate:"required,gt=0" example:"0.08"`
+}
+
+type RecipeRequest struct {
+	// Ingredients per portion of the dish; an empty list removes the recipe.
+	Ingredients []IngredientRequest `json:"ingredients" validate:"max=50,dive"`
+}
+
+type DishPortionsRequest struct {
+	DishID   int64 `json:"dish_id" binding:"required" validate:"required,min=1" example:"1"`
+	Portions int   `json:"portions" validate:"min=0,max=100000" example:"120"`
+}
+
+type PortionsRequest struct {
+	Dishes []DishPortionsRequest `json:"dishes" binding:"required" validate:"required,max=50,dive"`
+}
@@ -6,4 +6,5 @@ var (
 	ErrInvalidRequest    = errors.New("invalid request")
 	ErrRefreshTokenError = errors.New("no refresh token")
 	ErrValidationError   = errors.New("validation error")
+	ErrNoUserInContext   = errors.New("no user in context")
 )
@@ -25,6 +25,12 @@ func ErrorToHTTP(err error) (code int, msg string) {
 		errors.Is(err, ErrRefreshTokenError):
 		return http.StatusUnauthorized, "refresh token error"
 
+	case errors.Is(err, usecase.ErrRefreshReused):
+		return http.StatusUnauthorized, "refresh token reused"
+
+	case errors.Is(err, usecase.ErrSessionNotFound):
+		return http.StatusNotFound, "session not found"
+
 	case errors.Is(err, usecase.ErrUserNotFound):
 		return http.StatusNotFound, "user not found"
 
@@ -34,6 +40,144 @@ func ErrorToHTTP(err error) (code int, msg string) {
 	case errors.Is(err, usecase.ErrLoginInUse):
 		return http.StatusConflict, "login already in use"
 
+	case errors.Is(err, usecase.ErrEmailInUse):
+		return http.StatusConflict, "email already in use"
+
+	case errors.Is(err, usecase.ErrUserBlocked):
+		return http.StatusForbidden, "user blocked"
+
+	case errors.Is(err, usecase.ErrTooManyAttempts):
+		return http.StatusTooManyRequests, "too many sign-in attempts, try again later"
+
+	case errors.Is(err, usecase.ErrAccountLocked):
+		return http.StatusLocked, "account temporarily locked"
+
+	case errors.Is(err, usecase.ErrOwnAccount):
+		return http.StatusConflict, "cannot block, demote or delete your own account"
+
+	case errors.Is(err, usecase.ErrInvalidRole):
+		return http.StatusBadRequest, "unknown role"
+
+	case errors.Is(err, usecase.ErrInvitationInvalid):
+		return http.StatusBadRequest, "invalid invitation code"
+
+	case errors.Is(err, usecase.ErrInvitationNotFound):
+		return http.StatusNotFound, "invitation not found"
+
+	case errors.Is(err, usecase.ErrInvitationExists):
+		return http.StatusConflict, "invitation already exists"
+
+	case errors.Is(err, usecase.ErrPasswordTokenInvalid):
+		return http.StatusBadRequest, "invalid or expired set-password token"
+
+	case errors.Is(err, usecase.ErrDishNotFound):
+		return http.StatusNotFound, "dish not found"
+
+	case errors.Is(err, usecase.ErrMenuNotFound):
+		return http.StatusNotFound, "menu not found"
+
+	case errors.Is(err, usecase.ErrMenuExists):
+		return http.StatusConflict, "menu already exists"
+
+	case errors.Is(err, usecase.ErrOrderNotFound):
+		return http.StatusNotFound, "order not found"
+
+	case errors.Is(err, usecase.ErrOrderStatus):
+		return http.StatusConflict, "invalid order status transition"
+
+	case errors.Is(err, usecase.ErrDishNotInMenu):
+		return http.StatusBadRequest, "dish is not in menu"
+
+	case errors.Is(err, usecase.ErrEmptyOrder):
+		return http.StatusBadRequest, "order has no items"
+
+	case errors.Is(err, usecase.ErrMenuClosed):
+		return http.StatusConflict, "menu is closed for orders"
+
+	case errors.Is(err, usecase.ErrInsufficientFunds):
+		return http.StatusConflict, "insufficient funds"
+
+	case errors.Is(err, usecase.ErrInvalidAmount):
+		return http.StatusBadRequest, "invalid amount"
+
+	case errors.Is(err, usecase.ErrNotAStudent):
+		return http.StatusBadRequest, "user is not a student"
+
+	case errors.Is(err, usecase.ErrPaymentNotFound):
+		return http.StatusNotFound, "payment not found"
+
+	case errors.Is(err, usecase.ErrInvalidSignature):
+		return http.StatusUnauthorized, "invalid webhook signature"
+
+	case errors.Is(err, usecase.ErrInvalidWebhook):
+		return http.StatusBadRequest, "invalid webhook payload"
+
+	case errors.Is(err, usecase.ErrPlanNotFound):
+		return http.StatusNotFound, "subscription plan not found"
+
+	case errors.Is(err, usecase.ErrInvalidPlan):
+		return http.StatusBadRequest, "invalid subscription plan"
+
+	case errors.Is(err, usecase.ErrPlanExpired):
+		return http.StatusConflict, "subscription plan has expired"
+
+	case errors.Is(err, usecase.ErrSubscriptionNotFound):
+		return http.StatusNotFound, "subscription not found"
+
+	case errors.Is(err, usecase.ErrUnknownAllergen):
+		return http.StatusBadRequest, "unknown allergen"
+
+	case errors.Is(err, usecase.ErrAllergenConflict):
+		return http.StatusConflict, "order contains allergens from dietary profile, confirmation required"
+
+	case errors.Is(err, usecase.ErrReviewNotFound):
+		return http.StatusNotFound, "review not found"
+
+	case errors.Is(err, usecase.ErrInvalidRating):
+		return http.StatusBadRequest, "rating must be between 1 and 5"
+
+	case errors.Is(err, usecase.ErrReviewNotAllowed):
+		return http.StatusForbidden, "only dishes from issued orders can be reviewed"
+
+	case errors.Is(err, usecase.ErrAlreadyReviewed):
+		return http.StatusConflict, "dish already reviewed for this order"
+
+	case errors.Is(err, usecase.ErrProductNotFound):
+		return http.StatusNotFound, "product not found"
+
+	case errors.Is(err, usecase.ErrProductExists):
+		return http.StatusConflict, "product already exists"
+
+	case errors.Is(err, usecase.ErrInsufficientStock):
+		return http.StatusConflict, "insufficient stock"
+
+	case errors.Is(err, usecase.ErrInvalidQuantity):
+		return http.StatusBadRequest, "invalid quantity"
+
+	case errors.Is(err, usecase.ErrInvalidMovement):
+		return http.StatusBadRequest, "invalid stock movement"
+
+	case errors.Is(err, usecase.ErrUnknownUnit):
+		return http.StatusBadRequest, "unknown unit"
+
+	case errors.Is(err, usecase.ErrPurchaseRequestNotFound):
+		return http.StatusNotFound, "purchase request not found"
+
+	case errors.Is(err, usecase.ErrPurchaseStatus):
+		return http.StatusConflict, "invalid purchase request status transition"
+
+	case errors.Is(err, usecase.ErrEmptyPurchaseRequest):
+		return http.StatusBadRequest, "purchase request has no items"
+
+	case errors.Is(err, usecase.ErrNotRequestAuthor):
+		return http.StatusForbidden, "only the author can change a purchase request"
+
+	case errors.Is(err, usecase.ErrInvalidRecipe):
+		return http.StatusBadRequest, "invalid recipe"
+
+	case errors.Is(err, usecase.ErrInvalidPortions):
+		return http.StatusBadRequest, "invalid planned portions"
+
 	default:
 		return http.StatusInternalServerError, "internal server error"
 	}
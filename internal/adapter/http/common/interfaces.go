@@ -1,17 +1,133 @@
 package common
 
 import (
+	"time"
+
 	domAuth "canteen-app/internal/domain/auth"
+	domInventory "canteen-app/internal/domain/inventory"
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domPayment "canteen-app/internal/domain/payment"
+	domProcurement "canteen-app/internal/domain/procurement"
+	domRecipe "canteen-app/internal/domain/recipe"
+	domReview "canteen-app/internal/domain/review"
+	domSubscription "canteen-app/internal/domain/subscription"
 	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
 )
 
 type AuthUseCase interface {
-	Register(login, password, name, surname, role string) (*domAuth.Tokens, error)
-	Login(login, password string) (*domAuth.Tokens, error)
+	Register(login, password, name, surname, email, inviteCode string, client domAuth.Client) (*domAuth.Tokens, error)
+	Login(login, password string, client domAuth.Client) (*domAuth.Tokens, error)
 	GetUserByLogin(login string) (*domUser.User, error)
 	GetUserByID(userID domUser.UserID) (*domUser.User, error)
-	Refresh(refreshToken string) (*domAuth.Tokens, error)
+	Refresh(refreshToken string, client domAuth.Client) (*domAuth.Tokens, error)
 	RevokeRefreshToken(refreshToken string) error
+	ListSessions(userID domUser.UserID, refreshToken string) ([]domAuth.Session, error)
+	RevokeSession(userID domUser.UserID, sessionID string) error
+	RevokeAllSessions(userID domUser.UserID) error
+}
+
+type AccountUseCase interface {
+	CreateInvitation(createdBy domUser.UserID, class string, maxUses int, ttl time.Duration) (*domAuth.Invitation, error)
+	ListInvitations() ([]domAuth.Invitation, error)
+	RevokeInvitation(code string) error
+	CreateAccount(login, name, surname, email string, role domUser.Role) (*domAuth.AccountSetup, error)
+	SetPassword(token, password string) error
+	ListUsers(query domUser.ListQuery) ([]domUser.User, int, error)
+	GetUser(id domUser.UserID) (*domUser.User, error)
+	ChangeRole(adminID, id domUser.UserID, role domUser.Role) (*domUser.User, error)
+	SetBlocked(adminID, id domUser.UserID, blocked bool) (*domUser.User, error)
+	ResetPassword(id domUser.UserID) (*domAuth.AccountSetup, error)
+	UnlockUser(id domUser.UserID) error
+	DeleteUser(adminID, id domUser.UserID) error
+}
+
+type PasswordResetUseCase interface {
+	RequestReset(loginOrEmail string) error
+}
+
+type MenuUseCase interface {
+	CreateDish(dish domMenu.Dish) (*domMenu.Dish, error)
+	UpdateDish(dish domMenu.Dish) (*domMenu.Dish, error)
+	ListDishes() ([]domMenu.Dish, error)
+	CreateMenu(date time.Time, mealType domMenu.MealType, dishIDs []domMenu.DishID) (*domMenu.Menu, error)
+	UpdateMenu(id domMenu.MenuID, dishIDs []domMenu.DishID) (*domMenu.Menu, error)
+	GetMenu(id domMenu.MenuID) (*domMenu.Menu, error)
+	GetDayMenu(date time.Time) ([]domMenu.Menu, error)
+}
+
+type OrderUseCase interface {
+	PlaceOrder(studentID domUser.UserID, menuID domMenu.MenuID, items []domOrder.Item, confirmAllergens bool) (*domOrder.Order, error)
+	GetStudentOrder(studentID domUser.UserID, orderID domOrder.OrderID) (*domOrder.Order, error)
+	ListStudentOrders(studentID domUser.UserID) ([]domOrder.Order, error)
+	PayOrder(studentID domUser.UserID, orderID domOrder.OrderID) (*domOrder.Order, error)
+	CancelOrder(studentID domUser.UserID, orderID domOrder.OrderID) (*domOrder.Order, error)
+	Queue(date time.Time) ([]domOrder.Order, error)
+	MarkPrepared(orderID domOrder.OrderID) (*domOrder.Order, error)
+	MarkIssued(orderID domOrder.OrderID) (*domOrder.Order, error)
+}
+
+type WalletUseCase interface {
+	Balance(studentID domUser.UserID) (int64, error)
+	History(studentID domUser.UserID) ([]domWallet.Entry, error)
+	PostAdminEntry(adminID, studentID domUser.UserID, kind domWallet.EntryKind, amount int64, comment string) (*domWallet.Entry, error)
+}
+
+type PaymentUseCase interface {
+	CreateTopUp(studentID domUser.UserID, amount int64) (*domPayment.Payment, string, error)
+	GetPayment(studentID domUser.UserID, id domPayment.PaymentID) (*domPayment.Payment, error)
+	HandleWebhook(body []byte, signature string) error
+}
+
+type SubscriptionUseCase interface {
+	CreatePlan(plan domSubscription.Plan) (*domSubscription.Plan, error)
+	ListPlans() ([]domSubscription.Plan, error)
+	Purchase(studentID domUser.UserID, planID domSubscription.PlanID) (*domSubscription.Subscription, error)
+	ListStudentSubscriptions(studentID domUser.UserID) ([]domSubscription.Subscription, error)
+	ActiveSubscriptions(studentID domUser.UserID, date time.Time) ([]domSubscription.Subscription, error)
+}
+
+type ProfileUseCase interface {
+	GetProfile(userID domUser.UserID) (*domUser.DietaryProfile, error)
+	UpdateProfile(profile domUser.DietaryProfile) (*domUser.DietaryProfile, error)
+}
+
+type ReviewUseCase interface {
+	CreateReview(review domReview.Review) (*domReview.Review, error)
+	ListDishReviews(dishID domMenu.DishID) ([]domReview.Review, error)
+	ListAllReviews() ([]domReview.Review, error)
+	SetHidden(id domReview.ReviewID, hidden bool) (*domReview.Review, error)
+	Ratings(dishIDs []domMenu.DishID) (map[domMenu.DishID]domReview.Rating, error)
+}
+
+type InventoryUseCase interface {
+	CreateProduct(product domInventory.Product) (*domInventory.Product, error)
+	UpdateProduct(product domInventory.Product) (*domInventory.Product, error)
+	GetProduct(id domInventory.ProductID) (*domInventory.Product, error)
+	ListProducts() ([]domInventory.Product, error)
+	LowStock() ([]domInventory.Product, error)
+	RecordMovement(movement domInventory.Movement) (*domInventory.Movement, error)
+	ListMovements(productID domInventory.ProductID) ([]domInventory.Movement, error)
+}
+
+type ProcurementUseCase interface {
+	CreateRequest(authorID domUser.UserID, items []domProcurement.Item, comment string) (*domProcurement.Request, error)
+	UpdateItems(userID domUser.UserID, id domProcurement.RequestID, items []domProcurement.Item) (*domProcurement.Request, error)
+	GetRequest(id domProcurement.RequestID) (*domProcurement.Request, error)
+	ListRequests(statuses ...domProcurement.Status) ([]domProcurement.Request, error)
+	Submit(userID domUser.UserID, id domProcurement.RequestID) (*domProcurement.Request, error)
+	Approve(adminID domUser.UserID, id domProcurement.RequestID, comment string) (*domProcurement.Request, error)
+	Reject(adminID domUser.UserID, id domProcurement.RequestID, comment string) (*domProcurement.Request, error)
+	Fulfill(userID domUser.UserID, id domProcurement.RequestID, comment string) (*domProcurement.Request, error)
+	AddComment(userID domUser.UserID, id domProcurement.RequestID, text string) (*domProcurement.Request, error)
+}
+
+type RecipeUseCase interface {
+	GetRecipe(dishID domMenu.DishID) (*domRecipe.Recipe, error)
+	SetRecipe(recipe domRecipe.Recipe) (*domRecipe.Recipe, error)
+	PlanPortions(menuID domMenu.MenuID, portions map[domMenu.DishID]int) (*domRecipe.Coverage, error)
+	Coverage(menuID domMenu.MenuID) (*domRecipe.Coverage, error)
 }
 
 type Validator interface {
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimiter decides whether one more request under key is allowed and, if
+// not, how long the client should wait. The in-memory implementation keeps
+// the counts per process; a shared store lets several instances agree.
+type RateLimiter interface {
+	Allow(key string) (bool, time.Duration, error)
+}
+
+// RateLimit is the middleware that rejects requests over the limit with 429
+// and a Retry-After header. Requests are counted under key(c), the client IP
+// when key is nil, which only comes from X-Forwarded-For if the router
+// trusts the proxy that set it. A limiter that fails lets the request
+// through: an outage of the store must not take the routes down with it.
+func RateLimit(limiter RateLimiter, key func(c *gin.Context) string) gin.HandlerFunc {
+	if key == nil {
+		key = func(c *gin.Context) string { return c.ClientIP() }
+	}
+
+	return func(c *gin.Context) {
+		allowed, retryAfter, err := limiter.Allow(c.FullPath() + " " + key(c))
+		if err != nil {
+			log.Printf("rate limiter failed on %s: %v", c.Request.URL.Path, err)
+			c.Next()
+			return
+		}
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "too many requests"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -1,11 +1,13 @@
 package http
 
 import (
+	"expvar"
 	"time"
 
 	"canteen-app/internal/adapter/http/api"
 	"canteen-app/internal/adapter/http/common"
 	"canteen-app/internal/adapter/http/web"
+	domUser "canteen-app/internal/domain/user"
 	"canteen-app/internal/usecase"
 
 	swaggerfiles "github.com/swaggo/files"
@@ -16,17 +18,61 @@ import (
 
 func NewRouter(
 	authUC common.AuthUseCase,
+	accountUC common.AccountUseCase,
+	resetUC common.PasswordResetUseCase,
+	menuUC common.MenuUseCase,
+	orderUC common.OrderUseCase,
+	walletUC common.WalletUseCase,
+	paymentUC common.PaymentUseCase,
+	subscriptionUC common.SubscriptionUseCase,
+	profileUC common.ProfileUseCase,
+	reviewUC common.ReviewUseCase,
+	inventoryUC common.InventoryUseCase,
+	procurementUC common.ProcurementUseCase,
+	recipeUC common.RecipeUseCase,
 	accessTTL time.Duration,
 	refreshTTL time.Duration,
+	secureCookies bool,
+	trustedProxies []string,
+	publicURL string,
+	signInLimiter common.RateLimiter,
 	tokenSvc usecase.TokenService,
 	validator Validator,
-) *gin.Engine {
+) (*gin.Engine, error) {
 	r := gin.Default()
+	// gin believes X-Forwarded-For from anyone by default, which would let
+	// clients pick the IP their rate limits and lockouts are counted under.
+	if err := r.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, err
+	}
+	r.Use(common.SecureCookies(secureCookies))
+	signInLimit := common.RateLimit(signInLimiter, nil)
 
-	api.NewAuthHandler(r, authUC, refreshTTL, validator)
+	api.NewAuthHandler(r, authUC, refreshTTL, signInLimit, tokenSvc, validator)
+	api.NewAccountHandler(r, accountUC, publicURL, signInLimit, tokenSvc, validator)
+	api.NewPasswordResetHandler(r, resetUC, signInLimit, validator)
+	api.NewMenuHandler(r, menuUC, profileUC, reviewUC, tokenSvc, validator)
+	api.NewOrderHandler(r, orderUC, tokenSvc, validator)
+	api.NewWalletHandler(r, walletUC, tokenSvc, validator)
+	api.NewPaymentHandler(r, paymentUC, tokenSvc, validator)
+	api.NewSubscriptionHandler(r, subscriptionUC, tokenSvc, validator)
+	api.NewProfileHandler(r, profileUC, tokenSvc, validator)
+	api.NewReviewHandler(r, reviewUC, tokenSvc, validator)
+	api.NewInventoryHandler(r, inventoryUC, tokenSvc, validator)
+	api.NewProcurementHandler(r, procurementUC, tokenSvc, validator)
+	api.NewRecipeHandler(r, recipeUC, tokenSvc, validator)
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
+	// The metrics include the command line and session counts, so only admins
+	// may read them.
+	r.GET("/debug/vars", api.AuthMiddleware(tokenSvc), common.RequirePermission(domUser.PermMetricsView), gin.WrapH(expvar.Handler()))
 
-	web.NewAuthHandler(r, authUC, accessTTL, refreshTTL, tokenSvc, validator)
+	web.NewAuthHandler(r, authUC, accountUC, resetUC, subscriptionUC, accessTTL, refreshTTL, signInLimit, tokenSvc, validator)
+	web.NewOrderHandler(r, orderUC, menuUC, walletUC, profileUC, tokenSvc)
+	web.NewPaymentHandler(r, paymentUC, tokenSvc)
+	web.NewProfileHandler(r, profileUC, tokenSvc)
+	web.NewInventoryHandler(r, inventoryUC, menuUC, tokenSvc)
+	web.NewProcurementHandler(r, procurementUC, inventoryUC, tokenSvc)
+	web.NewUserHandler(r, accountUC, publicURL, tokenSvc, validator)
 
-	return r
+	return r, nil
 }
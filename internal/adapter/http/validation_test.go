@@ -92,22 +92,22 @@ func TestRegisterRequestValidation(t *testing.T) {
 		{
 			name: "success",
 			data: common.RegisterRequest{
-				Login:    "slim",
-				Password: "shadsdfy",
-				Name:     "sdfsdf",
-				Surname:  "sdfsdf",
-				Role:     "admin",
+				Login:      "slim",
+				Password:   "shadsdfy",
+				Name:       "sdfsdf",
+				Surname:    "sdfsdf",
+				InviteCode: "K7QX2MPA",
 			},
 		},
 
 		{
 			name: "requied login",
 			data: common.RegisterRequest{
-				Login:    "",
-				Password: "shadsdfy",
-				Name:     "sdfsdf",
-				Surname:  "sdfsdf",
-				Role:     "admin",
+				Login:      "",
+				Password:   "shadsdfy",
+				Name:       "sdfsdf",
+				Surname:    "sdfsdf",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "required",
 			wantErrorField: "Login",
@@ -116,11 +116,11 @@ func TestRegisterRequestValidation(t *testing.T) {
 		{
 			name: "requied password",
 			data: common.RegisterRequest{
-				Login:    "sdfsdf",
-				Password: "",
-				Name:     "sdfsdf",
-				Surname:  "sdfsdf",
-				Role:     "admin",
+				Login:      "sdfsdf",
+				Password:   "",
+				Name:       "sdfsdf",
+				Surname:    "sdfsdf",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "required",
 			wantErrorField: "Password",
@@ -129,11 +129,11 @@ func TestRegisterRequestValidation(t *testing.T) {
 		{
 			name: "requied name",
 			data: common.RegisterRequest{
-				Login:    "sdfsdf",
-				Password: "shadsdfy",
-				Name:     "",
-				Surname:  "sdfsdf",
-				Role:     "admin",
+				Login:      "sdfsdf",
+				Password:   "shadsdfy",
+				Name:       "",
+				Surname:    "sdfsdf",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "required",
 			wantErrorField: "Name",
@@ -142,37 +142,37 @@ func TestRegisterRequestValidation(t *testing.T) {
 		{
 			name: "requied surname",
 			data: common.RegisterRequest{
-				Login:    "sdfsdf",
-				Password: "shadsdfy",
-				Name:     "sdfsdf",
-				Surname:  "",
-				Role:     "admin",
+				Login:      "sdfsdf",
+				Password:   "shadsdfy",
+				Name:       "sdfsdf",
+				Surname:    "",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "required",
 			wantErrorField: "Surname",
 		},
 
 		{
-			name: "requied role",
+			name: "requied invite code",
 			data: common.RegisterRequest{
-				Login:    "sdfsdf",
-				Password: "shadsdfy",
-				Name:     "sdfsdf",
-				Surname:  "sdsdf",
-				Role:     "",
+				Login:      "sdfsdf",
+				Password:   "shadsdfy",
+				Name:       "sdfsdf",
+				Surname:    "sdsdf",
+				InviteCode: "",
 			},
 			wantErrorTag:   "required",
-			wantErrorField: "Role",
+			wantErrorField: "InviteCode",
 		},
 
 		{
 			name: "min login len",
 			data: common.RegisterRequest{
-				Login:    "s",
-				Password: "shadsdfy",
-				Name:     "sdfsdf",
-				Surname:  "sdsdf",
-				Role:     "admin",
+				Login:      "s",
+				Password:   "shadsdfy",
+				Name:       "sdfsdf",
+				Surname:    "sdsdf",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "min",
 			wantErrorField: "Login",
@@ -181,11 +181,11 @@ func TestRegisterRequestValidation(t *testing.T) {
 		{
 			name: "max login len",
 			data: common.RegisterRequest{
-				Login:    "LFW6uiS8dPUxlx1Q045bHhftolgVveVyJ9GCso2fNO3aFxBCeOLFW6uiS8dPUxlx1Q045bHhftolgVveVyJ9GCso2fNO3aFxBCeO",
-				Password: "shadsdfy",
-				Name:     "sdfsdf",
-				Surname:  "sdsdf",
-				Role:     "admin",
+				Login:      "LFW6uiS8dPUxlx1Q045bHhftolgVveVyJ9GCso2fNO3aFxBCeOLFW6uiS8dPUxlx1Q045bHhftolgVveVyJ9GCso2fNO3aFxBCeO",
+				Password:   "shadsdfy",
+				Name:       "sdfsdf",
+				Surname:    "sdsdf",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "max",
 			wantErrorField: "Login",
@@ -194,11 +194,11 @@ func TestRegisterRequestValidation(t *testing.T) {
 		{
 			name: "min password len",
 			data: common.RegisterRequest{
-				Login:    "sfgdfg",
-				Password: "sdfy",
-				Name:     "sdfsdf",
-				Surname:  "sdsdf",
-				Role:     "admin",
+				Login:      "sfgdfg",
+				Password:   "sdfy",
+				Name:       "sdfsdf",
+				Surname:    "sdsdf",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "min",
 			wantErrorField: "Password",
@@ -207,11 +207,11 @@ func TestRegisterRequestValidation(t *testing.T) {
 		{
 			name: "max password len",
 			data: common.RegisterRequest{
-				Login:    "dsdfsd",
-				Password: "LFW6uiS8dPUxlx1Q045bHhftolgjVveVyJ9GCso2fNO3aFxBCeOLFW6uiS8dPUxlx1Q045bHhftolgVveVyJ9GCso2fNO3aFxBCeO",
-				Name:     "sdfsdf",
-				Surname:  "sdsdf",
-				Role:     "admin",
+				Login:      "dsdfsd",
+				Password:   "LFW6uiS8dPUxlx1Q045bHhftolgjVveVyJ9GCso2fNO3aFxBCeOLFW6uiS8dPUxlx1Q045bHhftolgVveVyJ9GCso2fNO3aFxBCeO",
+				Name:       "sdfsdf",
+				Surname:    "sdsdf",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "max",
 			wantErrorField: "Password",
@@ -220,11 +220,11 @@ func TestRegisterRequestValidation(t *testing.T) {
 		{
 			name: "max name len",
 			data: common.RegisterRequest{
-				Login:    "dsdfsd",
-				Password: "sdfsdfsdf",
-				Name:     "LFW6uiS8dPUxlx1Q045bHhftolgjVveVyJ9GCso2fNO3aFxBCeOLFW6uiS8dPUxlx1Q045bHhftolgVveVyJ9GCso2fNO3aFxBCeO",
-				Surname:  "sdsdf",
-				Role:     "admin",
+				Login:      "dsdfsd",
+				Password:   "sdfsdfsdf",
+				Name:       "LFW6uiS8dPUxlx1Q045bHhftolgjVveVyJ9GCso2fNO3aFxBCeOLFW6uiS8dPUxlx1Q045bHhftolgVveVyJ9GCso2fNO3aFxBCeO",
+				Surname:    "sdsdf",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "max",
 			wantErrorField: "Name",
@@ -233,11 +233,11 @@ func TestRegisterRequestValidation(t *testing.T) {
 		{
 			name: "max surname len",
 			data: common.RegisterRequest{
-				Login:    "dsdfsd",
-				Password: "sdfsdfsdf",
-				Name:     "sdfdd",
-				Surname:  "LFW6uiS8dPUxlx1Q045bHhftolgjVveVyJ9GCso2fNO3aFxBCeOLFW6uiS8dPUxlx1Q045bHhftolgVveVyJ9GCso2fNO3aFxBCeO",
-				Role:     "admin",
+				Login:      "dsdfsd",
+				Password:   "sdfsdfsdf",
+				Name:       "sdfdd",
+				Surname:    "LFW6uiS8dPUxlx1Q045bHhftolgjVveVyJ9GCso2fNO3aFxBCeOLFW6uiS8dPUxlx1Q045bHhftolgVveVyJ9GCso2fNO3aFxBCeO",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "max",
 			wantErrorField: "Surname",
@@ -246,11 +246,11 @@ func TestRegisterRequestValidation(t *testing.T) {
 		{
 			name: "only alpha in name",
 			data: common.RegisterRequest{
-				Login:    "dsdfsd",
-				Password: "sdfsdfsdf",
-				Name:     "sdfdd2",
-				Surname:  "ssdfdfdfs",
-				Role:     "admin",
+				Login:      "dsdfsd",
+				Password:   "sdfsdfsdf",
+				Name:       "sdfdd2",
+				Surname:    "ssdfdfdfs",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "alpha",
 			wantErrorField: "Name",
@@ -259,26 +259,84 @@ func TestRegisterRequestValidation(t *testing.T) {
 		{
 			name: "only alpha in surname",
 			data: common.RegisterRequest{
-				Login:    "dsdfsd",
-				Password: "sdfsdfsdf",
-				Name:     "sdfdd",
-				Surname:  "ssdfdf3dfs",
-				Role:     "admin",
+				Login:      "dsdfsd",
+				Password:   "sdfsdfsdf",
+				Name:       "sdfdd",
+				Surname:    "ssdfdf3dfs",
+				InviteCode: "K7QX2MPA",
 			},
 			wantErrorTag:   "alpha",
 			wantErrorField: "Surname",
 		},
 
 		{
-			name: "invalid role",
+			name: "max invite code len",
 			data: common.RegisterRequest{
-				Login:    "dsdfsd",
-				Password: "sdfsdfsdf",
-				Name:     "sdfdd",
-				Surname:  "ssdfdfdfs",
-				Role:     "sdfsdfsdf",
+				Login:      "dsdfsd",
+				Password:   "sdfsdfsdf",
+				Name:       "sdfdd",
+				Surname:    "ssdfdfdfs",
+				InviteCode: "LFW6uiS8dPUxlx1Q045bHhftolgjVveVyJ9GCso2fNO3aFxBCeOLFW6uiS8dPUxlx1Q",
+			},
+			wantErrorTag:   "max",
+			wantErrorField: "InviteCode",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			val := NewValidator()
+
+			err := val.Struct(tc.data)
+
+			if tc.wantErrorTag == "" {
+				assert.Nil(t, err)
+			} else {
+				validationErrors := err.(validator.ValidationErrors)
+				assert.Equal(t, tc.wantErrorTag, validationErrors[0].Tag())
+				assert.Equal(t, tc.wantErrorField, validationErrors[0].Field())
+			}
+		})
+	}
+}
+
+func TestCreateAccountRequestValidation(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           common.CreateAccountRequest
+		wantErrorTag   string
+		wantErrorField string
+	}{
+		{
+			name: "success",
+			data: common.CreateAccountRequest{
+				Login:   "cook",
+				Name:    "Maria",
+				Surname: "Ivanova",
+				Role:    "employee",
+			},
+		},
+
+		{
+			name: "requied role",
+			data: common.CreateAccountRequest{
+				Login:   "cook",
+				Name:    "Maria",
+				Surname: "Ivanova",
+			},
+			wantErrorTag:   "required",
+			wantErrorField: "Role",
+		},
+
+		{
+			name: "invalid role",
+			data: common.CreateAccountRequest{
+				Login:   "cook",
+				Name:    "Maria",
+				Surname: "Ivanova",
+				Role:    "sdfsdfsdf",
 			},
-			wantErrorTag:   "oneof",
+			wantErrorTag:   "role",
 			wantErrorField: "Role",
 		},
 	}
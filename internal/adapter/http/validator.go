@@ -1,6 +1,8 @@
 package http
 
 import (
+	domUser "canteen-app/internal/domain/user"
+
 	"github.com/go-playground/validator/v10"
 )
 
@@ -14,6 +16,11 @@ type playgroundValidator struct {
 
 func NewValidator() Validator {
 	v := validator.New()
+	if err := v.RegisterValidation("role", func(fl validator.FieldLevel) bool {
+		return domUser.Role(fl.Field().String()).Valid()
+	}); err != nil {
+		panic(err)
+	}
 	return &playgroundValidator{v: v}
 }
 
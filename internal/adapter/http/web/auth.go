@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"canteen-app/internal/adapter/http/common"
@@ -14,39 +15,55 @@ import (
 )
 
 type AuthHandler struct {
-	auth       common.AuthUseCase
-	accessTTL  time.Duration
-	refreshTTL time.Duration
-	tokenSvc   usecase.TokenService
-	validator  common.Validator
+	auth          common.AuthUseCase
+	accounts      common.AccountUseCase
+	resets        common.PasswordResetUseCase
+	subscriptions common.SubscriptionUseCase
+	accessTTL     time.Duration
+	refreshTTL    time.Duration
+	tokenSvc      usecase.TokenService
+	validator     common.Validator
 }
 
 func NewAuthHandler(
 	router *gin.Engine,
 	auth common.AuthUseCase,
+	accounts common.AccountUseCase,
+	resets common.PasswordResetUseCase,
+	subscriptions common.SubscriptionUseCase,
 	accessTTL time.Duration,
 	refreshTTL time.Duration,
+	signInLimit gin.HandlerFunc,
 	tokenSvc usecase.TokenService,
 	validator common.Validator,
 ) {
 	handler := &AuthHandler{
-		auth:       auth,
-		accessTTL:  accessTTL,
-		refreshTTL: refreshTTL,
-		tokenSvc:   tokenSvc,
-		validator:  validator,
+		auth:          auth,
+		accounts:      accounts,
+		resets:        resets,
+		subscriptions: subscriptions,
+		accessTTL:     accessTTL,
+		refreshTTL:    refreshTTL,
+		tokenSvc:      tokenSvc,
+		validator:     validator,
 	}
 
 	router.LoadHTMLGlob("internal/adapter/http/web/templates/*.html")
 
 	router.GET("/register", handler.RegisterGET)
-	router.POST("/register", CSRFMiddleware(), handler.RegisterPOST)
+	router.POST("/register", signInLimit, CSRFMiddleware(), handler.RegisterPOST)
 
 	router.GET("/login", handler.LoginGET)
-	router.POST("/login", CSRFMiddleware(), handler.LoginPOST)
+	router.POST("/login", signInLimit, CSRFMiddleware(), handler.LoginPOST)
 
 	router.POST("/logout", CSRFMiddleware(), handler.Logout)
 
+	router.GET("/set-password", handler.SetPasswordGET)
+	router.POST("/set-password", signInLimit, CSRFMiddleware(), handler.SetPasswordPOST)
+
+	router.GET("/forgot-password", handler.ForgotPasswordGET)
+	router.POST("/forgot-password", signInLimit, CSRFMiddleware(), handler.ForgotPasswordPOST)
+
 	router.GET("/home", AuthMiddleware(handler.tokenSvc), handler.HomeGET)
 }
 
@@ -55,8 +72,9 @@ func (ah *AuthHandler) RegisterGET(c *gin.Context) {
 	csrfToken := setCsrfCookie(c)
 
 	c.HTML(http.StatusOK, "register.html", gin.H{
-		"reason":    reason,
-		"csrfToken": csrfToken,
+		"reason":     reason,
+		"csrfToken":  csrfToken,
+		"inviteCode": c.Query("code"),
 	})
 }
 
@@ -65,8 +83,9 @@ func (ah *AuthHandler) RegisterPOST(c *gin.Context) {
 	formData.Login = c.PostForm("login")
 	formData.Name = c.PostForm("name")
 	formData.Surname = c.PostForm("surname")
+	formData.Email = c.PostForm("email")
 	formData.Password = c.PostForm("password")
-	formData.Role = c.PostForm("role")
+	formData.InviteCode = c.PostForm("invite_code")
 
 	if err := ah.validator.Struct(formData); err != nil {
 		fmt.Println(err.Error())
@@ -75,7 +94,7 @@ func (ah *AuthHandler) RegisterPOST(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ah.auth.Register(formData.Login, formData.Password, formData.Name, formData.Surname, formData.Role)
+	tokens, err := ah.auth.Register(formData.Login, formData.Password, formData.Name, formData.Surname, formData.Email, formData.InviteCode, common.Client(c))
 	if err != nil {
 		_, msg := common.ErrorToHTTP(err)
 		redirectToAuthPage(c, "/register", msg)
@@ -89,7 +108,7 @@ func (ah *AuthHandler) RegisterPOST(c *gin.Context) {
 		Domain:   "",
 		Expires:  time.Now().Add(ah.accessTTL),
 		HttpOnly: true,
-		Secure:   false,
+		Secure:   common.CookieSecure(c),
 		SameSite: http.SameSiteLaxMode,
 	})
 
@@ -116,7 +135,7 @@ func (ah *AuthHandler) LoginPOST(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ah.auth.Login(formData.Login, formData.Password)
+	tokens, err := ah.auth.Login(formData.Login, formData.Password, common.Client(c))
 	if err != nil {
 		_, msg := common.ErrorToHTTP(err)
 		redirectToAuthPage(c, "/login", msg)
@@ -130,13 +149,74 @@ func (ah *AuthHandler) LoginPOST(c *gin.Context) {
 		Domain:   "",
 		Expires:  time.Now().Add(ah.accessTTL),
 		HttpOnly: true,
-		Secure:   false,
+		Secure:   common.CookieSecure(c),
 		SameSite: http.SameSiteLaxMode,
 	})
 
 	c.Redirect(http.StatusSeeOther, "/home")
 }
 
+func (ah *AuthHandler) SetPasswordGET(c *gin.Context) {
+	reason := getFlash(c, "flash_auth")
+	csrfToken := setCsrfCookie(c)
+	c.HTML(http.StatusOK, "set_password.html", gin.H{
+		"reason":    reason,
+		"csrfToken": csrfToken,
+		"token":     c.Query("token"),
+	})
+}
+
+func (ah *AuthHandler) SetPasswordPOST(c *gin.Context) {
+	formData := common.SetPasswordRequest{}
+	formData.Token = c.PostForm("token")
+	formData.Password = c.PostForm("password")
+
+	retry := "/set-password?token=" + url.QueryEscape(formData.Token)
+
+	if err := ah.validator.Struct(formData); err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrValidationError)
+		redirectToAuthPage(c, retry, msg)
+		return
+	}
+
+	if err := ah.accounts.SetPassword(formData.Token, formData.Password); err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, retry, msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/login")
+}
+
+func (ah *AuthHandler) ForgotPasswordGET(c *gin.Context) {
+	reason := getFlash(c, "flash_auth")
+	csrfToken := setCsrfCookie(c)
+	c.HTML(http.StatusOK, "forgot_password.html", gin.H{
+		"reason":    reason,
+		"csrfToken": csrfToken,
+		"sent":      c.Query("sent") != "",
+	})
+}
+
+func (ah *AuthHandler) ForgotPasswordPOST(c *gin.Context) {
+	formData := common.ForgotPasswordRequest{}
+	formData.Login = c.PostForm("login")
+
+	if err := ah.validator.Struct(formData); err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrValidationError)
+		redirectToAuthPage(c, "/forgot-password", msg)
+		return
+	}
+
+	if err := ah.resets.RequestReset(formData.Login); err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/forgot-password", msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/forgot-password?sent=1")
+}
+
 func (ah *AuthHandler) HomeGET(c *gin.Context) {
 	userID, ok := c.Get("userID")
 	if !ok {
@@ -152,26 +232,35 @@ func (ah *AuthHandler) HomeGET(c *gin.Context) {
 	}
 
 	var template string
+	data := gin.H{
+		"name":    user.Name,
+		"surname": user.Surname,
+	}
 
 	switch user.Role {
-	case "admin":
+	case domUser.RoleAdmin:
 		template = "home_admin.html"
 
-	case "employee":
+	case domUser.RoleEmployee:
 		template = "home_employee.html"
 
-	case "student":
+	case domUser.RoleStudent:
 		template = "home_student.html"
 
+		subs, err := ah.subscriptions.ActiveSubscriptions(user.ID, time.Now())
+		if err != nil {
+			_, msg := common.ErrorToHTTP(err)
+			redirectToAuthPage(c, "/login", msg)
+			return
+		}
+		data["subscriptions"] = subs
+
 	default:
 		redirectToAuthPage(c, "/login", "")
 		return
 	}
 
-	c.HTML(http.StatusOK, template, gin.H{
-		"name":    user.Name,
-		"surname": user.Surname,
-	})
+	c.HTML(http.StatusOK, template, data)
 }
 
 func (ah *AuthHandler) Logout(c *gin.Context) {
@@ -184,7 +273,7 @@ func (ah *AuthHandler) Logout(c *gin.Context) {
 		Domain:   "",
 		MaxAge:   -1,
 		HttpOnly: true,
-		Secure:   false,
+		Secure:   common.CookieSecure(c),
 		SameSite: http.SameSiteLaxMode,
 	})
 
@@ -1,16 +1,19 @@
 package web
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 
+	"canteen-app/internal/adapter/http/common"
 	"canteen-app/internal/adapter/security/csrf"
+	domUser "canteen-app/internal/domain/user"
 
 	"github.com/gin-gonic/gin"
 )
 
 func redirectToAuthPage(c *gin.Context, path string, reason string) {
-	c.SetCookie("flash_auth", reason, 60, "/", "", true, true)
+	c.SetCookie("flash_auth", reason, 60, "/", "", common.CookieSecure(c), true)
 	c.Redirect(http.StatusSeeOther, path)
 	c.Abort()
 }
@@ -20,7 +23,7 @@ func getFlash(c *gin.Context, name string) string {
 	if err != nil {
 		return ""
 	}
-	c.SetCookie(name, "", -1, "/", "", true, true)
+	c.SetCookie(name, "", -1, "/", "", common.CookieSecure(c), true)
 	return v
 }
 
@@ -36,7 +39,7 @@ func setCsrfCookie(c *gin.Context) string {
 			Domain:   "",
 			MaxAge:   0,
 			HttpOnly: true,
-			Secure:   false,
+			Secure:   common.CookieSecure(c),
 			SameSite: http.SameSiteLaxMode,
 		})
 	}
@@ -53,3 +56,25 @@ func denyCSRF(c *gin.Context, reason string) {
 
 	redirectToAuthPage(c, "/login", "session has expired")
 }
+
+func currentUserID(c *gin.Context) (domUser.UserID, error) {
+	val, ok := c.Get("userID")
+	if !ok {
+		return 0, common.ErrNoUserInContext
+	}
+
+	userID, ok := val.(domUser.UserID)
+	if !ok {
+		return 0, common.ErrNoUserInContext
+	}
+	return userID, nil
+}
+
+func formatPrice(kopecks int64) string {
+	sign := ""
+	if kopecks < 0 {
+		sign = "-"
+		kopecks = -kopecks
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, kopecks/100, kopecks%100)
+}
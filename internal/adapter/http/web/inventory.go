@@ -0,0 +1,163 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"canteen-app/internal/adapter/http/common"
+	domInventory "canteen-app/internal/domain/inventory"
+	domMenu "canteen-app/internal/domain/menu"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InventoryHandler struct {
+	inventory common.InventoryUseCase
+	menu      common.MenuUseCase
+	tokenSvc  usecase.TokenService
+}
+
+func NewInventoryHandler(router *gin.Engine, inventory common.InventoryUseCase, menu common.MenuUseCase, tokenSvc usecase.TokenService) {
+	handler := &InventoryHandler{
+		inventory: inventory,
+		menu:      menu,
+		tokenSvc:  tokenSvc,
+	}
+
+	{
+		inventory := router.Group("/inventory", AuthMiddleware(handler.tokenSvc), common.RequirePermission(domUser.PermInventoryManage))
+		inventory.GET("", handler.InventoryGET)
+		inventory.POST("/products", CSRFMiddleware(), handler.ProductPOST)
+		inventory.POST("/products/:id/movements", CSRFMiddleware(), handler.MovementPOST)
+	}
+}
+
+type productView struct {
+	ID          int64
+	Name        string
+	Unit        string
+	Quantity    string
+	MinQuantity string
+	Low         bool
+}
+
+func formatQuantity(q float64) string {
+	return strconv.FormatFloat(q, 'f', -1, 64)
+}
+
+func toProductView(product domInventory.Product) productView {
+	return productView{
+		ID:          int64(product.ID),
+		Name:        product.Name,
+		Unit:        string(product.Unit),
+		Quantity:    formatQuantity(product.Quantity),
+		MinQuantity: formatQuantity(product.MinQuantity),
+		Low:         product.IsLow(),
+	}
+}
+
+func (ih *InventoryHandler) InventoryGET(c *gin.Context) {
+	reason := getFlash(c, "flash_auth")
+	csrfToken := setCsrfCookie(c)
+
+	products, err := ih.inventory.ListProducts()
+	if err != nil {
+		_, reason = common.ErrorToHTTP(err)
+	}
+
+	dishes, err := ih.menu.ListDishes()
+	if err != nil {
+		_, reason = common.ErrorToHTTP(err)
+	}
+
+	productViews := make([]productView, 0, len(products))
+	lowViews := make([]productView, 0)
+	for _, product := range products {
+		view := toProductView(product)
+		productViews = append(productViews, view)
+		if view.Low {
+			lowViews = append(lowViews, view)
+		}
+	}
+
+	c.HTML(http.StatusOK, "inventory.html", gin.H{
+		"reason":    reason,
+		"csrfToken": csrfToken,
+		"products":  productViews,
+		"lowStock":  lowViews,
+		"dishes":    dishes,
+		"units":     domInventory.Units,
+	})
+}
+
+func (ih *InventoryHandler) ProductPOST(c *gin.Context) {
+	minQuantity, err := strconv.ParseFloat(c.DefaultPostForm("min_quantity", "0"), 64)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(usecase.ErrInvalidQuantity)
+		redirectToAuthPage(c, "/inventory", msg)
+		return
+	}
+
+	_, err = ih.inventory.CreateProduct(domInventory.Product{
+		Name:        c.PostForm("name"),
+		Unit:        domInventory.Unit(c.PostForm("unit")),
+		MinQuantity: minQuantity,
+	})
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/inventory", msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/inventory")
+}
+
+func (ih *InventoryHandler) MovementPOST(c *gin.Context) {
+	authorID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+		redirectToAuthPage(c, "/inventory", msg)
+		return
+	}
+
+	quantity, err := strconv.ParseFloat(c.PostForm("quantity"), 64)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(usecase.ErrInvalidQuantity)
+		redirectToAuthPage(c, "/inventory", msg)
+		return
+	}
+
+	var dishID int64
+	if raw := c.PostForm("dish_id"); raw != "" {
+		if dishID, err = strconv.ParseInt(raw, 10, 64); err != nil {
+			_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+			redirectToAuthPage(c, "/inventory", msg)
+			return
+		}
+	}
+
+	_, err = ih.inventory.RecordMovement(domInventory.Movement{
+		ProductID: domInventory.ProductID(id),
+		Kind:      domInventory.MovementKind(c.PostForm("kind")),
+		Quantity:  quantity,
+		DishID:    domMenu.DishID(dishID),
+		AuthorID:  authorID,
+		Comment:   c.PostForm("comment"),
+	})
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/inventory", msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/inventory")
+}
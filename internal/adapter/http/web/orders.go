@@ -0,0 +1,304 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OrderHandler struct {
+	orders   common.OrderUseCase
+	menu     common.MenuUseCase
+	wallet   common.WalletUseCase
+	profiles common.ProfileUseCase
+	tokenSvc usecase.TokenService
+}
+
+func NewOrderHandler(
+	router *gin.Engine,
+	orders common.OrderUseCase,
+	menu common.MenuUseCase,
+	wallet common.WalletUseCase,
+	profiles common.ProfileUseCase,
+	tokenSvc usecase.TokenService,
+) {
+	handler := &OrderHandler{
+		orders:   orders,
+		menu:     menu,
+		wallet:   wallet,
+		profiles: profiles,
+		tokenSvc: tokenSvc,
+	}
+
+	{
+		orders := router.Group("/orders", AuthMiddleware(handler.tokenSvc))
+
+		student := orders.Group("", common.RequirePermission(domUser.PermOrdersPlace))
+		student.GET("", handler.OrdersGET)
+		student.POST("", CSRFMiddleware(), handler.OrdersPOST)
+		student.POST("/:id/pay", CSRFMiddleware(), handler.PayPOST)
+		student.POST("/:id/cancel", CSRFMiddleware(), handler.CancelPOST)
+
+		staff := orders.Group("", common.RequirePermission(domUser.PermOrdersIssue))
+		staff.GET("/queue", handler.QueueGET)
+		staff.POST("/:id/prepare", CSRFMiddleware(), handler.PreparePOST)
+		staff.POST("/:id/issue", CSRFMiddleware(), handler.IssuePOST)
+	}
+}
+
+type dishView struct {
+	ID        int64
+	Name      string
+	Price     string
+	Weight    int
+	Allergens string
+	Conflicts string
+}
+
+type menuView struct {
+	ID       int64
+	MealType string
+	Dishes   []dishView
+}
+
+type orderView struct {
+	ID       int64
+	Date     string
+	MealType string
+	Items    []string
+	Total    string
+	Status   string
+	CanPay   bool
+	CanEdit  bool
+	Prepared bool
+}
+
+func toMenuView(menu domMenu.Menu, allergens []domMenu.Allergen) menuView {
+	view := menuView{ID: int64(menu.ID), MealType: string(menu.MealType)}
+	for _, dish := range menu.Dishes {
+		view.Dishes = append(view.Dishes, dishView{
+			ID:        int64(dish.ID),
+			Name:      dish.Name,
+			Price:     formatPrice(dish.Price),
+			Weight:    dish.Weight,
+			Allergens: joinAllergens(dish.Allergens),
+			Conflicts: joinAllergens(dish.Conflicts(allergens)),
+		})
+	}
+	return view
+}
+
+func joinAllergens(allergens []domMenu.Allergen) string {
+	names := make([]string, 0, len(allergens))
+	for _, a := range allergens {
+		names = append(names, string(a))
+	}
+	return strings.Join(names, ", ")
+}
+
+func toOrderView(order domOrder.Order) orderView {
+	view := orderView{
+		ID:       int64(order.ID),
+		Date:     order.Date.Format(common.DateLayout),
+		MealType: string(order.MealType),
+		Total:    formatPrice(order.Total),
+		Status:   string(order.Status),
+		CanPay:   order.Status.CanTransitionTo(domOrder.Paid),
+		CanEdit:  order.Status.CanTransitionTo(domOrder.Cancelled),
+		Prepared: order.Status == domOrder.Prepared,
+	}
+	for _, item := range order.Items {
+		view.Items = append(view.Items, item.Name+" x"+strconv.Itoa(item.Quantity))
+	}
+	return view
+}
+
+func (oh *OrderHandler) OrdersGET(c *gin.Context) {
+	reason := getFlash(c, "flash_auth")
+	csrfToken := setCsrfCookie(c)
+
+	studentID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	date := time.Now()
+	if raw := c.Query("date"); raw != "" {
+		if parsed, err := time.Parse(common.DateLayout, raw); err == nil {
+			date = parsed
+		}
+	}
+
+	menus, err := oh.menu.GetDayMenu(date)
+	if err != nil {
+		_, reason = common.ErrorToHTTP(err)
+	}
+
+	orders, err := oh.orders.ListStudentOrders(studentID)
+	if err != nil {
+		_, reason = common.ErrorToHTTP(err)
+	}
+
+	balance, err := oh.wallet.Balance(studentID)
+	if err != nil {
+		_, reason = common.ErrorToHTTP(err)
+	}
+
+	profile, err := oh.profiles.GetProfile(studentID)
+	if err != nil {
+		_, reason = common.ErrorToHTTP(err)
+		profile = &domUser.DietaryProfile{}
+	}
+
+	menuViews := make([]menuView, 0, len(menus))
+	for _, menu := range menus {
+		menuViews = append(menuViews, toMenuView(menu, profile.Allergens))
+	}
+
+	orderViews := make([]orderView, 0, len(orders))
+	for i := len(orders) - 1; i >= 0; i-- {
+		orderViews = append(orderViews, toOrderView(orders[i]))
+	}
+
+	c.HTML(http.StatusOK, "orders_student.html", gin.H{
+		"reason":    reason,
+		"csrfToken": csrfToken,
+		"date":      date.Format(common.DateLayout),
+		"balance":   formatPrice(balance),
+		"menus":     menuViews,
+		"orders":    orderViews,
+	})
+}
+
+func (oh *OrderHandler) OrdersPOST(c *gin.Context) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	menuID, err := strconv.ParseInt(c.PostForm("menu_id"), 10, 64)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+		redirectToAuthPage(c, "/orders", msg)
+		return
+	}
+
+	var items []domOrder.Item
+	for key, values := range c.Request.PostForm {
+		rawID, ok := strings.CutPrefix(key, "qty_")
+		if !ok || len(values) == 0 {
+			continue
+		}
+
+		dishID, err := strconv.ParseInt(rawID, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		qty, err := strconv.Atoi(values[0])
+		if err != nil || qty <= 0 {
+			continue
+		}
+
+		items = append(items, domOrder.Item{DishID: domMenu.DishID(dishID), Quantity: qty})
+	}
+
+	if _, err := oh.orders.PlaceOrder(studentID, domMenu.MenuID(menuID), items, c.PostForm("confirm_allergens") == "on"); err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/orders", msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/orders")
+}
+
+func (oh *OrderHandler) PayPOST(c *gin.Context) {
+	oh.studentAction(c, oh.orders.PayOrder)
+}
+
+func (oh *OrderHandler) CancelPOST(c *gin.Context) {
+	oh.studentAction(c, oh.orders.CancelOrder)
+}
+
+func (oh *OrderHandler) QueueGET(c *gin.Context) {
+	reason := getFlash(c, "flash_auth")
+	csrfToken := setCsrfCookie(c)
+
+	orders, err := oh.orders.Queue(time.Now())
+	if err != nil {
+		_, reason = common.ErrorToHTTP(err)
+	}
+
+	orderViews := make([]orderView, 0, len(orders))
+	for _, order := range orders {
+		orderViews = append(orderViews, toOrderView(order))
+	}
+
+	c.HTML(http.StatusOK, "orders_queue.html", gin.H{
+		"reason":    reason,
+		"csrfToken": csrfToken,
+		"orders":    orderViews,
+	})
+}
+
+func (oh *OrderHandler) PreparePOST(c *gin.Context) {
+	oh.staffAction(c, oh.orders.MarkPrepared)
+}
+
+func (oh *OrderHandler) IssuePOST(c *gin.Context) {
+	oh.staffAction(c, oh.orders.MarkIssued)
+}
+
+func (oh *OrderHandler) studentAction(c *gin.Context, action func(studentID domUser.UserID, orderID domOrder.OrderID) (*domOrder.Order, error)) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+		redirectToAuthPage(c, "/orders", msg)
+		return
+	}
+
+	if _, err := action(studentID, domOrder.OrderID(id)); err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/orders", msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/orders")
+}
+
+func (oh *OrderHandler) staffAction(c *gin.Context, action func(orderID domOrder.OrderID) (*domOrder.Order, error)) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+		redirectToAuthPage(c, "/orders/queue", msg)
+		return
+	}
+
+	if _, err := action(domOrder.OrderID(id)); err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/orders/queue", msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/orders/queue")
+}
@@ -0,0 +1,52 @@
+package web
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"canteen-app/internal/adapter/http/common"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PaymentHandler struct {
+	payments common.PaymentUseCase
+	tokenSvc usecase.TokenService
+}
+
+func NewPaymentHandler(router *gin.Engine, payments common.PaymentUseCase, tokenSvc usecase.TokenService) {
+	handler := &PaymentHandler{
+		payments: payments,
+		tokenSvc: tokenSvc,
+	}
+
+	router.POST("/payments/top-up", AuthMiddleware(handler.tokenSvc), common.RequirePermission(domUser.PermPaymentsTopUp), CSRFMiddleware(), handler.TopUpPOST)
+}
+
+func (ph *PaymentHandler) TopUpPOST(c *gin.Context) {
+	studentID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	rubles, err := strconv.ParseFloat(c.PostForm("amount"), 64)
+	if err != nil || rubles < 1 || rubles > 10000 {
+		_, msg := common.ErrorToHTTP(usecase.ErrInvalidAmount)
+		redirectToAuthPage(c, "/orders", msg)
+		return
+	}
+
+	_, redirectURL, err := ph.payments.CreateTopUp(studentID, int64(math.Round(rubles*100)))
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/orders", msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, redirectURL)
+}
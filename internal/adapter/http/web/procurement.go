@@ -0,0 +1,239 @@
+package web
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"canteen-app/internal/adapter/http/common"
+	domInventory "canteen-app/internal/domain/inventory"
+	domProcurement "canteen-app/internal/domain/procurement"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProcurementHandler struct {
+	procurement common.ProcurementUseCase
+	inventory   common.InventoryUseCase
+	tokenSvc    usecase.TokenService
+}
+
+func NewProcurementHandler(router *gin.Engine, procurement common.ProcurementUseCase, inventory common.InventoryUseCase, tokenSvc usecase.TokenService) {
+	handler := &ProcurementHandler{
+		procurement: procurement,
+		inventory:   inventory,
+		tokenSvc:    tokenSvc,
+	}
+
+	{
+		procurement := router.Group("/procurement", AuthMiddleware(handler.tokenSvc), common.RequirePermission(domUser.PermProcurementRequest))
+		procurement.GET("", handler.ProcurementGET)
+		procurement.POST("/requests", CSRFMiddleware(), handler.RequestPOST)
+		procurement.POST("/requests/:id/submit", CSRFMiddleware(), handler.SubmitPOST)
+		procurement.POST("/requests/:id/comments", CSRFMiddleware(), handler.CommentPOST)
+		procurement.POST("/requests/:id/fulfill", CSRFMiddleware(), handler.FulfillPOST)
+		procurement.POST("/requests/:id/approve", common.RequirePermission(domUser.PermProcurementApprove), CSRFMiddleware(), handler.ApprovePOST)
+		procurement.POST("/requests/:id/reject", common.RequirePermission(domUser.PermProcurementApprove), CSRFMiddleware(), handler.RejectPOST)
+	}
+}
+
+type purchaseItemView struct {
+	Name          string
+	Unit          string
+	Quantity      string
+	EstimatedCost string
+}
+
+type purchaseRequestView struct {
+	ID            int64
+	AuthorID      int64
+	Status        string
+	EstimatedCost string
+	ApproverID    int64
+	Items         []purchaseItemView
+	Comments      []domProcurement.Comment
+	Mine          bool
+	CanSubmit     bool
+	CanApprove    bool
+	CanFulfill    bool
+}
+
+func toPurchaseRequestView(request domProcurement.Request, userID domUser.UserID, canApprove bool) purchaseRequestView {
+	items := make([]purchaseItemView, 0, len(request.Items))
+	for _, item := range request.Items {
+		items = append(items, purchaseItemView{
+			Name:          item.Name,
+			Unit:          string(item.Unit),
+			Quantity:      formatQuantity(item.Quantity),
+			EstimatedCost: formatPrice(item.EstimatedCost),
+		})
+	}
+
+	mine := request.AuthorID == userID
+	return purchaseRequestView{
+		ID:            int64(request.ID),
+		AuthorID:      int64(request.AuthorID),
+		Status:        string(request.Status),
+		EstimatedCost: formatPrice(request.EstimatedCost),
+		ApproverID:    int64(request.ApproverID),
+		Items:         items,
+		Comments:      request.Comments,
+		Mine:          mine,
+		CanSubmit:     mine && request.Status.CanTransitionTo(domProcurement.Submitted),
+		CanApprove:    canApprove && request.Status.CanTransitionTo(domProcurement.Approved),
+		CanFulfill:    request.Status.CanTransitionTo(domProcurement.Fulfilled),
+	}
+}
+
+func (ph *ProcurementHandler) ProcurementGET(c *gin.Context) {
+	reason := getFlash(c, "flash_auth")
+	csrfToken := setCsrfCookie(c)
+
+	userID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	canApprove := common.CurrentRole(c).Can(domUser.PermProcurementApprove)
+
+	requests, err := ph.procurement.ListRequests()
+	if err != nil {
+		_, reason = common.ErrorToHTTP(err)
+	}
+
+	products, err := ph.inventory.ListProducts()
+	if err != nil {
+		_, reason = common.ErrorToHTTP(err)
+	}
+
+	views := make([]purchaseRequestView, 0, len(requests))
+	for i := len(requests) - 1; i >= 0; i-- {
+		views = append(views, toPurchaseRequestView(requests[i], userID, canApprove))
+	}
+
+	productViews := make([]productView, 0, len(products))
+	for _, product := range products {
+		productViews = append(productViews, toProductView(product))
+	}
+
+	c.HTML(http.StatusOK, "procurement.html", gin.H{
+		"reason":     reason,
+		"csrfToken":  csrfToken,
+		"requests":   views,
+		"products":   productViews,
+		"canApprove": canApprove,
+	})
+}
+
+// RequestPOST drafts a purchase request from the qty_<product id> and
+// cost_<product id> form fields; products with an empty quantity are skipped.
+// Costs are entered in rubles.
+func (ph *ProcurementHandler) RequestPOST(c *gin.Context) {
+	authorID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	if err := c.Request.ParseForm(); err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+		redirectToAuthPage(c, "/procurement", msg)
+		return
+	}
+
+	items := make([]domProcurement.Item, 0)
+	for key, values := range c.Request.PostForm {
+		rawID, ok := strings.CutPrefix(key, "qty_")
+		if !ok || len(values) == 0 || values[0] == "" {
+			continue
+		}
+
+		productID, err := strconv.ParseInt(rawID, 10, 64)
+		if err != nil {
+			_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+			redirectToAuthPage(c, "/procurement", msg)
+			return
+		}
+
+		quantity, err := strconv.ParseFloat(values[0], 64)
+		if err != nil {
+			_, msg := common.ErrorToHTTP(usecase.ErrInvalidQuantity)
+			redirectToAuthPage(c, "/procurement", msg)
+			return
+		}
+
+		cost, err := strconv.ParseFloat(c.DefaultPostForm("cost_"+rawID, "0"), 64)
+		if err != nil {
+			_, msg := common.ErrorToHTTP(usecase.ErrInvalidQuantity)
+			redirectToAuthPage(c, "/procurement", msg)
+			return
+		}
+
+		items = append(items, domProcurement.Item{
+			ProductID:     domInventory.ProductID(productID),
+			Quantity:      quantity,
+			EstimatedCost: int64(math.Round(cost * 100)),
+		})
+	}
+
+	_, err = ph.procurement.CreateRequest(authorID, items, c.PostForm("comment"))
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/procurement", msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/procurement")
+}
+
+func (ph *ProcurementHandler) SubmitPOST(c *gin.Context) {
+	ph.resolve(c, func(userID domUser.UserID, id domProcurement.RequestID, _ string) (*domProcurement.Request, error) {
+		return ph.procurement.Submit(userID, id)
+	})
+}
+
+func (ph *ProcurementHandler) ApprovePOST(c *gin.Context) {
+	ph.resolve(c, ph.procurement.Approve)
+}
+
+func (ph *ProcurementHandler) RejectPOST(c *gin.Context) {
+	ph.resolve(c, ph.procurement.Reject)
+}
+
+func (ph *ProcurementHandler) FulfillPOST(c *gin.Context) {
+	ph.resolve(c, ph.procurement.Fulfill)
+}
+
+func (ph *ProcurementHandler) CommentPOST(c *gin.Context) {
+	ph.resolve(c, ph.procurement.AddComment)
+}
+
+func (ph *ProcurementHandler) resolve(c *gin.Context, action func(userID domUser.UserID, id domProcurement.RequestID, comment string) (*domProcurement.Request, error)) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+		redirectToAuthPage(c, "/procurement", msg)
+		return
+	}
+
+	if _, err := action(userID, domProcurement.RequestID(id), c.PostForm("comment")); err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/procurement", msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/procurement")
+}
@@ -0,0 +1,96 @@
+package web
+
+import (
+	"net/http"
+	"slices"
+
+	"canteen-app/internal/adapter/http/common"
+	domMenu "canteen-app/internal/domain/menu"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProfileHandler struct {
+	profiles common.ProfileUseCase
+	tokenSvc usecase.TokenService
+}
+
+func NewProfileHandler(router *gin.Engine, profiles common.ProfileUseCase, tokenSvc usecase.TokenService) {
+	handler := &ProfileHandler{
+		profiles: profiles,
+		tokenSvc: tokenSvc,
+	}
+
+	{
+		profile := router.Group("/profile", AuthMiddleware(handler.tokenSvc), common.RequirePermission(domUser.PermProfileEdit))
+		profile.GET("", handler.ProfileGET)
+		profile.POST("", CSRFMiddleware(), handler.ProfilePOST)
+	}
+}
+
+type allergenView struct {
+	Name    string
+	Checked bool
+}
+
+func (ph *ProfileHandler) ProfileGET(c *gin.Context) {
+	reason := getFlash(c, "flash_auth")
+	csrfToken := setCsrfCookie(c)
+
+	userID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	profile, err := ph.profiles.GetProfile(userID)
+	if err != nil {
+		_, reason = common.ErrorToHTTP(err)
+		profile = &domUser.DietaryProfile{}
+	}
+
+	allergens := make([]allergenView, 0, len(domMenu.Allergens))
+	for _, a := range domMenu.Allergens {
+		allergens = append(allergens, allergenView{
+			Name:    string(a),
+			Checked: slices.Contains(profile.Allergens, a),
+		})
+	}
+
+	c.HTML(http.StatusOK, "profile.html", gin.H{
+		"reason":       reason,
+		"csrfToken":    csrfToken,
+		"allergens":    allergens,
+		"restrictions": profile.Restrictions,
+	})
+}
+
+func (ph *ProfileHandler) ProfilePOST(c *gin.Context) {
+	userID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	var allergens []domMenu.Allergen
+	for _, raw := range c.PostFormArray("allergens") {
+		allergens = append(allergens, domMenu.Allergen(raw))
+	}
+
+	_, err = ph.profiles.UpdateProfile(domUser.DietaryProfile{
+		UserID:       userID,
+		Allergens:    allergens,
+		Restrictions: c.PostForm("restrictions"),
+	})
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/profile", msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/profile")
+}
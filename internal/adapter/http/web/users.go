@@ -0,0 +1,233 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"canteen-app/internal/adapter/http/common"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UserHandler struct {
+	accounts  common.AccountUseCase
+	publicURL string
+	tokenSvc  usecase.TokenService
+	validator common.Validator
+}
+
+// NewUserHandler registers the admin pages to look after accounts. publicURL
+// is used to build the set-password links after a password reset.
+func NewUserHandler(router *gin.Engine, accounts common.AccountUseCase, publicURL string, tokenSvc usecase.TokenService, validator common.Validator) {
+	handler := &UserHandler{
+		accounts:  accounts,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+		tokenSvc:  tokenSvc,
+		validator: validator,
+	}
+
+	{
+		users := router.Group("/admin/users", AuthMiddleware(handler.tokenSvc), common.RequirePermission(domUser.PermUsersManage))
+		users.GET("", handler.UsersGET)
+		users.GET("/:id", handler.UserGET)
+		users.POST("/:id/role", CSRFMiddleware(), handler.RolePOST)
+		users.POST("/:id/block", CSRFMiddleware(), handler.BlockPOST)
+		users.POST("/:id/unblock", CSRFMiddleware(), handler.UnblockPOST)
+		users.POST("/:id/unlock", CSRFMiddleware(), handler.UnlockPOST)
+		users.POST("/:id/reset-password", CSRFMiddleware(), handler.ResetPasswordPOST)
+		users.POST("/:id/delete", CSRFMiddleware(), handler.DeletePOST)
+	}
+}
+
+var roles = []domUser.Role{domUser.RoleAdmin, domUser.RoleEmployee, domUser.RoleStudent}
+
+func (uh *UserHandler) UsersGET(c *gin.Context) {
+	reason := getFlash(c, "flash_auth")
+
+	var req common.UserListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		_, reason = common.ErrorToHTTP(common.ErrInvalidRequest)
+		req = common.UserListRequest{}
+	} else if err := uh.validator.Struct(req); err != nil {
+		_, reason = common.ErrorToHTTP(common.ErrValidationError)
+		req = common.UserListRequest{}
+	}
+	req = req.WithDefaults()
+
+	users, total, err := uh.accounts.ListUsers(req.ListQuery())
+	if err != nil {
+		_, reason = common.ErrorToHTTP(err)
+	}
+
+	// pageURL keeps the filters when moving between pages.
+	pageURL := func(page int) string {
+		query := url.Values{}
+		if req.Search != "" {
+			query.Set("search", req.Search)
+		}
+		if req.Role != "" {
+			query.Set("role", string(req.Role))
+		}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("per_page", strconv.Itoa(req.PerPage))
+		return "/admin/users?" + query.Encode()
+	}
+
+	data := gin.H{
+		"reason": reason,
+		"users":  users,
+		"total":  total,
+		"search": req.Search,
+		"role":   string(req.Role),
+		"roles":  roles,
+		"page":   req.Page,
+		"pages":  max((total+req.PerPage-1)/req.PerPage, 1),
+	}
+	if req.Page > 1 {
+		data["prevURL"] = pageURL(req.Page - 1)
+	}
+	if req.Page*req.PerPage < total {
+		data["nextURL"] = pageURL(req.Page + 1)
+	}
+
+	c.HTML(http.StatusOK, "users.html", data)
+}
+
+func (uh *UserHandler) UserGET(c *gin.Context) {
+	uh.renderUser(c, getFlash(c, "flash_auth"), "")
+}
+
+func (uh *UserHandler) RolePOST(c *gin.Context) {
+	uh.update(c, func(adminID, id domUser.UserID) error {
+		_, err := uh.accounts.ChangeRole(adminID, id, domUser.Role(c.PostForm("role")))
+		return err
+	})
+}
+
+func (uh *UserHandler) BlockPOST(c *gin.Context) {
+	uh.update(c, func(adminID, id domUser.UserID) error {
+		_, err := uh.accounts.SetBlocked(adminID, id, true)
+		return err
+	})
+}
+
+func (uh *UserHandler) UnblockPOST(c *gin.Context) {
+	uh.update(c, func(adminID, id domUser.UserID) error {
+		_, err := uh.accounts.SetBlocked(adminID, id, false)
+		return err
+	})
+}
+
+func (uh *UserHandler) UnlockPOST(c *gin.Context) {
+	uh.update(c, func(_, id domUser.UserID) error {
+		return uh.accounts.UnlockUser(id)
+	})
+}
+
+// ResetPasswordPOST shows the set-password link on the user page right away
+// rather than redirecting, so that it never ends up in a cookie.
+func (uh *UserHandler) ResetPasswordPOST(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+		redirectToAuthPage(c, "/admin/users", msg)
+		return
+	}
+
+	setup, err := uh.accounts.ResetPassword(domUser.UserID(id))
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, fmt.Sprintf("/admin/users/%d", id), msg)
+		return
+	}
+
+	uh.renderUser(c, "", uh.publicURL+"/set-password?token="+url.QueryEscape(setup.Token))
+}
+
+func (uh *UserHandler) DeletePOST(c *gin.Context) {
+	adminID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+		redirectToAuthPage(c, "/admin/users", msg)
+		return
+	}
+
+	if err := uh.accounts.DeleteUser(adminID, domUser.UserID(id)); err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, fmt.Sprintf("/admin/users/%d", id), msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/admin/users")
+}
+
+func (uh *UserHandler) renderUser(c *gin.Context, reason, setPasswordURL string) {
+	csrfToken := setCsrfCookie(c)
+
+	adminID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+		redirectToAuthPage(c, "/admin/users", msg)
+		return
+	}
+
+	user, err := uh.accounts.GetUser(domUser.UserID(id))
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/admin/users", msg)
+		return
+	}
+
+	c.HTML(http.StatusOK, "user.html", gin.H{
+		"reason":         reason,
+		"csrfToken":      csrfToken,
+		"user":           user,
+		"roles":          roles,
+		"self":           user.ID == adminID,
+		"setPasswordURL": setPasswordURL,
+	})
+}
+
+func (uh *UserHandler) update(c *gin.Context, action func(adminID, id domUser.UserID) error) {
+	adminID, err := currentUserID(c)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, "/login", msg)
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_, msg := common.ErrorToHTTP(common.ErrInvalidRequest)
+		redirectToAuthPage(c, "/admin/users", msg)
+		return
+	}
+
+	page := fmt.Sprintf("/admin/users/%d", id)
+	if err := action(adminID, domUser.UserID(id)); err != nil {
+		_, msg := common.ErrorToHTTP(err)
+		redirectToAuthPage(c, page, msg)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, page)
+}
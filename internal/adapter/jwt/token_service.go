@@ -34,7 +34,7 @@ func NewJWTTokenService(accessSecret, refreshSecret []byte, accessTTL, refreshTT
 
 type accessClaims struct {
 	UserID domUser.UserID `json:"sub"`
-	Role   string         `json:"role"`
+	Role   domUser.Role   `json:"role"`
 	jwt.RegisteredClaims
 }
 
@@ -44,7 +44,7 @@ type refreshClaims struct {
 	jwt.RegisteredClaims
 }
 
-func (s *JWTTokenService) GenerateAccessToken(userID domUser.UserID, role string) (string, error) {
+func (s *JWTTokenService) GenerateAccessToken(userID domUser.UserID, role domUser.Role) (string, error) {
 	exp := time.Now().Add(s.accessTTL)
 	claims := accessClaims{
 		UserID: userID,
@@ -0,0 +1,64 @@
+// Package outbox delivers email into a directory instead of sending it, one
+// file per message, for development and tests.
+package outbox
+
+import (
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	domMail "canteen-app/internal/domain/mail"
+	"canteen-app/internal/usecase"
+)
+
+// Outbox writes each message to dir as an .eml file that mail clients open.
+// Messages carry secrets such as password reset links, so the files are
+// readable by the owner only.
+type Outbox struct {
+	dir  string
+	from string
+	now  func() time.Time
+
+	mu   sync.Mutex
+	sent int
+}
+
+var _ usecase.Mailer = (*Outbox)(nil)
+
+// NewOutbox creates dir if missing. from is the sender of every message.
+func NewOutbox(dir, from string) (*Outbox, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create outbox: %w", err)
+	}
+	return &Outbox{dir: dir, from: from, now: time.Now}, nil
+}
+
+func (o *Outbox) Send(msg domMail.Message) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	now := o.now()
+	o.sent++
+	// The counter keeps the names of messages sent within one
+	// nanosecond apart and their order.
+	name := fmt.Sprintf("%s-%06d.eml", now.UTC().Format("20060102T150405.000000000Z"), o.sent)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", o.from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	if err := os.WriteFile(filepath.Join(o.dir, name), []byte(b.String()), 0o600); err != nil {
+		return fmt.Errorf("write to outbox: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package outbox
+
+import (
+	"io"
+	"mime"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	domMail "canteen-app/internal/domain/mail"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutbox_Send(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "outbox")
+	outbox, err := NewOutbox(dir, "canteen@example.com")
+	require.NoError(t, err)
+	outbox.now = func() time.Time { return time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC) }
+
+	require.NoError(t, outbox.Send(domMail.Message{To: "slim@example.com", Subject: "Сброс пароля", Body: "line one\nline two\n"}))
+	require.NoError(t, outbox.Send(domMail.Message{To: "shady@example.com", Subject: "Hello", Body: "hi"}))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "20261019T100000.000000000Z-000001.eml", entries[0].Name())
+	assert.Equal(t, "20261019T100000.000000000Z-000002.eml", entries[1].Name())
+
+	info, err := entries[0].Info()
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	file, err := os.Open(filepath.Join(dir, entries[0].Name()))
+	require.NoError(t, err)
+	defer file.Close()
+
+	msg, err := mail.ReadMessage(file)
+	require.NoError(t, err)
+	assert.Equal(t, "canteen@example.com", msg.Header.Get("From"))
+	assert.Equal(t, "slim@example.com", msg.Header.Get("To"))
+	assert.Equal(t, "Mon, 19 Oct 2026 10:00:00 +0000", msg.Header.Get("Date"))
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Сброс пароля", subject)
+
+	body, err := io.ReadAll(msg.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "line one\r\nline two\r\n", string(body))
+}
@@ -0,0 +1,20 @@
+// Package metrics publishes the application gauges through expvar; they are
+// served with the runtime ones at /debug/vars, to admins only.
+package metrics
+
+import (
+	"expvar"
+
+	"canteen-app/internal/usecase"
+)
+
+var activeSessions = expvar.NewInt("active_sessions")
+
+// Sessions reports the number of unexpired refresh tokens.
+type Sessions struct{}
+
+var _ usecase.SessionMetrics = Sessions{}
+
+func (Sessions) SetActiveSessions(n int) {
+	activeSessions.Set(int64(n))
+}
@@ -0,0 +1,64 @@
+package fake
+
+import (
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+
+	domPayment "canteen-app/internal/domain/payment"
+
+	"github.com/gin-gonic/gin"
+)
+
+var checkoutPage = template.Must(template.New("checkout").Parse(`<!DOCTYPE html>
+
+<html>
+    <h1>FAKE GATEWAY</h1>
+
+    <p>{{.description}}</p>
+    <p>amount: {{.amount}}</p>
+    <form method="post">
+        <button type="submit" name="outcome" value="success">pay</button>
+        <button type="submit" name="outcome" value="decline">decline</button>
+        <button type="submit" name="outcome" value="timeout">timeout</button>
+    </form>
+</html>`))
+
+// RegisterRoutes mounts the checkout page the payer is redirected to. After an
+// outcome is chosen the webhook is delivered and the payer is sent to returnURL.
+func (g *Gateway) RegisterRoutes(router *gin.Engine, returnURL string) {
+	router.GET("/fake-gateway/checkout/:id", g.checkoutGET)
+	router.POST("/fake-gateway/checkout/:id", func(c *gin.Context) {
+		g.checkoutPOST(c, returnURL)
+	})
+}
+
+func (g *Gateway) checkoutGET(c *gin.Context) {
+	g.mu.Lock()
+	p, ok := g.payments[domPayment.PaymentID(c.Param("id"))]
+	g.mu.Unlock()
+	if !ok {
+		c.String(http.StatusNotFound, "unknown payment")
+		return
+	}
+
+	c.Status(http.StatusOK)
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	err := checkoutPage.Execute(c.Writer, gin.H{
+		"description": p.description,
+		"amount":      fmt.Sprintf("%d.%02d", p.amount/100, p.amount%100),
+	})
+	if err != nil {
+		log.Printf("fake gateway: render checkout: %v", err)
+	}
+}
+
+func (g *Gateway) checkoutPOST(c *gin.Context, returnURL string) {
+	id := domPayment.PaymentID(c.Param("id"))
+	if err := g.Deliver(c.Request.Context(), id, Outcome(c.PostForm("outcome"))); err != nil {
+		log.Printf("fake gateway: deliver webhook for %s: %v", id, err)
+	}
+
+	c.Redirect(http.StatusSeeOther, returnURL)
+}
@@ -0,0 +1,164 @@
+package fake
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	domPayment "canteen-app/internal/domain/payment"
+	"canteen-app/internal/usecase"
+)
+
+// SignatureHeader carries the hex encoded HMAC-SHA256 of the webhook body.
+const SignatureHeader = "X-Signature"
+
+type Outcome string
+
+const (
+	Success Outcome = "success"
+	Decline Outcome = "decline"
+	Timeout Outcome = "timeout"
+)
+
+var ErrUnknownPayment = errors.New("fake gateway: unknown payment")
+
+type webhookPayload struct {
+	PaymentID string `json:"payment_id"`
+	Status    string `json:"status"`
+	Amount    int64  `json:"amount"`
+}
+
+type payment struct {
+	amount      int64
+	description string
+}
+
+// Gateway simulates a card acquirer for development and tests. A payer is
+// redirected to its checkout page, picks an outcome, and the gateway posts a
+// signed webhook with the result, just like a real acquirer would.
+type Gateway struct {
+	secret      []byte
+	checkoutURL string
+	webhookURL  string
+	client      *http.Client
+
+	mu       sync.Mutex
+	payments map[domPayment.PaymentID]payment
+}
+
+var _ usecase.PaymentGateway = (*Gateway)(nil)
+
+func NewGateway(secret []byte, checkoutURL, webhookURL string) *Gateway {
+	return &Gateway{
+		secret:      secret,
+		checkoutURL: checkoutURL,
+		webhookURL:  webhookURL,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		payments:    make(map[domPayment.PaymentID]payment),
+	}
+}
+
+func (g *Gateway) CreatePayment(id domPayment.PaymentID, amount int64, description string) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.payments[id] = payment{amount: amount, description: description}
+	return g.checkoutURL + "/" + url.PathEscape(string(id)), nil
+}
+
+func (g *Gateway) ParseWebhook(body []byte, signature string) (domPayment.Event, error) {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, g.mac(body)) {
+		return domPayment.Event{}, usecase.ErrInvalidSignature
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return domPayment.Event{}, usecase.ErrInvalidWebhook
+	}
+
+	return domPayment.Event{
+		PaymentID: domPayment.PaymentID(payload.PaymentID),
+		Status:    domPayment.Status(payload.Status),
+		Amount:    payload.Amount,
+	}, nil
+}
+
+func (g *Gateway) Sign(body []byte) string {
+	return hex.EncodeToString(g.mac(body))
+}
+
+// Simulate builds the signed webhook the gateway would send for the given
+// outcome without delivering it.
+func (g *Gateway) Simulate(id domPayment.PaymentID, outcome Outcome) ([]byte, string, error) {
+	g.mu.Lock()
+	p, ok := g.payments[id]
+	g.mu.Unlock()
+	if !ok {
+		return nil, "", ErrUnknownPayment
+	}
+
+	var status domPayment.Status
+	switch outcome {
+	case Success:
+		status = domPayment.Succeeded
+	case Decline:
+		status = domPayment.Declined
+	case Timeout:
+		status = domPayment.Expired
+	default:
+		return nil, "", fmt.Errorf("fake gateway: unknown outcome %q", outcome)
+	}
+
+	body, err := json.Marshal(webhookPayload{
+		PaymentID: string(id),
+		Status:    string(status),
+		Amount:    p.amount,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, g.Sign(body), nil
+}
+
+// Deliver posts the webhook for the given outcome to the configured URL.
+func (g *Gateway) Deliver(ctx context.Context, id domPayment.PaymentID, outcome Outcome) error {
+	body, signature, err := g.Simulate(id, outcome)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, signature)
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fake gateway: webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (g *Gateway) mac(body []byte) []byte {
+	m := hmac.New(sha256.New, g.secret)
+	m.Write(body)
+	return m.Sum(nil)
+}
@@ -0,0 +1,71 @@
+// Package ratelimit holds the rate limiters behind the RateLimit middleware.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+)
+
+type window struct {
+	start time.Time
+	count int
+}
+
+// Memory allows limit requests per key in fixed windows of the given length,
+// counted in this process.
+type Memory struct {
+	limit  int
+	length time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	windows   map[string]window
+	nextSweep time.Time
+}
+
+var _ common.RateLimiter = (*Memory)(nil)
+
+func NewMemory(limit int, length time.Duration) *Memory {
+	return &Memory{
+		limit:   limit,
+		length:  length,
+		now:     time.Now,
+		windows: make(map[string]window),
+	}
+}
+
+func (m *Memory) Allow(key string) (bool, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	w := m.windows[key]
+	if now.Sub(w.start) >= m.length {
+		w = window{start: now}
+	}
+	if w.count >= m.limit {
+		return false, w.start.Add(m.length).Sub(now), nil
+	}
+	w.count++
+	m.windows[key] = w
+	return true, 0, nil
+}
+
+// sweep drops the windows that are over, at most once per window length, so
+// that clients seen once do not pile up.
+func (m *Memory) sweep(now time.Time) {
+	if now.Before(m.nextSweep) {
+		return
+	}
+	m.nextSweep = now.Add(m.length)
+
+	for key, w := range m.windows {
+		if now.Sub(w.start) >= m.length {
+			delete(m.windows, key)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"canteen-app/internal/adapter/http/common"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory_Allow(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	limiter := NewMemory(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		allowed, _, err := limiter.Allow("192.0.2.1")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+
+	now = now.Add(20 * time.Second)
+	allowed, retryAfter, err := limiter.Allow("192.0.2.1")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 40*time.Second, retryAfter)
+
+	allowed, _, err = limiter.Allow("198.51.100.7")
+	require.NoError(t, err)
+	assert.True(t, allowed, "keys are counted apart")
+
+	now = now.Add(40 * time.Second)
+	allowed, _, err = limiter.Allow("192.0.2.1")
+	require.NoError(t, err)
+	assert.True(t, allowed, "a new window starts")
+}
+
+func TestMemory_Sweep(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	limiter := NewMemory(1, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	_, _, err := limiter.Allow("192.0.2.1")
+	require.NoError(t, err)
+
+	now = now.Add(time.Minute)
+	_, _, err = limiter.Allow("198.51.100.7")
+	require.NoError(t, err)
+	assert.Len(t, limiter.windows, 1)
+}
+
+func TestRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	limited := r.Group("/api/auth", common.RateLimit(NewMemory(1, time.Minute), nil))
+	limited.POST("/login", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+	limited.POST("/register", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	send := func(path, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		req.RemoteAddr = ip + ":1234"
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusNoContent, send("/api/auth/login", "192.0.2.1").Code)
+
+	w := send("/api/auth/login", "192.0.2.1")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "60", w.Header().Get("Retry-After"))
+
+	assert.Equal(t, http.StatusNoContent, send("/api/auth/login", "198.51.100.7").Code, "other clients are not limited")
+	assert.Equal(t, http.StatusNoContent, send("/api/auth/register", "192.0.2.1").Code, "routes are counted apart")
+}
@@ -0,0 +1,262 @@
+// Package migrations applies versioned SQL schema migrations. Every migration
+// is a pair of files named NNNN_name.up.sql and NNNN_name.down.sql; applied
+// versions are recorded in the schema_version table.
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrSchemaBehind     = errors.New("database schema is behind")
+	ErrInvalidMigration = errors.New("invalid migration")
+)
+
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+const createVersionTable = `CREATE TABLE IF NOT EXISTS schema_version (
+    version    INTEGER PRIMARY KEY,
+    name       TEXT      NOT NULL,
+    applied_at TIMESTAMP NOT NULL
+)`
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// State is a migration together with the time it was applied, zero for
+// pending migrations.
+type State struct {
+	Migration
+	AppliedAt time.Time
+}
+
+func (s State) Applied() bool {
+	return !s.AppliedAt.IsZero()
+}
+
+// Load reads the migrations from the root of fsys, ordered by version.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("%w: unexpected file name %s", ErrInvalidMigration, entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("%w: version %d is used by %s and %s", ErrInvalidMigration, version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(data)
+		} else {
+			m.Down = string(data)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Version == 0 || strings.TrimSpace(m.Up) == "" || strings.TrimSpace(m.Down) == "" {
+			return nil, fmt.Errorf("%w: %04d_%s needs a non-zero version and both up and down files", ErrInvalidMigration, m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrator applies migrations to a database. The SQL it runs itself is
+// portable between PostgreSQL and SQLite.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func New(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Status lists the known migrations in order, marking the applied ones.
+func (m *Migrator) Status() ([]State, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]State, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		states = append(states, State{Migration: migration, AppliedAt: applied[migration.Version]})
+	}
+	return states, nil
+}
+
+// Check reports ErrSchemaBehind when some migrations are not applied yet.
+func (m *Migrator) Check() error {
+	states, err := m.Status()
+	if err != nil {
+		return err
+	}
+
+	var pending []string
+	for _, s := range states {
+		if !s.Applied() {
+			pending = append(pending, fmt.Sprintf("%04d_%s", s.Version, s.Name))
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: pending migrations %s", ErrSchemaBehind, strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// Up applies all pending migrations in order and returns them. Every
+// migration runs in its own transaction.
+func (m *Migrator) Up() ([]Migration, error) {
+	states, err := m.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, s := range states {
+		if s.Applied() {
+			continue
+		}
+		err := m.run(s.Up, `INSERT INTO schema_version (version, name, applied_at) VALUES ($1, $2, $3)`,
+			s.Version, s.Name, time.Now().UTC())
+		if err != nil {
+			return done, fmt.Errorf("apply %04d_%s: %w", s.Version, s.Name, err)
+		}
+		done = append(done, s.Migration)
+	}
+	return done, nil
+}
+
+// Down reverts the last n applied migrations, newest first, and returns them.
+func (m *Migrator) Down(n int) ([]Migration, error) {
+	states, err := m.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(states) - 1; i >= 0 && len(done) < n; i-- {
+		s := states[i]
+		if !s.Applied() {
+			continue
+		}
+		if err := m.run(s.Down, `DELETE FROM schema_version WHERE version = $1`, s.Version); err != nil {
+			return done, fmt.Errorf("revert %04d_%s: %w", s.Version, s.Name, err)
+		}
+		done = append(done, s.Migration)
+	}
+	return done, nil
+}
+
+func (m *Migrator) run(script, record string, args ...any) error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(script); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec(record, args...); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) applied() (map[int]time.Time, error) {
+	if _, err := m.db.Exec(createVersionTable); err != nil {
+		return nil, fmt.Errorf("create schema_version: %w", err)
+	}
+
+	rows, err := m.db.Query(`SELECT version, applied_at FROM schema_version`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var (
+			version   int
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// Create writes an empty pair of migration files to dir, numbered after the
+// last migration there, and returns their paths.
+func Create(dir, name string) (string, string, error) {
+	if !fileName.MatchString("0001_" + name + ".up.sql") {
+		return "", "", fmt.Errorf("%w: name must consist of lowercase letters, digits and underscores", ErrInvalidMigration)
+	}
+
+	migrations, err := Load(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+	version := 1
+	if len(migrations) > 0 {
+		version = migrations[len(migrations)-1].Version + 1
+	}
+
+	base := filepath.Join(dir, fmt.Sprintf("%04d_%s", version, name))
+	up, down := base+".up.sql", base+".down.sql"
+	if err := os.WriteFile(up, []byte("-- "+name+"\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(down, []byte("-- revert "+name+"\n"), 0o644); err != nil {
+		os.Remove(up)
+		return "", "", err
+	}
+	return up, down, nil
+}
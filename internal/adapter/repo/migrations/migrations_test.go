@@ -0,0 +1,151 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"canteen-app/internal/adapter/repo/migrations"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func file(s string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(s)}
+}
+
+var testMigrations = fstest.MapFS{
+	"0001_dishes.up.sql":       file(`CREATE TABLE dishes (id INTEGER PRIMARY KEY, name TEXT NOT NULL)`),
+	"0001_dishes.down.sql":     file(`DROP TABLE dishes`),
+	"0002_dish_price.up.sql":   file(`ALTER TABLE dishes ADD COLUMN price INTEGER NOT NULL DEFAULT 0`),
+	"0002_dish_price.down.sql": file(`ALTER TABLE dishes DROP COLUMN price`),
+	"README.md":                file(`not a migration`),
+}
+
+func openDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestLoad(t *testing.T) {
+	list, err := migrations.Load(testMigrations)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, 1, list[0].Version)
+	assert.Equal(t, "dishes", list[0].Name)
+	assert.Equal(t, `DROP TABLE dishes`, list[0].Down)
+	assert.Equal(t, 2, list[1].Version)
+	assert.Equal(t, "dish_price", list[1].Name)
+
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+	}{
+		{"bad file name", fstest.MapFS{"init.sql": file(`SELECT 1`)}},
+		{"missing down", fstest.MapFS{"0001_init.up.sql": file(`SELECT 1`)}},
+		{"empty up", fstest.MapFS{"0001_init.up.sql": file(" \n"), "0001_init.down.sql": file(`SELECT 1`)}},
+		{"zero version", fstest.MapFS{"0000_init.up.sql": file(`SELECT 1`), "0000_init.down.sql": file(`SELECT 1`)}},
+		{"version used twice", fstest.MapFS{
+			"0001_a.up.sql": file(`SELECT 1`), "0001_a.down.sql": file(`SELECT 1`),
+			"0001_b.up.sql": file(`SELECT 1`), "0001_b.down.sql": file(`SELECT 1`),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := migrations.Load(tt.fsys)
+			assert.ErrorIs(t, err, migrations.ErrInvalidMigration)
+		})
+	}
+}
+
+func TestMigrator_UpDown(t *testing.T) {
+	db := openDB(t)
+	migrator, err := migrations.New(db, testMigrations)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, migrator.Check(), migrations.ErrSchemaBehind)
+
+	done, err := migrator.Up()
+	require.NoError(t, err)
+	assert.Len(t, done, 2)
+	require.NoError(t, migrator.Check())
+
+	_, err = db.Exec(`INSERT INTO dishes (name, price) VALUES ('Борщ', 12000)`)
+	require.NoError(t, err)
+
+	done, err = migrator.Up()
+	require.NoError(t, err)
+	assert.Empty(t, done, "nothing left to apply")
+
+	done, err = migrator.Down(1)
+	require.NoError(t, err)
+	require.Len(t, done, 1)
+	assert.Equal(t, 2, done[0].Version)
+
+	states, err := migrator.Status()
+	require.NoError(t, err)
+	require.Len(t, states, 2)
+	assert.True(t, states[0].Applied())
+	assert.False(t, states[1].Applied())
+	assert.ErrorIs(t, migrator.Check(), migrations.ErrSchemaBehind)
+
+	_, err = db.Exec(`INSERT INTO dishes (name, price) VALUES ('Чай', 2000)`)
+	assert.Error(t, err, "price column is reverted")
+
+	done, err = migrator.Down(5)
+	require.NoError(t, err)
+	assert.Len(t, done, 1)
+
+	_, err = db.Exec(`SELECT 1 FROM dishes`)
+	assert.Error(t, err, "dishes table is reverted")
+}
+
+func TestMigrator_FailedMigrationIsRolledBack(t *testing.T) {
+	db := openDB(t)
+	migrator, err := migrations.New(db, fstest.MapFS{
+		"0001_dishes.up.sql":   testMigrations["0001_dishes.up.sql"],
+		"0001_dishes.down.sql": testMigrations["0001_dishes.down.sql"],
+		"0002_broken.up.sql":   file("CREATE TABLE menus (id INTEGER PRIMARY KEY);\nSELECT * FROM missing;"),
+		"0002_broken.down.sql": file(`DROP TABLE menus`),
+	})
+	require.NoError(t, err)
+
+	done, err := migrator.Up()
+	assert.Error(t, err)
+	require.Len(t, done, 1)
+
+	states, err := migrator.Status()
+	require.NoError(t, err)
+	assert.True(t, states[0].Applied())
+	assert.False(t, states[1].Applied())
+
+	_, err = db.Exec(`SELECT 1 FROM menus`)
+	assert.Error(t, err, "partial migration is rolled back")
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	for name, f := range testMigrations {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), f.Data, 0o644))
+	}
+
+	up, down, err := migrations.Create(dir, "add_allergens")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "0003_add_allergens.up.sql"), up)
+	assert.Equal(t, filepath.Join(dir, "0003_add_allergens.down.sql"), down)
+
+	list, err := migrations.Load(os.DirFS(dir))
+	require.NoError(t, err)
+	assert.Len(t, list, 3)
+
+	_, _, err = migrations.Create(dir, "Add Allergens")
+	assert.ErrorIs(t, err, migrations.ErrInvalidMigration)
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"database/sql"
+	"testing"
+
+	"canteen-app/internal/adapter/repo/repotest"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRepo_Contract(t *testing.T) {
+	repotest.UserRepository(t, func(t *testing.T) usecase.UserRepository { return NewUserRepo(openTestDB(t)) })
+}
+
+func TestRefreshRepo_Contract(t *testing.T) {
+	repotest.RefreshTokenRepository(t, func(t *testing.T) usecase.RefreshTokenRepository { return NewRefreshRepo(openTestDBWithUsers(t)) })
+}
+
+func TestSecurityEventRepo_Contract(t *testing.T) {
+	repotest.SecurityEventRepository(t, func(t *testing.T) usecase.SecurityEventRepository {
+		return NewSecurityEventRepo(openTestDBWithUsers(t))
+	})
+}
+
+func TestInvitationRepo_Contract(t *testing.T) {
+	repotest.InvitationRepository(t, func(t *testing.T) usecase.InvitationRepository { return NewInvitationRepo(openTestDBWithUsers(t)) })
+}
+
+func TestPasswordTokenRepo_Contract(t *testing.T) {
+	repotest.PasswordTokenRepository(t, func(t *testing.T) usecase.PasswordTokenRepository {
+		return NewPasswordTokenRepo(openTestDBWithUsers(t))
+	})
+}
+
+// openTestDBWithUsers creates the users 1 and 2 the contracts refer to, which
+// the foreign keys require.
+func openTestDBWithUsers(t *testing.T) *sql.DB {
+	db := openTestDB(t)
+	users := NewUserRepo(db)
+	for _, login := range []string{"first", "second"} {
+		_, err := users.CreateUser(domUser.User{Login: login, PasswordHash: "hash", Role: "student"})
+		require.NoError(t, err)
+	}
+	return db
+}
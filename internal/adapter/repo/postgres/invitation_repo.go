@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+	"canteen-app/internal/usecase"
+)
+
+const invitationColumns = `code, class, created_by, max_uses, uses, created_at, expires_at`
+
+type InvitationRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.InvitationRepository = (*InvitationRepo)(nil)
+
+func NewInvitationRepo(db *sql.DB) *InvitationRepo {
+	return &InvitationRepo{db: db}
+}
+
+func (r *InvitationRepo) CreateInvitation(invitation domAuth.Invitation) error {
+	_, err := r.db.Exec(
+		`INSERT INTO invitations (`+invitationColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		invitation.Code, invitation.Class, invitation.CreatedBy, invitation.MaxUses, invitation.Uses,
+		invitation.CreatedAt, invitation.ExpiresAt,
+	)
+	if isUniqueViolation(err) {
+		return usecase.ErrInvitationExists
+	}
+	return err
+}
+
+func (r *InvitationRepo) ListInvitations() ([]domAuth.Invitation, error) {
+	rows, err := r.db.Query(`SELECT ` + invitationColumns + ` FROM invitations ORDER BY created_at DESC, code`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invitations := make([]domAuth.Invitation, 0)
+	for rows.Next() {
+		invitation, err := scanInvitation(rows)
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, *invitation)
+	}
+	return invitations, rows.Err()
+}
+
+func (r *InvitationRepo) DeleteInvitation(code string) error {
+	res, err := r.db.Exec(`DELETE FROM invitations WHERE code = $1`, code)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return usecase.ErrInvitationNotFound
+	}
+	return nil
+}
+
+func (r *InvitationRepo) UseInvitation(code string, now time.Time) (*domAuth.Invitation, error) {
+	invitation, err := scanInvitation(r.db.QueryRow(
+		`UPDATE invitations SET uses = uses + 1
+		WHERE code = $1 AND uses < max_uses AND expires_at > $2
+		RETURNING `+invitationColumns,
+		code, now,
+	))
+	if errors.Is(err, usecase.ErrInvitationNotFound) {
+		return nil, usecase.ErrInvitationInvalid
+	}
+	return invitation, err
+}
+
+func (r *InvitationRepo) ReleaseInvitation(code string) error {
+	_, err := r.db.Exec(`UPDATE invitations SET uses = uses - 1 WHERE code = $1 AND uses > 0`, code)
+	return err
+}
+
+func scanInvitation(row rowScanner) (*domAuth.Invitation, error) {
+	var invitation domAuth.Invitation
+	err := row.Scan(
+		&invitation.Code, &invitation.Class, &invitation.CreatedBy, &invitation.MaxUses, &invitation.Uses,
+		&invitation.CreatedAt, &invitation.ExpiresAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, usecase.ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
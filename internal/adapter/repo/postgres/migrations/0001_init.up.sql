@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS users (
+    id            BIGSERIAL PRIMARY KEY,
+    login         TEXT NOT NULL,
+    password_hash TEXT NOT NULL,
+    name          TEXT NOT NULL,
+    surname       TEXT NOT NULL,
+    role          TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_login_key ON users (login);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    token_id   TEXT PRIMARY KEY,
+    user_id    BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_user_id_idx ON refresh_tokens (user_id);
@@ -0,0 +1,8 @@
+ALTER TABLE refresh_tokens ADD COLUMN user_agent TEXT NOT NULL DEFAULT '';
+ALTER TABLE refresh_tokens ADD COLUMN ip TEXT NOT NULL DEFAULT '';
+
+-- When existing sessions started is unknown; count them from now.
+ALTER TABLE refresh_tokens ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE refresh_tokens ADD COLUMN last_used_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE refresh_tokens ALTER COLUMN created_at DROP DEFAULT;
+ALTER TABLE refresh_tokens ALTER COLUMN last_used_at DROP DEFAULT;
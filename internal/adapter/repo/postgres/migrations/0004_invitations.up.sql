@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS invitations (
+    code       TEXT        PRIMARY KEY,
+    class      TEXT        NOT NULL,
+    created_by BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    max_uses   INTEGER     NOT NULL,
+    uses       INTEGER     NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS password_tokens (
+    token_hash TEXT        PRIMARY KEY,
+    user_id    BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS password_tokens_user_id_idx ON password_tokens (user_id);
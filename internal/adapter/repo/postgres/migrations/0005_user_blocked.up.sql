@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN blocked BOOLEAN NOT NULL DEFAULT FALSE;
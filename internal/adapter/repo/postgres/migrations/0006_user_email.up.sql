@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN email TEXT NOT NULL DEFAULT '';
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_key ON users (email) WHERE email <> '';
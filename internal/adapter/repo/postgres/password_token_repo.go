@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+	"canteen-app/internal/usecase"
+)
+
+type PasswordTokenRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.PasswordTokenRepository = (*PasswordTokenRepo)(nil)
+
+func NewPasswordTokenRepo(db *sql.DB) *PasswordTokenRepo {
+	return &PasswordTokenRepo{db: db}
+}
+
+func (r *PasswordTokenRepo) SavePasswordToken(token domAuth.PasswordToken) error {
+	_, err := r.db.Exec(
+		`INSERT INTO password_tokens (token_hash, user_id, expires_at) VALUES ($1, $2, $3)
+		ON CONFLICT (token_hash) DO UPDATE SET user_id = excluded.user_id, expires_at = excluded.expires_at`,
+		token.Hash, token.UserID, token.ExpiresAt,
+	)
+	return err
+}
+
+func (r *PasswordTokenRepo) TakePasswordToken(hash string, now time.Time) (*domAuth.PasswordToken, error) {
+	var token domAuth.PasswordToken
+	err := r.db.QueryRow(
+		`DELETE FROM password_tokens WHERE token_hash = $1 RETURNING token_hash, user_id, expires_at`, hash,
+	).Scan(&token.Hash, &token.UserID, &token.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, usecase.ErrPasswordTokenInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !now.Before(token.ExpiresAt) {
+		return nil, usecase.ErrPasswordTokenInvalid
+	}
+	return &token, nil
+}
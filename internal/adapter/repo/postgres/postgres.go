@@ -0,0 +1,43 @@
+// Package postgres implements the repositories on top of PostgreSQL.
+package postgres
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+
+	"canteen-app/internal/adapter/repo/migrations"
+
+	_ "github.com/lib/pq"
+)
+
+// MigrationsDir is where the migrations live in the source tree; new ones
+// are created there.
+const MigrationsDir = "internal/adapter/repo/postgres/migrations"
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Open connects to the database. The schema is managed by the migrator.
+func Open(dsn string) (*sql.DB, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open postgres: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("connect to postgres: %w", err)
+	}
+
+	return db, nil
+}
+
+func NewMigrator(db *sql.DB) (*migrations.Migrator, error) {
+	fsys, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	return migrations.New(db, fsys)
+}
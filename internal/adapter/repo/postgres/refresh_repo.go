@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+const refreshColumns = `token_id, user_id, family_id, user_agent, ip, created_at, last_used_at, expires_at, rotated_at`
+
+type RefreshRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.RefreshTokenRepository = (*RefreshRepo)(nil)
+
+func NewRefreshRepo(db *sql.DB) *RefreshRepo {
+	return &RefreshRepo{db: db}
+}
+
+func (r *RefreshRepo) Save(token domAuth.RefreshToken) error {
+	return saveRefresh(r.db, token)
+}
+
+func (r *RefreshRepo) Get(tokenID string) (*domAuth.RefreshToken, error) {
+	token, err := scanRefresh(r.db.QueryRow(
+		`SELECT `+refreshColumns+` FROM refresh_tokens WHERE token_id = $1 AND expires_at > now()`,
+		tokenID,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, usecase.ErrRefreshNotFound
+	}
+	return token, err
+}
+
+// Rotate marks the token with a conditional update, so of concurrent
+// rotations only one succeeds.
+func (r *RefreshRepo) Rotate(tokenID string, next domAuth.RefreshToken) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(
+		`UPDATE refresh_tokens SET rotated_at = now()
+		WHERE token_id = $1 AND rotated_at IS NULL AND expires_at > now()`,
+		tokenID,
+	)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return whyNotRotated(tx, tokenID)
+	}
+
+	if err := saveRefresh(tx, next); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// whyNotRotated tells a rotated token from a missing or expired one.
+func whyNotRotated(tx *sql.Tx, tokenID string) error {
+	var exists bool
+	err := tx.QueryRow(
+		`SELECT EXISTS (SELECT 1 FROM refresh_tokens WHERE token_id = $1 AND expires_at > now())`,
+		tokenID,
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return usecase.ErrRefreshNotFound
+	}
+	return usecase.ErrRefreshRotated
+}
+
+func (r *RefreshRepo) ListActive(userID domUser.UserID, now time.Time) ([]domAuth.RefreshToken, error) {
+	rows, err := r.db.Query(
+		`SELECT `+refreshColumns+` FROM refresh_tokens
+		WHERE user_id = $1 AND rotated_at IS NULL AND expires_at > $2
+		ORDER BY created_at, family_id`,
+		userID, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]domAuth.RefreshToken, 0)
+	for rows.Next() {
+		token, err := scanRefresh(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, *token)
+	}
+	return tokens, rows.Err()
+}
+
+func (r *RefreshRepo) DeleteFamily(familyID string) error {
+	_, err := r.db.Exec(`DELETE FROM refresh_tokens WHERE family_id = $1`, familyID)
+	return err
+}
+
+func (r *RefreshRepo) DeleteByUser(userID domUser.UserID) error {
+	_, err := r.db.Exec(`DELETE FROM refresh_tokens WHERE user_id = $1`, userID)
+	return err
+}
+
+func (r *RefreshRepo) DeleteExpired(now time.Time) (int, error) {
+	res, err := r.db.Exec(`DELETE FROM refresh_tokens WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func (r *RefreshRepo) CountActive(now time.Time) (int, error) {
+	var n int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM refresh_tokens WHERE expires_at > $1 AND rotated_at IS NULL`, now).Scan(&n)
+	return n, err
+}
+
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func saveRefresh(db execer, token domAuth.RefreshToken) error {
+	var rotatedAt sql.NullTime
+	if token.Rotated() {
+		rotatedAt = sql.NullTime{Time: token.RotatedAt, Valid: true}
+	}
+
+	_, err := db.Exec(
+		`INSERT INTO refresh_tokens (`+refreshColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (token_id) DO UPDATE SET user_id = EXCLUDED.user_id, family_id = EXCLUDED.family_id,
+			user_agent = EXCLUDED.user_agent, ip = EXCLUDED.ip, created_at = EXCLUDED.created_at,
+			last_used_at = EXCLUDED.last_used_at, expires_at = EXCLUDED.expires_at, rotated_at = EXCLUDED.rotated_at`,
+		token.ID, token.UserID, token.FamilyID, token.Client.UserAgent, token.Client.IP,
+		token.CreatedAt, token.LastUsedAt, token.ExpiresAt, rotatedAt,
+	)
+	return err
+}
+
+func scanRefresh(row rowScanner) (*domAuth.RefreshToken, error) {
+	var (
+		token     domAuth.RefreshToken
+		rotatedAt sql.NullTime
+	)
+	err := row.Scan(
+		&token.ID, &token.UserID, &token.FamilyID, &token.Client.UserAgent, &token.Client.IP,
+		&token.CreatedAt, &token.LastUsedAt, &token.ExpiresAt, &rotatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	token.RotatedAt = rotatedAt.Time
+	return &token, nil
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshRepo(t *testing.T) {
+	db := openTestDB(t)
+	users := NewUserRepo(db)
+	repo := NewRefreshRepo(db)
+
+	userID, err := users.CreateUser(domUser.User{Login: "slim", PasswordHash: "hash", Role: "student"})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Save(domAuth.RefreshToken{ID: "token-1", UserID: userID, FamilyID: "token-1", ExpiresAt: time.Now().Add(time.Hour)}))
+
+	got, err := repo.Get("token-1")
+	require.NoError(t, err)
+	assert.Equal(t, userID, got.UserID)
+
+	require.NoError(t, repo.Rotate("token-1", domAuth.RefreshToken{ID: "token-2", UserID: userID, FamilyID: "token-1", ExpiresAt: time.Now().Add(time.Hour)}))
+
+	got, err = repo.Get("token-1")
+	require.NoError(t, err)
+	assert.True(t, got.Rotated())
+
+	// Tokens are removed with their user.
+	_, err = db.Exec(`DELETE FROM users WHERE id = $1`, userID)
+	require.NoError(t, err)
+
+	_, err = repo.Get("token-2")
+	assert.ErrorIs(t, err, usecase.ErrRefreshNotFound)
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"database/sql"
+
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+type SecurityEventRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.SecurityEventRepository = (*SecurityEventRepo)(nil)
+
+func NewSecurityEventRepo(db *sql.DB) *SecurityEventRepo {
+	return &SecurityEventRepo{db: db}
+}
+
+func (r *SecurityEventRepo) RecordEvent(event domAuth.SecurityEvent) (domAuth.SecurityEventID, error) {
+	var id domAuth.SecurityEventID
+	err := r.db.QueryRow(
+		`INSERT INTO security_events (user_id, kind, details, created_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id`,
+		event.UserID, event.Kind, event.Details, event.CreatedAt,
+	).Scan(&id)
+	return id, err
+}
+
+func (r *SecurityEventRepo) ListEvents(userID domUser.UserID) ([]domAuth.SecurityEvent, error) {
+	rows, err := r.db.Query(
+		`SELECT id, user_id, kind, details, created_at FROM security_events WHERE user_id = $1 ORDER BY id`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]domAuth.SecurityEvent, 0)
+	for rows.Next() {
+		var event domAuth.SecurityEvent
+		if err := rows.Scan(&event.ID, &event.UserID, &event.Kind, &event.Details, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
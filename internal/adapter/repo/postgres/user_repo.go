@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/lib/pq"
+	"github.com/lib/pq/pqerror"
+)
+
+type UserRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.UserRepository = (*UserRepo)(nil)
+
+func NewUserRepo(db *sql.DB) *UserRepo {
+	return &UserRepo{db: db}
+}
+
+const userColumns = `id, login, password_hash, name, surname, role, email, blocked`
+
+func scanUser(row rowScanner) (domUser.User, error) {
+	var user domUser.User
+	err := row.Scan(&user.ID, &user.Login, &user.PasswordHash, &user.Name, &user.Surname, &user.Role, &user.Email, &user.Blocked)
+	return user, err
+}
+
+func (r *UserRepo) CreateUser(user domUser.User) (domUser.UserID, error) {
+	var id domUser.UserID
+	err := r.db.QueryRow(
+		`INSERT INTO users (login, password_hash, name, surname, role, email, blocked)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`,
+		user.Login, user.PasswordHash, user.Name, user.Surname, user.Role, user.Email, user.Blocked,
+	).Scan(&id)
+	if isUniqueViolation(err) {
+		return 0, takenColumn(err)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *UserRepo) GetUserByID(id domUser.UserID) (*domUser.User, error) {
+	return r.getUser(`SELECT `+userColumns+` FROM users WHERE id = $1`, id)
+}
+
+func (r *UserRepo) GetUserByLogin(login string) (*domUser.User, error) {
+	return r.getUser(`SELECT `+userColumns+` FROM users WHERE login = $1`, login)
+}
+
+func (r *UserRepo) GetUserByEmail(email string) (*domUser.User, error) {
+	return r.getUser(`SELECT `+userColumns+` FROM users WHERE email = $1 AND email <> ''`, email)
+}
+
+func (r *UserRepo) getUser(query string, arg any) (*domUser.User, error) {
+	user, err := scanUser(r.db.QueryRow(query, arg))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domUser.User{}, usecase.ErrUserNotFound
+	}
+	if err != nil {
+		return &domUser.User{}, err
+	}
+	return &user, nil
+}
+
+func (r *UserRepo) SetPasswordHash(id domUser.UserID, hash string) error {
+	res, err := r.db.Exec(`UPDATE users SET password_hash = $1 WHERE id = $2`, hash, id)
+	return userAffected(res, err)
+}
+
+func (r *UserRepo) ListUsers(query domUser.ListQuery) ([]domUser.User, int, error) {
+	where := ` WHERE 1 = 1`
+	var args []any
+	if query.Role != "" {
+		args = append(args, query.Role)
+		where += fmt.Sprintf(` AND role = $%d`, len(args))
+	}
+	if query.Search != "" {
+		args = append(args, likePattern(query.Search))
+		n := len(args)
+		where += fmt.Sprintf(` AND (login ILIKE $%d OR name ILIKE $%d OR surname ILIKE $%d)`, n, n, n)
+	}
+
+	var total int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM users`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// LIMIT NULL is no limit.
+	var limit *int
+	if query.Limit > 0 {
+		limit = &query.Limit
+	}
+	args = append(args, limit, max(query.Offset, 0))
+	rows, err := r.db.Query(
+		`SELECT `+userColumns+` FROM users`+where+fmt.Sprintf(` ORDER BY id LIMIT $%d OFFSET $%d`, len(args)-1, len(args)),
+		args...,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	users := make([]domUser.User, 0)
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+func (r *UserRepo) UpdateUser(user domUser.User) error {
+	res, err := r.db.Exec(
+		`UPDATE users SET name = $1, surname = $2, role = $3, blocked = $4 WHERE id = $5`,
+		user.Name, user.Surname, user.Role, user.Blocked, user.ID,
+	)
+	return userAffected(res, err)
+}
+
+// DeleteUser also removes the refresh tokens, invitations and set-password
+// tokens of the user, which reference it with ON DELETE CASCADE.
+func (r *UserRepo) DeleteUser(id domUser.UserID) error {
+	res, err := r.db.Exec(`DELETE FROM users WHERE id = $1`, id)
+	return userAffected(res, err)
+}
+
+// userAffected maps an update of no rows to ErrUserNotFound.
+func userAffected(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return usecase.ErrUserNotFound
+	}
+	return nil
+}
+
+// likePattern matches values containing s; ILIKE escapes wildcards with a
+// backslash by default.
+func likePattern(s string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s) + "%"
+}
+
+// takenColumn tells a taken email from a taken login by the violated index.
+func takenColumn(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Constraint == "users_email_key" {
+		return usecase.ErrEmailInUse
+	}
+	return usecase.ErrLoginInUse
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pqerror.UniqueViolation
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"testing"
+
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRepo(t *testing.T) {
+	repo := NewUserRepo(openTestDB(t))
+
+	user := domUser.User{Login: "slim", PasswordHash: "hash", Name: "Slim", Surname: "Shady", Role: "student"}
+
+	firstID, err := repo.CreateUser(user)
+	require.NoError(t, err)
+
+	_, err = repo.CreateUser(user)
+	assert.ErrorIs(t, err, usecase.ErrLoginInUse)
+
+	user.Login = "marshall"
+	secondID, err := repo.CreateUser(user)
+	require.NoError(t, err)
+	assert.Greater(t, secondID, firstID)
+
+	got, err := repo.GetUserByID(firstID)
+	require.NoError(t, err)
+	assert.Equal(t, "slim", got.Login)
+	assert.Equal(t, "hash", got.PasswordHash)
+	assert.Equal(t, "student", got.Role)
+
+	got, err = repo.GetUserByLogin("marshall")
+	require.NoError(t, err)
+	assert.Equal(t, secondID, got.ID)
+
+	_, err = repo.GetUserByID(secondID + 100)
+	assert.ErrorIs(t, err, usecase.ErrUserNotFound)
+
+	_, err = repo.GetUserByLogin("nobody")
+	assert.ErrorIs(t, err, usecase.ErrUserNotFound)
+}
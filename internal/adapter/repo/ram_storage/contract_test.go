@@ -0,0 +1,73 @@
+package ram_storage_test
+
+import (
+	"testing"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	"canteen-app/internal/adapter/repo/repotest"
+	"canteen-app/internal/usecase"
+)
+
+func TestUserRepo(t *testing.T) {
+	repotest.UserRepository(t, func(t *testing.T) usecase.UserRepository { return ram_storage.NewUserRepo() })
+}
+
+func TestRefreshRepo(t *testing.T) {
+	repotest.RefreshTokenRepository(t, func(t *testing.T) usecase.RefreshTokenRepository { return ram_storage.NewRefreshRepo() })
+}
+
+func TestSecurityEventRepo(t *testing.T) {
+	repotest.SecurityEventRepository(t, func(t *testing.T) usecase.SecurityEventRepository { return ram_storage.NewSecurityEventRepo() })
+}
+
+func TestLoginAttemptRepo(t *testing.T) {
+	repotest.LoginAttemptRepository(t, func(t *testing.T) usecase.LoginAttemptRepository { return ram_storage.NewLoginAttemptRepo() })
+}
+
+func TestInvitationRepo(t *testing.T) {
+	repotest.InvitationRepository(t, func(t *testing.T) usecase.InvitationRepository { return ram_storage.NewInvitationRepo() })
+}
+
+func TestPasswordTokenRepo(t *testing.T) {
+	repotest.PasswordTokenRepository(t, func(t *testing.T) usecase.PasswordTokenRepository { return ram_storage.NewPasswordTokenRepo() })
+}
+
+func TestDietaryProfileRepo(t *testing.T) {
+	repotest.DietaryProfileRepository(t, func(t *testing.T) usecase.DietaryProfileRepository { return ram_storage.NewDietaryProfileRepo() })
+}
+
+func TestMenuRepo(t *testing.T) {
+	repotest.MenuRepository(t, func(t *testing.T) usecase.MenuRepository { return ram_storage.NewMenuRepo() })
+}
+
+func TestOrderRepo(t *testing.T) {
+	repotest.OrderRepository(t, func(t *testing.T) usecase.OrderRepository { return ram_storage.NewOrderRepo() })
+}
+
+func TestWalletRepo(t *testing.T) {
+	repotest.WalletRepository(t, func(t *testing.T) usecase.WalletRepository { return ram_storage.NewWalletRepo() })
+}
+
+func TestPaymentRepo(t *testing.T) {
+	repotest.PaymentRepository(t, func(t *testing.T) usecase.PaymentRepository { return ram_storage.NewPaymentRepo() })
+}
+
+func TestSubscriptionRepo(t *testing.T) {
+	repotest.SubscriptionRepository(t, func(t *testing.T) usecase.SubscriptionRepository { return ram_storage.NewSubscriptionRepo() })
+}
+
+func TestReviewRepo(t *testing.T) {
+	repotest.ReviewRepository(t, func(t *testing.T) usecase.ReviewRepository { return ram_storage.NewReviewRepo() })
+}
+
+func TestInventoryRepo(t *testing.T) {
+	repotest.InventoryRepository(t, func(t *testing.T) usecase.InventoryRepository { return ram_storage.NewInventoryRepo() })
+}
+
+func TestProcurementRepo(t *testing.T) {
+	repotest.ProcurementRepository(t, func(t *testing.T) usecase.ProcurementRepository { return ram_storage.NewProcurementRepo() })
+}
+
+func TestRecipeRepo(t *testing.T) {
+	repotest.RecipeRepository(t, func(t *testing.T) usecase.RecipeRepository { return ram_storage.NewRecipeRepo() })
+}
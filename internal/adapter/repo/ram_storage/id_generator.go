@@ -0,0 +1,24 @@
+package ram_storage
+
+import (
+	"sync/atomic"
+
+	domUser "canteen-app/internal/domain/user"
+)
+
+// UserIDGenerator hands out the IDs of new users. It must be safe for
+// concurrent use.
+type UserIDGenerator interface {
+	NextUserID() (domUser.UserID, error)
+}
+
+// Sequence is a monotonic UserIDGenerator starting at 1.
+type Sequence struct {
+	last atomic.Int64
+}
+
+var _ UserIDGenerator = (*Sequence)(nil)
+
+func (s *Sequence) NextUserID() (domUser.UserID, error) {
+	return domUser.UserID(s.last.Add(1)), nil
+}
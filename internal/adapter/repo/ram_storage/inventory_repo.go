@@ -0,0 +1,125 @@
+package ram_storage
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	domInventory "canteen-app/internal/domain/inventory"
+	"canteen-app/internal/usecase"
+)
+
+type InventoryRepo struct {
+	mu             sync.RWMutex
+	products       map[domInventory.ProductID]domInventory.Product
+	movements      []domInventory.Movement
+	nextProductID  domInventory.ProductID
+	nextMovementID domInventory.MovementID
+}
+
+var _ usecase.InventoryRepository = (*InventoryRepo)(nil)
+
+func NewInventoryRepo() *InventoryRepo {
+	return &InventoryRepo{
+		products: make(map[domInventory.ProductID]domInventory.Product),
+	}
+}
+
+func (r *InventoryRepo) CreateProduct(product domInventory.Product) (domInventory.ProductID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.nameTaken(product.Name, 0) {
+		return 0, usecase.ErrProductExists
+	}
+
+	r.nextProductID++
+	product.ID = r.nextProductID
+	r.products[product.ID] = product
+	return product.ID, nil
+}
+
+func (r *InventoryRepo) UpdateProduct(product domInventory.Product) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.products[product.ID]
+	if !ok {
+		return usecase.ErrProductNotFound
+	}
+	if r.nameTaken(product.Name, product.ID) {
+		return usecase.ErrProductExists
+	}
+
+	stored.Name = product.Name
+	stored.Unit = product.Unit
+	stored.MinQuantity = product.MinQuantity
+	r.products[product.ID] = stored
+	return nil
+}
+
+func (r *InventoryRepo) GetProductByID(id domInventory.ProductID) (*domInventory.Product, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	product, ok := r.products[id]
+	if !ok {
+		return &domInventory.Product{}, usecase.ErrProductNotFound
+	}
+	return &product, nil
+}
+
+func (r *InventoryRepo) ListProducts() ([]domInventory.Product, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	products := make([]domInventory.Product, 0, len(r.products))
+	for _, product := range r.products {
+		products = append(products, product)
+	}
+	sort.Slice(products, func(i, j int) bool { return products[i].ID < products[j].ID })
+	return products, nil
+}
+
+func (r *InventoryRepo) ApplyMovement(movement domInventory.Movement) (domInventory.MovementID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	product, ok := r.products[movement.ProductID]
+	if !ok {
+		return 0, usecase.ErrProductNotFound
+	}
+	if product.Quantity+movement.Quantity < 0 {
+		return 0, usecase.ErrInsufficientStock
+	}
+
+	product.Quantity += movement.Quantity
+	r.products[product.ID] = product
+
+	r.nextMovementID++
+	movement.ID = r.nextMovementID
+	r.movements = append(r.movements, movement)
+	return movement.ID, nil
+}
+
+func (r *InventoryRepo) ListMovements(productID domInventory.ProductID) ([]domInventory.Movement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	movements := make([]domInventory.Movement, 0)
+	for _, movement := range r.movements {
+		if movement.ProductID == productID {
+			movements = append(movements, movement)
+		}
+	}
+	return movements, nil
+}
+
+func (r *InventoryRepo) nameTaken(name string, except domInventory.ProductID) bool {
+	for _, product := range r.products {
+		if product.ID != except && strings.EqualFold(product.Name, name) {
+			return true
+		}
+	}
+	return false
+}
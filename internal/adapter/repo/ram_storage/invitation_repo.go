@@ -0,0 +1,84 @@
+package ram_storage
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+	"canteen-app/internal/usecase"
+)
+
+type InvitationRepo struct {
+	mu          sync.Mutex
+	invitations map[string]domAuth.Invitation
+}
+
+var _ usecase.InvitationRepository = (*InvitationRepo)(nil)
+
+func NewInvitationRepo() *InvitationRepo {
+	return &InvitationRepo{invitations: make(map[string]domAuth.Invitation)}
+}
+
+func (r *InvitationRepo) CreateInvitation(invitation domAuth.Invitation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.invitations[invitation.Code]; ok {
+		return usecase.ErrInvitationExists
+	}
+	r.invitations[invitation.Code] = invitation
+	return nil
+}
+
+func (r *InvitationRepo) ListInvitations() ([]domAuth.Invitation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	invitations := make([]domAuth.Invitation, 0, len(r.invitations))
+	for _, invitation := range r.invitations {
+		invitations = append(invitations, invitation)
+	}
+	sort.Slice(invitations, func(i, j int) bool {
+		if !invitations[i].CreatedAt.Equal(invitations[j].CreatedAt) {
+			return invitations[i].CreatedAt.After(invitations[j].CreatedAt)
+		}
+		return invitations[i].Code < invitations[j].Code
+	})
+	return invitations, nil
+}
+
+func (r *InvitationRepo) DeleteInvitation(code string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.invitations[code]; !ok {
+		return usecase.ErrInvitationNotFound
+	}
+	delete(r.invitations, code)
+	return nil
+}
+
+func (r *InvitationRepo) UseInvitation(code string, now time.Time) (*domAuth.Invitation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	invitation, ok := r.invitations[code]
+	if !ok || !invitation.Usable(now) {
+		return nil, usecase.ErrInvitationInvalid
+	}
+	invitation.Uses++
+	r.invitations[code] = invitation
+	return &invitation, nil
+}
+
+func (r *InvitationRepo) ReleaseInvitation(code string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if invitation, ok := r.invitations[code]; ok && invitation.Uses > 0 {
+		invitation.Uses--
+		r.invitations[code] = invitation
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package ram_storage
+
+import (
+	"sync"
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+	"canteen-app/internal/usecase"
+)
+
+type loginAttempts struct {
+	domAuth.Attempts
+	// forgetAt is when the failures are past their window.
+	forgetAt time.Time
+}
+
+// LoginAttemptRepo keeps failed sign-ins in memory, so a restart forgets
+// them. Attempts past their window are swept on writes, which keeps logins
+// guessed once from piling up.
+type LoginAttemptRepo struct {
+	mu        sync.Mutex
+	attempts  map[string]loginAttempts
+	nextSweep time.Time
+}
+
+var _ usecase.LoginAttemptRepository = (*LoginAttemptRepo)(nil)
+
+func NewLoginAttemptRepo() *LoginAttemptRepo {
+	return &LoginAttemptRepo{attempts: make(map[string]loginAttempts)}
+}
+
+func (r *LoginAttemptRepo) GetAttempts(key string) (domAuth.Attempts, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.attempts[key].Attempts, nil
+}
+
+func (r *LoginAttemptRepo) RecordFailure(key string, now time.Time, window time.Duration) (domAuth.Attempts, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sweep(now, window)
+
+	stored, ok := r.attempts[key]
+	if !ok || now.After(stored.forgetAt) {
+		stored = loginAttempts{Attempts: domAuth.Attempts{LockedUntil: stored.LockedUntil}}
+	}
+	stored.Failures++
+	stored.LastFailure = now
+	stored.forgetAt = now.Add(window)
+	r.attempts[key] = stored
+	return stored.Attempts, nil
+}
+
+func (r *LoginAttemptRepo) Lock(key string, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := r.attempts[key]
+	stored.LockedUntil = until
+	r.attempts[key] = stored
+	return nil
+}
+
+func (r *LoginAttemptRepo) ResetAttempts(key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.attempts, key)
+	return nil
+}
+
+// sweep drops the attempts that no longer matter, at most once per window.
+func (r *LoginAttemptRepo) sweep(now time.Time, window time.Duration) {
+	if now.Before(r.nextSweep) {
+		return
+	}
+	r.nextSweep = now.Add(window)
+
+	for key, stored := range r.attempts {
+		if now.After(stored.forgetAt) && !stored.LockedUntil.After(now) {
+			delete(r.attempts, key)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package ram_storage
+
+import (
+	"maps"
+	"sort"
+	"sync"
+	"time"
+
+	domMenu "canteen-app/internal/domain/menu"
+	"canteen-app/internal/usecase"
+)
+
+type menuRecord struct {
+	ID       domMenu.MenuID
+	Date     time.Time
+	MealType domMenu.MealType
+	DishIDs  []domMenu.DishID
+	Portions map[domMenu.DishID]int
+}
+
+type MenuRepo struct {
+	mu         sync.RWMutex
+	dishes     map[domMenu.DishID]domMenu.Dish
+	menus      map[domMenu.MenuID]menuRecord
+	nextDishID domMenu.DishID
+	nextMenuID domMenu.MenuID
+}
+
+var _ usecase.MenuRepository = (*MenuRepo)(nil)
+
+func NewMenuRepo() *MenuRepo {
+	return &MenuRepo{
+		dishes: make(map[domMenu.DishID]domMenu.Dish),
+		menus:  make(map[domMenu.MenuID]menuRecord),
+	}
+}
+
+func (r *MenuRepo) CreateDish(dish domMenu.Dish) (domMenu.DishID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextDishID++
+	dish.ID = r.nextDishID
+	r.dishes[dish.ID] = dish
+	return dish.ID, nil
+}
+
+func (r *MenuRepo) UpdateDish(dish domMenu.Dish) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.dishes[dish.ID]; !ok {
+		return usecase.ErrDishNotFound
+	}
+	r.dishes[dish.ID] = dish
+	return nil
+}
+
+func (r *MenuRepo) GetDishByID(id domMenu.DishID) (*domMenu.Dish, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dish, ok := r.dishes[id]
+	if !ok {
+		return &domMenu.Dish{}, usecase.ErrDishNotFound
+	}
+	return &dish, nil
+}
+
+func (r *MenuRepo) ListDishes() ([]domMenu.Dish, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dishes := make([]domMenu.Dish, 0, len(r.dishes))
+	for _, dish := range r.dishes {
+		dishes = append(dishes, dish)
+	}
+	sort.Slice(dishes, func(i, j int) bool { return dishes[i].ID < dishes[j].ID })
+	return dishes, nil
+}
+
+func (r *MenuRepo) CreateMenu(menu domMenu.Menu) (domMenu.MenuID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, rec := range r.menus {
+		if rec.Date.Equal(menu.Date) && rec.MealType == menu.MealType {
+			return 0, usecase.ErrMenuExists
+		}
+	}
+
+	r.nextMenuID++
+	menu.ID = r.nextMenuID
+	r.menus[menu.ID] = toMenuRecord(menu)
+	return menu.ID, nil
+}
+
+func (r *MenuRepo) UpdateMenu(menu domMenu.Menu) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.menus[menu.ID]
+	if !ok {
+		return usecase.ErrMenuNotFound
+	}
+
+	updated := toMenuRecord(menu)
+	rec.DishIDs = updated.DishIDs
+	rec.Portions = updated.Portions
+	r.menus[menu.ID] = rec
+	return nil
+}
+
+func (r *MenuRepo) GetMenuByID(id domMenu.MenuID) (*domMenu.Menu, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rec, ok := r.menus[id]
+	if !ok {
+		return &domMenu.Menu{}, usecase.ErrMenuNotFound
+	}
+
+	menu := r.fromMenuRecord(rec)
+	return &menu, nil
+}
+
+func (r *MenuRepo) GetMenusByDate(date time.Time) ([]domMenu.Menu, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	menus := make([]domMenu.Menu, 0, 2)
+	for _, rec := range r.menus {
+		if rec.Date.Equal(date) {
+			menus = append(menus, r.fromMenuRecord(rec))
+		}
+	}
+	sort.Slice(menus, func(i, j int) bool { return menus[i].ID < menus[j].ID })
+	return menus, nil
+}
+
+func toMenuRecord(menu domMenu.Menu) menuRecord {
+	ids := make([]domMenu.DishID, 0, len(menu.Dishes))
+	portions := make(map[domMenu.DishID]int)
+	for _, dish := range menu.Dishes {
+		ids = append(ids, dish.ID)
+		if n := menu.Portions[dish.ID]; n > 0 {
+			portions[dish.ID] = n
+		}
+	}
+	return menuRecord{ID: menu.ID, Date: menu.Date, MealType: menu.MealType, DishIDs: ids, Portions: portions}
+}
+
+func (r *MenuRepo) fromMenuRecord(rec menuRecord) domMenu.Menu {
+	dishes := make([]domMenu.Dish, 0, len(rec.DishIDs))
+	for _, id := range rec.DishIDs {
+		if dish, ok := r.dishes[id]; ok {
+			dishes = append(dishes, dish)
+		}
+	}
+	return domMenu.Menu{ID: rec.ID, Date: rec.Date, MealType: rec.MealType, Dishes: dishes, Portions: maps.Clone(rec.Portions)}
+}
@@ -0,0 +1,115 @@
+package ram_storage
+
+import (
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	domOrder "canteen-app/internal/domain/order"
+	domSubscription "canteen-app/internal/domain/subscription"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+type OrderRepo struct {
+	mu     sync.RWMutex
+	orders map[domOrder.OrderID]domOrder.Order
+	nextID domOrder.OrderID
+}
+
+var _ usecase.OrderRepository = (*OrderRepo)(nil)
+
+func NewOrderRepo() *OrderRepo {
+	return &OrderRepo{
+		orders: make(map[domOrder.OrderID]domOrder.Order),
+	}
+}
+
+func (r *OrderRepo) CreateOrder(order domOrder.Order) (domOrder.OrderID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	order.ID = r.nextID
+	order.Items = slices.Clone(order.Items)
+	order.ConfirmedAllergens = slices.Clone(order.ConfirmedAllergens)
+	r.orders[order.ID] = order
+	return order.ID, nil
+}
+
+func (r *OrderRepo) GetOrderByID(id domOrder.OrderID) (*domOrder.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	order, ok := r.orders[id]
+	if !ok {
+		return &domOrder.Order{}, usecase.ErrOrderNotFound
+	}
+	order.Items = slices.Clone(order.Items)
+	order.ConfirmedAllergens = slices.Clone(order.ConfirmedAllergens)
+	return &order, nil
+}
+
+func (r *OrderRepo) ListOrdersByStudent(studentID domUser.UserID) ([]domOrder.Order, error) {
+	return r.list(func(o domOrder.Order) bool { return o.StudentID == studentID }), nil
+}
+
+func (r *OrderRepo) ListOrdersByDate(date time.Time, statuses ...domOrder.Status) ([]domOrder.Order, error) {
+	return r.list(func(o domOrder.Order) bool {
+		return o.Date.Equal(date) && (len(statuses) == 0 || slices.Contains(statuses, o.Status))
+	}), nil
+}
+
+func (r *OrderRepo) UpdateStatus(id domOrder.OrderID, from, to domOrder.Status) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order, ok := r.orders[id]
+	if !ok {
+		return usecase.ErrOrderNotFound
+	}
+	if order.Status != from {
+		return usecase.ErrOrderStatus
+	}
+
+	order.Status = to
+	order.UpdatedAt = time.Now()
+	r.orders[id] = order
+	return nil
+}
+
+func (r *OrderRepo) MarkPaidBySubscription(id domOrder.OrderID, subID domSubscription.SubscriptionID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order, ok := r.orders[id]
+	if !ok {
+		return usecase.ErrOrderNotFound
+	}
+	if order.Status != domOrder.Placed {
+		return usecase.ErrOrderStatus
+	}
+
+	order.Status = domOrder.Paid
+	order.SubscriptionID = subID
+	order.UpdatedAt = time.Now()
+	r.orders[id] = order
+	return nil
+}
+
+func (r *OrderRepo) list(match func(domOrder.Order) bool) []domOrder.Order {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	orders := make([]domOrder.Order, 0)
+	for _, order := range r.orders {
+		if match(order) {
+			order.Items = slices.Clone(order.Items)
+			order.ConfirmedAllergens = slices.Clone(order.ConfirmedAllergens)
+			orders = append(orders, order)
+		}
+	}
+	sort.Slice(orders, func(i, j int) bool { return orders[i].ID < orders[j].ID })
+	return orders
+}
@@ -0,0 +1,43 @@
+package ram_storage
+
+import (
+	"sync"
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+	"canteen-app/internal/usecase"
+)
+
+type PasswordTokenRepo struct {
+	mu     sync.Mutex
+	tokens map[string]domAuth.PasswordToken
+}
+
+var _ usecase.PasswordTokenRepository = (*PasswordTokenRepo)(nil)
+
+func NewPasswordTokenRepo() *PasswordTokenRepo {
+	return &PasswordTokenRepo{tokens: make(map[string]domAuth.PasswordToken)}
+}
+
+func (r *PasswordTokenRepo) SavePasswordToken(token domAuth.PasswordToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens[token.Hash] = token
+	return nil
+}
+
+func (r *PasswordTokenRepo) TakePasswordToken(hash string, now time.Time) (*domAuth.PasswordToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.tokens[hash]
+	if !ok {
+		return nil, usecase.ErrPasswordTokenInvalid
+	}
+	delete(r.tokens, hash)
+	if !now.Before(token.ExpiresAt) {
+		return nil, usecase.ErrPasswordTokenInvalid
+	}
+	return &token, nil
+}
@@ -0,0 +1,59 @@
+package ram_storage
+
+import (
+	"sync"
+	"time"
+
+	domPayment "canteen-app/internal/domain/payment"
+	"canteen-app/internal/usecase"
+)
+
+type PaymentRepo struct {
+	mu       sync.RWMutex
+	payments map[domPayment.PaymentID]domPayment.Payment
+}
+
+var _ usecase.PaymentRepository = (*PaymentRepo)(nil)
+
+func NewPaymentRepo() *PaymentRepo {
+	return &PaymentRepo{
+		payments: make(map[domPayment.PaymentID]domPayment.Payment),
+	}
+}
+
+func (r *PaymentRepo) CreatePayment(payment domPayment.Payment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.payments[payment.ID] = payment
+	return nil
+}
+
+func (r *PaymentRepo) GetPaymentByID(id domPayment.PaymentID) (*domPayment.Payment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	payment, ok := r.payments[id]
+	if !ok {
+		return &domPayment.Payment{}, usecase.ErrPaymentNotFound
+	}
+	return &payment, nil
+}
+
+func (r *PaymentRepo) UpdateStatus(id domPayment.PaymentID, from, to domPayment.Status) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	payment, ok := r.payments[id]
+	if !ok {
+		return usecase.ErrPaymentNotFound
+	}
+	if payment.Status != from {
+		return usecase.ErrPaymentStatus
+	}
+
+	payment.Status = to
+	payment.UpdatedAt = time.Now()
+	r.payments[id] = payment
+	return nil
+}
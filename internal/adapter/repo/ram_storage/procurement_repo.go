@@ -0,0 +1,123 @@
+package ram_storage
+
+import (
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	domProcurement "canteen-app/internal/domain/procurement"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+type ProcurementRepo struct {
+	mu       sync.RWMutex
+	requests map[domProcurement.RequestID]domProcurement.Request
+	nextID   domProcurement.RequestID
+}
+
+var _ usecase.ProcurementRepository = (*ProcurementRepo)(nil)
+
+func NewProcurementRepo() *ProcurementRepo {
+	return &ProcurementRepo{
+		requests: make(map[domProcurement.RequestID]domProcurement.Request),
+	}
+}
+
+func (r *ProcurementRepo) CreateRequest(request domProcurement.Request) (domProcurement.RequestID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	request.ID = r.nextID
+	r.requests[request.ID] = cloneRequest(request)
+	return request.ID, nil
+}
+
+func (r *ProcurementRepo) GetRequestByID(id domProcurement.RequestID) (*domProcurement.Request, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	request, ok := r.requests[id]
+	if !ok {
+		return &domProcurement.Request{}, usecase.ErrPurchaseRequestNotFound
+	}
+	request = cloneRequest(request)
+	return &request, nil
+}
+
+func (r *ProcurementRepo) ListRequests(statuses ...domProcurement.Status) ([]domProcurement.Request, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	requests := make([]domProcurement.Request, 0)
+	for _, request := range r.requests {
+		if len(statuses) > 0 && !slices.Contains(statuses, request.Status) {
+			continue
+		}
+		requests = append(requests, cloneRequest(request))
+	}
+	sort.Slice(requests, func(i, j int) bool { return requests[i].ID < requests[j].ID })
+	return requests, nil
+}
+
+func (r *ProcurementRepo) UpdateItems(id domProcurement.RequestID, items []domProcurement.Item, estimatedCost int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request, ok := r.requests[id]
+	if !ok {
+		return usecase.ErrPurchaseRequestNotFound
+	}
+	if request.Status != domProcurement.Draft {
+		return usecase.ErrPurchaseStatus
+	}
+
+	request.Items = slices.Clone(items)
+	request.EstimatedCost = estimatedCost
+	request.UpdatedAt = time.Now()
+	r.requests[id] = request
+	return nil
+}
+
+func (r *ProcurementRepo) UpdateStatus(id domProcurement.RequestID, from, to domProcurement.Status, approverID domUser.UserID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request, ok := r.requests[id]
+	if !ok {
+		return usecase.ErrPurchaseRequestNotFound
+	}
+	if request.Status != from {
+		return usecase.ErrPurchaseStatus
+	}
+
+	request.Status = to
+	if approverID != 0 {
+		request.ApproverID = approverID
+	}
+	request.UpdatedAt = time.Now()
+	r.requests[id] = request
+	return nil
+}
+
+func (r *ProcurementRepo) AddComment(id domProcurement.RequestID, comment domProcurement.Comment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request, ok := r.requests[id]
+	if !ok {
+		return usecase.ErrPurchaseRequestNotFound
+	}
+
+	request.Comments = append(slices.Clone(request.Comments), comment)
+	r.requests[id] = request
+	return nil
+}
+
+func cloneRequest(request domProcurement.Request) domProcurement.Request {
+	request.Items = slices.Clone(request.Items)
+	request.Comments = slices.Clone(request.Comments)
+	return request
+}
@@ -0,0 +1,43 @@
+package ram_storage
+
+import (
+	"slices"
+	"sync"
+
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+type DietaryProfileRepo struct {
+	mu       sync.RWMutex
+	profiles map[domUser.UserID]domUser.DietaryProfile
+}
+
+var _ usecase.DietaryProfileRepository = (*DietaryProfileRepo)(nil)
+
+func NewDietaryProfileRepo() *DietaryProfileRepo {
+	return &DietaryProfileRepo{
+		profiles: make(map[domUser.UserID]domUser.DietaryProfile),
+	}
+}
+
+func (r *DietaryProfileRepo) GetProfile(userID domUser.UserID) (*domUser.DietaryProfile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	profile, ok := r.profiles[userID]
+	if !ok {
+		return &domUser.DietaryProfile{UserID: userID}, nil
+	}
+	profile.Allergens = slices.Clone(profile.Allergens)
+	return &profile, nil
+}
+
+func (r *DietaryProfileRepo) SaveProfile(profile domUser.DietaryProfile) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	profile.Allergens = slices.Clone(profile.Allergens)
+	r.profiles[profile.UserID] = profile
+	return nil
+}
@@ -0,0 +1,49 @@
+package ram_storage
+
+import (
+	"slices"
+	"sync"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domRecipe "canteen-app/internal/domain/recipe"
+	"canteen-app/internal/usecase"
+)
+
+type RecipeRepo struct {
+	mu      sync.RWMutex
+	recipes map[domMenu.DishID]domRecipe.Recipe
+}
+
+var _ usecase.RecipeRepository = (*RecipeRepo)(nil)
+
+func NewRecipeRepo() *RecipeRepo {
+	return &RecipeRepo{
+		recipes: make(map[domMenu.DishID]domRecipe.Recipe),
+	}
+}
+
+func (r *RecipeRepo) GetRecipe(dishID domMenu.DishID) (*domRecipe.Recipe, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	recipe, ok := r.recipes[dishID]
+	if !ok {
+		return &domRecipe.Recipe{DishID: dishID}, nil
+	}
+	recipe.Ingredients = slices.Clone(recipe.Ingredients)
+	return &recipe, nil
+}
+
+func (r *RecipeRepo) SaveRecipe(recipe domRecipe.Recipe) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(recipe.Ingredients) == 0 {
+		delete(r.recipes, recipe.DishID)
+		return nil
+	}
+
+	recipe.Ingredients = slices.Clone(recipe.Ingredients)
+	r.recipes[recipe.DishID] = recipe
+	return nil
+}
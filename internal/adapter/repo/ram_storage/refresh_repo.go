@@ -1,44 +1,131 @@
 package ram_storage
 
 import (
+	"sort"
+	"sync"
 	"time"
 
+	domAuth "canteen-app/internal/domain/auth"
 	domUser "canteen-app/internal/domain/user"
 	"canteen-app/internal/usecase"
 )
 
-type refreshRecord struct {
-	UserId    domUser.UserID
-	ExpiresAt time.Time
-}
-
 type RefreshRepo struct {
-	data map[string]refreshRecord
+	mu   sync.RWMutex
+	data map[string]domAuth.RefreshToken
 }
 
 var _ usecase.RefreshTokenRepository = (*RefreshRepo)(nil)
 
 func NewRefreshRepo() *RefreshRepo {
 	return &RefreshRepo{
-		data: make(map[string]refreshRecord),
+		data: make(map[string]domAuth.RefreshToken),
 	}
 }
 
-func (r *RefreshRepo) Save(tokenID string, userID domUser.UserID, exp time.Time) {
-	r.data[tokenID] = refreshRecord{UserId: userID, ExpiresAt: exp}
+func (r *RefreshRepo) Save(token domAuth.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.data[token.ID] = token
+	return nil
+}
+
+func (r *RefreshRepo) Get(tokenID string) (*domAuth.RefreshToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	token, ok := r.data[tokenID]
+	if !ok || !token.ExpiresAt.After(time.Now()) {
+		return nil, usecase.ErrRefreshNotFound
+	}
+	return &token, nil
 }
 
-func (r *RefreshRepo) Delete(tokenID string) {
-	delete(r.data, tokenID)
+func (r *RefreshRepo) Rotate(tokenID string, next domAuth.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.data[tokenID]
+	if !ok || !token.ExpiresAt.After(time.Now()) {
+		return usecase.ErrRefreshNotFound
+	}
+	if token.Rotated() {
+		return usecase.ErrRefreshRotated
+	}
+
+	token.RotatedAt = time.Now()
+	r.data[tokenID] = token
+	r.data[next.ID] = next
+	return nil
 }
 
-func (r *RefreshRepo) IsValid(tokenID string, userID domUser.UserID) bool {
-	rec, ok := r.data[tokenID]
-	if !ok {
-		return false
+func (r *RefreshRepo) ListActive(userID domUser.UserID, now time.Time) ([]domAuth.RefreshToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tokens := make([]domAuth.RefreshToken, 0)
+	for _, token := range r.data {
+		if token.UserID == userID && token.ExpiresAt.After(now) && !token.Rotated() {
+			tokens = append(tokens, token)
+		}
 	}
-	if rec.UserId != userID {
-		return false
+	sort.Slice(tokens, func(i, j int) bool {
+		if !tokens[i].CreatedAt.Equal(tokens[j].CreatedAt) {
+			return tokens[i].CreatedAt.Before(tokens[j].CreatedAt)
+		}
+		return tokens[i].FamilyID < tokens[j].FamilyID
+	})
+	return tokens, nil
+}
+
+func (r *RefreshRepo) DeleteFamily(familyID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, token := range r.data {
+		if token.FamilyID == familyID {
+			delete(r.data, id)
+		}
+	}
+	return nil
+}
+
+func (r *RefreshRepo) DeleteByUser(userID domUser.UserID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, token := range r.data {
+		if token.UserID == userID {
+			delete(r.data, id)
+		}
+	}
+	return nil
+}
+
+func (r *RefreshRepo) DeleteExpired(now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for id, token := range r.data {
+		if !token.ExpiresAt.After(now) {
+			delete(r.data, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (r *RefreshRepo) CountActive(now time.Time) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	active := 0
+	for _, token := range r.data {
+		if token.ExpiresAt.After(now) && !token.Rotated() {
+			active++
+		}
 	}
-	return true
+	return active, nil
 }
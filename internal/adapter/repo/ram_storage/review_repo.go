@@ -0,0 +1,111 @@
+package ram_storage
+
+import (
+	"sort"
+	"sync"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domReview "canteen-app/internal/domain/review"
+	"canteen-app/internal/usecase"
+)
+
+type ReviewRepo struct {
+	mu      sync.RWMutex
+	reviews map[domReview.ReviewID]domReview.Review
+	nextID  domReview.ReviewID
+}
+
+var _ usecase.ReviewRepository = (*ReviewRepo)(nil)
+
+func NewReviewRepo() *ReviewRepo {
+	return &ReviewRepo{
+		reviews: make(map[domReview.ReviewID]domReview.Review),
+	}
+}
+
+func (r *ReviewRepo) CreateReview(review domReview.Review) (domReview.ReviewID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.reviews {
+		if existing.OrderID == review.OrderID && existing.DishID == review.DishID {
+			return 0, usecase.ErrAlreadyReviewed
+		}
+	}
+
+	r.nextID++
+	review.ID = r.nextID
+	r.reviews[review.ID] = review
+	return review.ID, nil
+}
+
+func (r *ReviewRepo) GetReviewByID(id domReview.ReviewID) (*domReview.Review, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	review, ok := r.reviews[id]
+	if !ok {
+		return &domReview.Review{}, usecase.ErrReviewNotFound
+	}
+	return &review, nil
+}
+
+func (r *ReviewRepo) ListReviews(dishID domMenu.DishID, includeHidden bool) ([]domReview.Review, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reviews := make([]domReview.Review, 0)
+	for _, review := range r.reviews {
+		if dishID != 0 && review.DishID != dishID {
+			continue
+		}
+		if review.Hidden && !includeHidden {
+			continue
+		}
+		reviews = append(reviews, review)
+	}
+	sort.Slice(reviews, func(i, j int) bool { return reviews[i].ID < reviews[j].ID })
+	return reviews, nil
+}
+
+func (r *ReviewRepo) SetHidden(id domReview.ReviewID, hidden bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	review, ok := r.reviews[id]
+	if !ok {
+		return usecase.ErrReviewNotFound
+	}
+	review.Hidden = hidden
+	r.reviews[id] = review
+	return nil
+}
+
+func (r *ReviewRepo) Ratings(dishIDs []domMenu.DishID) (map[domMenu.DishID]domReview.Rating, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	wanted := make(map[domMenu.DishID]struct{}, len(dishIDs))
+	for _, id := range dishIDs {
+		wanted[id] = struct{}{}
+	}
+
+	sums := make(map[domMenu.DishID]int)
+	ratings := make(map[domMenu.DishID]domReview.Rating)
+	for _, review := range r.reviews {
+		if _, ok := wanted[review.DishID]; !ok {
+			continue
+		}
+		rating := ratings[review.DishID]
+		rating.DishID = review.DishID
+		rating.Count++
+		sums[review.DishID] += review.Rating
+		ratings[review.DishID] = rating
+	}
+
+	for id, rating := range ratings {
+		rating.Average = float64(sums[id]) / float64(rating.Count)
+		ratings[id] = rating
+	}
+	return ratings, nil
+}
@@ -0,0 +1,44 @@
+package ram_storage
+
+import (
+	"sync"
+
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+type SecurityEventRepo struct {
+	mu     sync.RWMutex
+	events []domAuth.SecurityEvent
+	nextID domAuth.SecurityEventID
+}
+
+var _ usecase.SecurityEventRepository = (*SecurityEventRepo)(nil)
+
+func NewSecurityEventRepo() *SecurityEventRepo {
+	return &SecurityEventRepo{}
+}
+
+func (r *SecurityEventRepo) RecordEvent(event domAuth.SecurityEvent) (domAuth.SecurityEventID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	event.ID = r.nextID
+	r.events = append(r.events, event)
+	return event.ID, nil
+}
+
+func (r *SecurityEventRepo) ListEvents(userID domUser.UserID) ([]domAuth.SecurityEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	events := make([]domAuth.SecurityEvent, 0)
+	for _, event := range r.events {
+		if event.UserID == userID {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,112 @@
+package ram_storage
+
+import (
+	"sort"
+	"sync"
+
+	domSubscription "canteen-app/internal/domain/subscription"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+type SubscriptionRepo struct {
+	mu                 sync.RWMutex
+	plans              map[domSubscription.PlanID]domSubscription.Plan
+	subscriptions      map[domSubscription.SubscriptionID]domSubscription.Subscription
+	nextPlanID         domSubscription.PlanID
+	nextSubscriptionID domSubscription.SubscriptionID
+}
+
+var _ usecase.SubscriptionRepository = (*SubscriptionRepo)(nil)
+
+func NewSubscriptionRepo() *SubscriptionRepo {
+	return &SubscriptionRepo{
+		plans:         make(map[domSubscription.PlanID]domSubscription.Plan),
+		subscriptions: make(map[domSubscription.SubscriptionID]domSubscription.Subscription),
+	}
+}
+
+func (r *SubscriptionRepo) CreatePlan(plan domSubscription.Plan) (domSubscription.PlanID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextPlanID++
+	plan.ID = r.nextPlanID
+	r.plans[plan.ID] = plan
+	return plan.ID, nil
+}
+
+func (r *SubscriptionRepo) GetPlanByID(id domSubscription.PlanID) (*domSubscription.Plan, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	plan, ok := r.plans[id]
+	if !ok {
+		return &domSubscription.Plan{}, usecase.ErrPlanNotFound
+	}
+	return &plan, nil
+}
+
+func (r *SubscriptionRepo) ListPlans() ([]domSubscription.Plan, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	plans := make([]domSubscription.Plan, 0, len(r.plans))
+	for _, plan := range r.plans {
+		plans = append(plans, plan)
+	}
+	sort.Slice(plans, func(i, j int) bool { return plans[i].ID < plans[j].ID })
+	return plans, nil
+}
+
+func (r *SubscriptionRepo) CreateSubscription(sub domSubscription.Subscription) (domSubscription.SubscriptionID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextSubscriptionID++
+	sub.ID = r.nextSubscriptionID
+	r.subscriptions[sub.ID] = sub
+	return sub.ID, nil
+}
+
+func (r *SubscriptionRepo) GetSubscriptionByID(id domSubscription.SubscriptionID) (*domSubscription.Subscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sub, ok := r.subscriptions[id]
+	if !ok {
+		return &domSubscription.Subscription{}, usecase.ErrSubscriptionNotFound
+	}
+	return &sub, nil
+}
+
+func (r *SubscriptionRepo) ListSubscriptionsByStudent(studentID domUser.UserID) ([]domSubscription.Subscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	subs := make([]domSubscription.Subscription, 0)
+	for _, sub := range r.subscriptions {
+		if sub.StudentID == studentID {
+			subs = append(subs, sub)
+		}
+	}
+	sort.Slice(subs, func(i, j int) bool { return subs[i].ID < subs[j].ID })
+	return subs, nil
+}
+
+func (r *SubscriptionRepo) DecrementRemaining(id domSubscription.SubscriptionID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sub, ok := r.subscriptions[id]
+	if !ok {
+		return usecase.ErrSubscriptionNotFound
+	}
+	if sub.RemainingMeals <= 0 {
+		return usecase.ErrSubscriptionUsedUp
+	}
+
+	sub.RemainingMeals--
+	r.subscriptions[id] = sub
+	return nil
+}
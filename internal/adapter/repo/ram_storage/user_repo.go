@@ -1,44 +1,169 @@
 package ram_storage
 
 import (
-	"math/rand"
-	"time"
+	"sort"
+	"strings"
+	"sync"
 
 	domUser "canteen-app/internal/domain/user"
 	"canteen-app/internal/usecase"
 )
 
 type UserRepo struct {
-	Users map[domUser.UserID]domUser.User
+	mu      sync.RWMutex
+	ids     UserIDGenerator
+	users   map[domUser.UserID]domUser.User
+	byLogin map[string]domUser.UserID
+	byEmail map[string]domUser.UserID
 }
 
 var _ usecase.UserRepository = (*UserRepo)(nil)
 
+// NewUserRepo numbers users sequentially.
 func NewUserRepo() *UserRepo {
+	return NewUserRepoWithIDs(&Sequence{})
+}
+
+func NewUserRepoWithIDs(ids UserIDGenerator) *UserRepo {
 	return &UserRepo{
-		Users: make(map[domUser.UserID]domUser.User),
+		ids:     ids,
+		users:   make(map[domUser.UserID]domUser.User),
+		byLogin: make(map[string]domUser.UserID),
+		byEmail: make(map[string]domUser.UserID),
+	}
+}
+
+// CreateUser checks the login and email and stores the user under one lock,
+// so of concurrent registrations with the same login only one succeeds. An ID
+// the generator has already handed out is rejected with ErrUserIDConflict.
+func (ur *UserRepo) CreateUser(user domUser.User) (domUser.UserID, error) {
+	ur.mu.Lock()
+	defer ur.mu.Unlock()
+
+	if _, ok := ur.byLogin[user.Login]; ok {
+		return 0, usecase.ErrLoginInUse
+	}
+	if _, ok := ur.byEmail[user.Email]; ok {
+		return 0, usecase.ErrEmailInUse
+	}
+
+	id, err := ur.ids.NextUserID()
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := ur.users[id]; ok {
+		return 0, usecase.ErrUserIDConflict
 	}
+
+	user.ID = id
+	ur.users[user.ID] = user
+	ur.byLogin[user.Login] = user.ID
+	if user.Email != "" {
+		ur.byEmail[user.Email] = user.ID
+	}
+	return user.ID, nil
 }
 
-func (ur *UserRepo) CreateUser(user domUser.User) domUser.UserID {
-	rand.Seed(time.Now().UnixNano())
-	user.ID = domUser.UserID(rand.Int63n(int64(234)))
-	ur.Users[user.ID] = user
-	return user.ID
+func (ur *UserRepo) GetUserByID(id domUser.UserID) (*domUser.User, error) {
+	ur.mu.RLock()
+	defer ur.mu.RUnlock()
+
+	if user, ok := ur.users[id]; ok {
+		return &user, nil
+	}
+	return &domUser.User{}, usecase.ErrUserNotFound
 }
 
-func (ur UserRepo) GetUserByID(id domUser.UserID) (*domUser.User, error) {
-	if user, ok := ur.Users[id]; ok {
+func (ur *UserRepo) GetUserByLogin(login string) (*domUser.User, error) {
+	ur.mu.RLock()
+	defer ur.mu.RUnlock()
+
+	if id, ok := ur.byLogin[login]; ok {
+		user := ur.users[id]
 		return &user, nil
 	}
 	return &domUser.User{}, usecase.ErrUserNotFound
 }
 
-func (uc UserRepo) GetUserByLogin(login string) (*domUser.User, error) {
-	for _, val := range uc.Users {
-		if val.Login == login {
-			return &val, nil
-		}
+func (ur *UserRepo) GetUserByEmail(email string) (*domUser.User, error) {
+	ur.mu.RLock()
+	defer ur.mu.RUnlock()
+
+	if id, ok := ur.byEmail[email]; ok {
+		user := ur.users[id]
+		return &user, nil
 	}
 	return &domUser.User{}, usecase.ErrUserNotFound
 }
+
+func (ur *UserRepo) SetPasswordHash(id domUser.UserID, hash string) error {
+	ur.mu.Lock()
+	defer ur.mu.Unlock()
+
+	user, ok := ur.users[id]
+	if !ok {
+		return usecase.ErrUserNotFound
+	}
+	user.PasswordHash = hash
+	ur.users[id] = user
+	return nil
+}
+
+func (ur *UserRepo) ListUsers(query domUser.ListQuery) ([]domUser.User, int, error) {
+	ur.mu.RLock()
+	defer ur.mu.RUnlock()
+
+	search := strings.ToLower(query.Search)
+	matched := make([]domUser.User, 0)
+	for _, user := range ur.users {
+		if query.Role != "" && user.Role != query.Role {
+			continue
+		}
+		if search != "" &&
+			!strings.Contains(strings.ToLower(user.Login), search) &&
+			!strings.Contains(strings.ToLower(user.Name), search) &&
+			!strings.Contains(strings.ToLower(user.Surname), search) {
+			continue
+		}
+		matched = append(matched, user)
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].ID < matched[j].ID })
+
+	total := len(matched)
+	start := min(max(query.Offset, 0), total)
+	end := total
+	if query.Limit > 0 {
+		end = min(start+query.Limit, total)
+	}
+	return matched[start:end], total, nil
+}
+
+func (ur *UserRepo) UpdateUser(user domUser.User) error {
+	ur.mu.Lock()
+	defer ur.mu.Unlock()
+
+	stored, ok := ur.users[user.ID]
+	if !ok {
+		return usecase.ErrUserNotFound
+	}
+	stored.Name = user.Name
+	stored.Surname = user.Surname
+	stored.Role = user.Role
+	stored.Blocked = user.Blocked
+	ur.users[user.ID] = stored
+	return nil
+}
+
+func (ur *UserRepo) DeleteUser(id domUser.UserID) error {
+	ur.mu.Lock()
+	defer ur.mu.Unlock()
+
+	user, ok := ur.users[id]
+	if !ok {
+		return usecase.ErrUserNotFound
+	}
+	delete(ur.users, id)
+	delete(ur.byLogin, user.Login)
+	delete(ur.byEmail, user.Email)
+	return nil
+}
@@ -0,0 +1,34 @@
+package ram_storage_test
+
+import (
+	"testing"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedIDs domUser.UserID
+
+func (f fixedIDs) NextUserID() (domUser.UserID, error) { return domUser.UserID(f), nil }
+
+func TestUserRepo_IDConflict(t *testing.T) {
+	repo := ram_storage.NewUserRepoWithIDs(fixedIDs(7))
+
+	id, err := repo.CreateUser(domUser.User{Login: "slim", Role: "student"})
+	require.NoError(t, err)
+	assert.Equal(t, domUser.UserID(7), id)
+
+	_, err = repo.CreateUser(domUser.User{Login: "marshall", Role: "student"})
+	assert.ErrorIs(t, err, usecase.ErrUserIDConflict)
+
+	got, err := repo.GetUserByID(7)
+	require.NoError(t, err)
+	assert.Equal(t, "slim", got.Login, "existing user must not be overwritten")
+
+	_, err = repo.GetUserByLogin("marshall")
+	assert.ErrorIs(t, err, usecase.ErrUserNotFound)
+}
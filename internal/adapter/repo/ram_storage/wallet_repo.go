@@ -0,0 +1,81 @@
+package ram_storage
+
+import (
+	"sync"
+
+	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
+	"canteen-app/internal/usecase"
+)
+
+type WalletRepo struct {
+	mu      sync.RWMutex
+	entries map[domUser.UserID][]domWallet.Entry
+	nextID  domWallet.EntryID
+}
+
+var _ usecase.WalletRepository = (*WalletRepo)(nil)
+
+func NewWalletRepo() *WalletRepo {
+	return &WalletRepo{
+		entries: make(map[domUser.UserID][]domWallet.Entry),
+	}
+}
+
+func (r *WalletRepo) Append(entry domWallet.Entry) (domWallet.EntryID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := r.entries[entry.StudentID]
+
+	if entry.Kind == domWallet.Charge && entry.OrderID != 0 {
+		unrefunded := 0
+		for _, e := range entries {
+			if e.OrderID != entry.OrderID {
+				continue
+			}
+			switch e.Kind {
+			case domWallet.Charge:
+				unrefunded++
+			case domWallet.Refund:
+				unrefunded--
+			}
+		}
+		if unrefunded > 0 {
+			return 0, usecase.ErrAlreadyCharged
+		}
+	}
+
+	if entry.Kind == domWallet.TopUp && entry.PaymentID != "" {
+		for _, e := range entries {
+			if e.Kind == domWallet.TopUp && e.PaymentID == entry.PaymentID {
+				return 0, usecase.ErrAlreadyCredited
+			}
+		}
+	}
+
+	if entry.Amount < 0 && domWallet.Balance(entries)+entry.Amount < 0 {
+		return 0, usecase.ErrInsufficientFunds
+	}
+
+	r.nextID++
+	entry.ID = r.nextID
+	r.entries[entry.StudentID] = append(entries, entry)
+	return entry.ID, nil
+}
+
+func (r *WalletRepo) ListEntries(studentID domUser.UserID) ([]domWallet.Entry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]domWallet.Entry, len(r.entries[studentID]))
+	copy(entries, r.entries[studentID])
+	return entries, nil
+}
+
+func (r *WalletRepo) Balance(studentID domUser.UserID) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return domWallet.Balance(r.entries[studentID]), nil
+}
@@ -0,0 +1,91 @@
+package repotest
+
+import (
+	"testing"
+
+	domInventory "canteen-app/internal/domain/inventory"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func InventoryRepository(t *testing.T, newRepo func(t *testing.T) usecase.InventoryRepository) {
+	t.Run("products", func(t *testing.T) {
+		repo := newRepo(t)
+		flour := domInventory.Product{Name: "Мука", Unit: domInventory.Kilogram, MinQuantity: 10}
+		milk := domInventory.Product{Name: "Молоко", Unit: domInventory.Liter, MinQuantity: 5}
+
+		var err error
+		flour.ID, err = repo.CreateProduct(flour)
+		require.NoError(t, err)
+		milk.ID, err = repo.CreateProduct(milk)
+		require.NoError(t, err)
+
+		_, err = repo.CreateProduct(domInventory.Product{Name: "МУКА", Unit: domInventory.Gram})
+		assert.ErrorIs(t, err, usecase.ErrProductExists, "names are compared case-insensitively")
+
+		_, err = repo.ApplyMovement(domInventory.Movement{ProductID: flour.ID, Kind: domInventory.Receipt, Quantity: 25})
+		require.NoError(t, err)
+
+		flour.Name = "Мука пшеничная"
+		flour.MinQuantity = 20
+		flour.Quantity = 1000
+		require.NoError(t, repo.UpdateProduct(flour))
+		flour.Quantity = 25
+
+		got, err := repo.GetProductByID(flour.ID)
+		require.NoError(t, err)
+		assert.Equal(t, flour, *got, "quantity only changes with movements")
+
+		milk.Name = "мука пшеничная"
+		assert.ErrorIs(t, repo.UpdateProduct(milk), usecase.ErrProductExists)
+		milk.Name = "Молоко"
+
+		products, err := repo.ListProducts()
+		require.NoError(t, err)
+		assert.Equal(t, []domInventory.Product{flour, milk}, products)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.GetProductByID(42)
+		assert.ErrorIs(t, err, usecase.ErrProductNotFound)
+		assert.ErrorIs(t, repo.UpdateProduct(domInventory.Product{ID: 42, Name: "Мука"}), usecase.ErrProductNotFound)
+		_, err = repo.ApplyMovement(domInventory.Movement{ProductID: 42, Kind: domInventory.Receipt, Quantity: 1})
+		assert.ErrorIs(t, err, usecase.ErrProductNotFound)
+	})
+
+	t.Run("movements", func(t *testing.T) {
+		repo := newRepo(t)
+		flourID, err := repo.CreateProduct(domInventory.Product{Name: "Мука", Unit: domInventory.Kilogram})
+		require.NoError(t, err)
+		milkID, err := repo.CreateProduct(domInventory.Product{Name: "Молоко", Unit: domInventory.Liter})
+		require.NoError(t, err)
+
+		receipt := domInventory.Movement{ProductID: flourID, Kind: domInventory.Receipt, Quantity: 10, AuthorID: 2, Comment: "Поставка", CreatedAt: at(8, 0)}
+		consumption := domInventory.Movement{ProductID: flourID, Kind: domInventory.Consumption, Quantity: -2.5, DishID: 1, AuthorID: 2, CreatedAt: at(12, 0)}
+		receipt.ID, err = repo.ApplyMovement(receipt)
+		require.NoError(t, err)
+		_, err = repo.ApplyMovement(domInventory.Movement{ProductID: milkID, Kind: domInventory.Receipt, Quantity: 3, CreatedAt: at(9, 0)})
+		require.NoError(t, err)
+		consumption.ID, err = repo.ApplyMovement(consumption)
+		require.NoError(t, err)
+
+		_, err = repo.ApplyMovement(domInventory.Movement{ProductID: flourID, Kind: domInventory.WriteOff, Quantity: -7.6})
+		assert.ErrorIs(t, err, usecase.ErrInsufficientStock)
+
+		got, err := repo.GetProductByID(flourID)
+		require.NoError(t, err)
+		assert.InDelta(t, 7.5, got.Quantity, 1e-9)
+
+		movements, err := repo.ListMovements(flourID)
+		require.NoError(t, err)
+		assert.Equal(t, []domInventory.Movement{receipt, consumption}, movements)
+
+		movements, err = repo.ListMovements(42)
+		require.NoError(t, err)
+		assert.Empty(t, movements)
+	})
+}
@@ -0,0 +1,107 @@
+package repotest
+
+import (
+	"testing"
+
+	domMenu "canteen-app/internal/domain/menu"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func MenuRepository(t *testing.T, newRepo func(t *testing.T) usecase.MenuRepository) {
+	t.Run("dishes", func(t *testing.T) {
+		repo := newRepo(t)
+		soup := domMenu.Dish{Name: "Борщ", Description: "Со сметаной", Price: 12000, Weight: 250, Allergens: []domMenu.Allergen{domMenu.Lactose}}
+		tea := domMenu.Dish{Name: "Чай", Price: 2000, Weight: 200}
+
+		var err error
+		soup.ID, err = repo.CreateDish(soup)
+		require.NoError(t, err)
+		tea.ID, err = repo.CreateDish(tea)
+		require.NoError(t, err)
+		assert.NotEqual(t, soup.ID, tea.ID)
+
+		soup.Price = 13000
+		require.NoError(t, repo.UpdateDish(soup))
+
+		got, err := repo.GetDishByID(soup.ID)
+		require.NoError(t, err)
+		assert.Equal(t, soup, *got)
+
+		dishes, err := repo.ListDishes()
+		require.NoError(t, err)
+		assert.Equal(t, []domMenu.Dish{soup, tea}, dishes)
+	})
+
+	t.Run("dish not found", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.GetDishByID(42)
+		assert.ErrorIs(t, err, usecase.ErrDishNotFound)
+		assert.ErrorIs(t, repo.UpdateDish(domMenu.Dish{ID: 42, Name: "Борщ"}), usecase.ErrDishNotFound)
+	})
+
+	t.Run("menus", func(t *testing.T) {
+		repo := newRepo(t)
+		soupID, err := repo.CreateDish(domMenu.Dish{Name: "Борщ", Price: 12000, Weight: 250})
+		require.NoError(t, err)
+		teaID, err := repo.CreateDish(domMenu.Dish{Name: "Чай", Price: 2000, Weight: 200})
+		require.NoError(t, err)
+		soup, err := repo.GetDishByID(soupID)
+		require.NoError(t, err)
+		tea, err := repo.GetDishByID(teaID)
+		require.NoError(t, err)
+
+		lunch := domMenu.Menu{Date: day(19), MealType: domMenu.Lunch, Dishes: []domMenu.Dish{*tea, *soup}}
+		lunch.ID, err = repo.CreateMenu(lunch)
+		require.NoError(t, err)
+		breakfast := domMenu.Menu{Date: day(19), MealType: domMenu.Breakfast, Dishes: []domMenu.Dish{*tea}}
+		breakfast.ID, err = repo.CreateMenu(breakfast)
+		require.NoError(t, err)
+		_, err = repo.CreateMenu(domMenu.Menu{Date: day(20), MealType: domMenu.Lunch, Dishes: []domMenu.Dish{*soup}})
+		require.NoError(t, err)
+
+		_, err = repo.CreateMenu(domMenu.Menu{Date: day(19), MealType: domMenu.Lunch, Dishes: []domMenu.Dish{*soup}})
+		assert.ErrorIs(t, err, usecase.ErrMenuExists)
+
+		got, err := repo.GetMenuByID(lunch.ID)
+		require.NoError(t, err)
+		assert.True(t, got.Date.Equal(day(19)))
+		assert.Equal(t, domMenu.Lunch, got.MealType)
+		assert.Equal(t, []domMenu.Dish{*tea, *soup}, got.Dishes, "dishes keep the menu order")
+		assert.Empty(t, got.Portions)
+
+		lunch.Dishes = []domMenu.Dish{*soup}
+		lunch.Portions = map[domMenu.DishID]int{soupID: 120, teaID: 80}
+		require.NoError(t, repo.UpdateMenu(lunch))
+
+		got, err = repo.GetMenuByID(lunch.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []domMenu.Dish{*soup}, got.Dishes)
+		assert.Equal(t, map[domMenu.DishID]int{soupID: 120}, got.Portions, "portions of dishes outside the menu are dropped")
+
+		soup.Name = "Борщ украинский"
+		require.NoError(t, repo.UpdateDish(*soup))
+
+		menus, err := repo.GetMenusByDate(day(19))
+		require.NoError(t, err)
+		require.Len(t, menus, 2)
+		assert.Equal(t, lunch.ID, menus[0].ID)
+		assert.Equal(t, "Борщ украинский", menus[0].Dishes[0].Name, "menus show the current dishes")
+		assert.Equal(t, breakfast.ID, menus[1].ID)
+
+		menus, err = repo.GetMenusByDate(day(21))
+		require.NoError(t, err)
+		assert.Empty(t, menus)
+	})
+
+	t.Run("menu not found", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.GetMenuByID(42)
+		assert.ErrorIs(t, err, usecase.ErrMenuNotFound)
+		assert.ErrorIs(t, repo.UpdateMenu(domMenu.Menu{ID: 42}), usecase.ErrMenuNotFound)
+	})
+}
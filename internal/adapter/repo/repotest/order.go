@@ -0,0 +1,111 @@
+package repotest
+
+import (
+	"testing"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func OrderRepository(t *testing.T, newRepo func(t *testing.T) usecase.OrderRepository) {
+	newOrder := func(studentID domUser.UserID, d int) domOrder.Order {
+		return domOrder.Order{
+			StudentID: studentID,
+			MenuID:    1,
+			Date:      day(d),
+			MealType:  domMenu.Lunch,
+			Items:     []domOrder.Item{{DishID: 1, Name: "Борщ", Price: 12000, Quantity: 2}},
+			Total:     24000,
+			Status:    domOrder.Placed,
+			CreatedAt: at(9, 0),
+			UpdatedAt: at(9, 0),
+		}
+	}
+
+	t.Run("create and get", func(t *testing.T) {
+		repo := newRepo(t)
+		order := newOrder(7, 19)
+		order.ConfirmedAllergens = []domMenu.Allergen{domMenu.Lactose}
+
+		id, err := repo.CreateOrder(order)
+		require.NoError(t, err)
+		order.ID = id
+
+		got, err := repo.GetOrderByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, order, *got)
+
+		_, err = repo.GetOrderByID(id + 1)
+		assert.ErrorIs(t, err, usecase.ErrOrderNotFound)
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		repo := newRepo(t)
+		first, err := repo.CreateOrder(newOrder(7, 19))
+		require.NoError(t, err)
+		second, err := repo.CreateOrder(newOrder(8, 19))
+		require.NoError(t, err)
+		third, err := repo.CreateOrder(newOrder(7, 20))
+		require.NoError(t, err)
+		require.NoError(t, repo.UpdateStatus(second, domOrder.Placed, domOrder.Paid))
+
+		orders, err := repo.ListOrdersByStudent(7)
+		require.NoError(t, err)
+		assert.Equal(t, []domOrder.OrderID{first, third}, orderIDs(orders))
+
+		orders, err = repo.ListOrdersByDate(day(19))
+		require.NoError(t, err)
+		assert.Equal(t, []domOrder.OrderID{first, second}, orderIDs(orders))
+
+		orders, err = repo.ListOrdersByDate(day(19), domOrder.Paid, domOrder.Prepared)
+		require.NoError(t, err)
+		assert.Equal(t, []domOrder.OrderID{second}, orderIDs(orders))
+
+		orders, err = repo.ListOrdersByStudent(9)
+		require.NoError(t, err)
+		assert.Empty(t, orders)
+	})
+
+	t.Run("status compare-and-swap", func(t *testing.T) {
+		repo := newRepo(t)
+		id, err := repo.CreateOrder(newOrder(7, 19))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.UpdateStatus(id, domOrder.Placed, domOrder.Paid))
+		assert.ErrorIs(t, repo.UpdateStatus(id, domOrder.Placed, domOrder.Cancelled), usecase.ErrOrderStatus)
+		assert.ErrorIs(t, repo.UpdateStatus(id+1, domOrder.Placed, domOrder.Paid), usecase.ErrOrderNotFound)
+
+		got, err := repo.GetOrderByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, domOrder.Paid, got.Status)
+		assert.True(t, got.UpdatedAt.After(at(9, 0)))
+	})
+
+	t.Run("paid by subscription", func(t *testing.T) {
+		repo := newRepo(t)
+		id, err := repo.CreateOrder(newOrder(7, 19))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.MarkPaidBySubscription(id, 3))
+		assert.ErrorIs(t, repo.MarkPaidBySubscription(id, 3), usecase.ErrOrderStatus)
+		assert.ErrorIs(t, repo.MarkPaidBySubscription(id+1, 3), usecase.ErrOrderNotFound)
+
+		got, err := repo.GetOrderByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, domOrder.Paid, got.Status)
+		assert.EqualValues(t, 3, got.SubscriptionID)
+	})
+}
+
+func orderIDs(orders []domOrder.Order) []domOrder.OrderID {
+	ids := make([]domOrder.OrderID, 0, len(orders))
+	for _, o := range orders {
+		ids = append(ids, o.ID)
+	}
+	return ids
+}
@@ -0,0 +1,39 @@
+package repotest
+
+import (
+	"testing"
+
+	domPayment "canteen-app/internal/domain/payment"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func PaymentRepository(t *testing.T, newRepo func(t *testing.T) usecase.PaymentRepository) {
+	t.Run("create and get", func(t *testing.T) {
+		repo := newRepo(t)
+		payment := domPayment.Payment{ID: "pay-1", StudentID: 7, Amount: 50000, Status: domPayment.Pending, CreatedAt: at(9, 0), UpdatedAt: at(9, 0)}
+		require.NoError(t, repo.CreatePayment(payment))
+
+		got, err := repo.GetPaymentByID("pay-1")
+		require.NoError(t, err)
+		assert.Equal(t, payment, *got)
+
+		_, err = repo.GetPaymentByID("pay-2")
+		assert.ErrorIs(t, err, usecase.ErrPaymentNotFound)
+	})
+
+	t.Run("status compare-and-swap", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreatePayment(domPayment.Payment{ID: "pay-1", StudentID: 7, Amount: 50000, Status: domPayment.Pending}))
+
+		require.NoError(t, repo.UpdateStatus("pay-1", domPayment.Pending, domPayment.Succeeded))
+		assert.ErrorIs(t, repo.UpdateStatus("pay-1", domPayment.Pending, domPayment.Declined), usecase.ErrPaymentStatus)
+		assert.ErrorIs(t, repo.UpdateStatus("pay-2", domPayment.Pending, domPayment.Succeeded), usecase.ErrPaymentNotFound)
+
+		got, err := repo.GetPaymentByID("pay-1")
+		require.NoError(t, err)
+		assert.Equal(t, domPayment.Succeeded, got.Status)
+	})
+}
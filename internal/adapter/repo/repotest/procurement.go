@@ -0,0 +1,107 @@
+package repotest
+
+import (
+	"testing"
+
+	domInventory "canteen-app/internal/domain/inventory"
+	domProcurement "canteen-app/internal/domain/procurement"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ProcurementRepository(t *testing.T, newRepo func(t *testing.T) usecase.ProcurementRepository) {
+	flour := domProcurement.Item{ProductID: 1, Name: "Мука", Unit: domInventory.Kilogram, Quantity: 50, EstimatedCost: 250000}
+	newRequest := func() domProcurement.Request {
+		return domProcurement.Request{
+			AuthorID:      2,
+			Items:         []domProcurement.Item{flour},
+			EstimatedCost: 250000,
+			Status:        domProcurement.Draft,
+			Comments:      []domProcurement.Comment{{AuthorID: 2, Text: "Мука заканчивается", CreatedAt: at(9, 0)}},
+			CreatedAt:     at(9, 0),
+			UpdatedAt:     at(9, 0),
+		}
+	}
+
+	t.Run("create, get and list", func(t *testing.T) {
+		repo := newRepo(t)
+		request := newRequest()
+
+		var err error
+		request.ID, err = repo.CreateRequest(request)
+		require.NoError(t, err)
+		submittedID, err := repo.CreateRequest(newRequest())
+		require.NoError(t, err)
+		require.NoError(t, repo.UpdateStatus(submittedID, domProcurement.Draft, domProcurement.Submitted, 0))
+
+		got, err := repo.GetRequestByID(request.ID)
+		require.NoError(t, err)
+		assert.Equal(t, request, *got)
+
+		requests, err := repo.ListRequests()
+		require.NoError(t, err)
+		require.Len(t, requests, 2)
+		assert.Equal(t, request.ID, requests[0].ID)
+		assert.Equal(t, submittedID, requests[1].ID)
+
+		requests, err = repo.ListRequests(domProcurement.Submitted, domProcurement.Approved)
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+		assert.Equal(t, submittedID, requests[0].ID)
+
+		_, err = repo.GetRequestByID(42)
+		assert.ErrorIs(t, err, usecase.ErrPurchaseRequestNotFound)
+	})
+
+	t.Run("items change only in drafts", func(t *testing.T) {
+		repo := newRepo(t)
+		id, err := repo.CreateRequest(newRequest())
+		require.NoError(t, err)
+
+		sugar := domProcurement.Item{ProductID: 3, Name: "Сахар", Unit: domInventory.Kilogram, Quantity: 10, EstimatedCost: 90000}
+		require.NoError(t, repo.UpdateItems(id, []domProcurement.Item{flour, sugar}, 340000))
+
+		got, err := repo.GetRequestByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, []domProcurement.Item{flour, sugar}, got.Items)
+		assert.EqualValues(t, 340000, got.EstimatedCost)
+
+		require.NoError(t, repo.UpdateStatus(id, domProcurement.Draft, domProcurement.Submitted, 0))
+		assert.ErrorIs(t, repo.UpdateItems(id, []domProcurement.Item{flour}, 250000), usecase.ErrPurchaseStatus)
+		assert.ErrorIs(t, repo.UpdateItems(42, []domProcurement.Item{flour}, 250000), usecase.ErrPurchaseRequestNotFound)
+	})
+
+	t.Run("status compare-and-swap", func(t *testing.T) {
+		repo := newRepo(t)
+		id, err := repo.CreateRequest(newRequest())
+		require.NoError(t, err)
+
+		require.NoError(t, repo.UpdateStatus(id, domProcurement.Draft, domProcurement.Submitted, 0))
+		require.NoError(t, repo.UpdateStatus(id, domProcurement.Submitted, domProcurement.Approved, 1))
+		assert.ErrorIs(t, repo.UpdateStatus(id, domProcurement.Submitted, domProcurement.Rejected, 1), usecase.ErrPurchaseStatus)
+		require.NoError(t, repo.UpdateStatus(id, domProcurement.Approved, domProcurement.Fulfilled, 0))
+		assert.ErrorIs(t, repo.UpdateStatus(42, domProcurement.Draft, domProcurement.Submitted, 0), usecase.ErrPurchaseRequestNotFound)
+
+		got, err := repo.GetRequestByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, domProcurement.Fulfilled, got.Status)
+		assert.EqualValues(t, 1, got.ApproverID, "a zero approver keeps the recorded one")
+	})
+
+	t.Run("comments", func(t *testing.T) {
+		repo := newRepo(t)
+		request := newRequest()
+		id, err := repo.CreateRequest(request)
+		require.NoError(t, err)
+
+		comment := domProcurement.Comment{AuthorID: 1, Text: "Согласовано", CreatedAt: at(10, 0)}
+		require.NoError(t, repo.AddComment(id, comment))
+		assert.ErrorIs(t, repo.AddComment(42, comment), usecase.ErrPurchaseRequestNotFound)
+
+		got, err := repo.GetRequestByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, append(request.Comments, comment), got.Comments)
+	})
+}
@@ -0,0 +1,45 @@
+package repotest
+
+import (
+	"testing"
+
+	domRecipe "canteen-app/internal/domain/recipe"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func RecipeRepository(t *testing.T, newRepo func(t *testing.T) usecase.RecipeRepository) {
+	t.Run("save, replace and remove", func(t *testing.T) {
+		repo := newRepo(t)
+
+		got, err := repo.GetRecipe(1)
+		require.NoError(t, err)
+		assert.Equal(t, domRecipe.Recipe{DishID: 1}, *got)
+
+		recipe := domRecipe.Recipe{
+			DishID:      1,
+			Ingredients: []domRecipe.Ingredient{{ProductID: 1, Quantity: 0.08}, {ProductID: 2, Quantity: 0.2}},
+			UpdatedAt:   at(9, 0),
+		}
+		require.NoError(t, repo.SaveRecipe(recipe))
+
+		got, err = repo.GetRecipe(1)
+		require.NoError(t, err)
+		assert.Equal(t, recipe, *got)
+
+		recipe.Ingredients = recipe.Ingredients[:1]
+		recipe.UpdatedAt = at(10, 0)
+		require.NoError(t, repo.SaveRecipe(recipe))
+
+		got, err = repo.GetRecipe(1)
+		require.NoError(t, err)
+		assert.Equal(t, recipe, *got)
+
+		require.NoError(t, repo.SaveRecipe(domRecipe.Recipe{DishID: 1}))
+		got, err = repo.GetRecipe(1)
+		require.NoError(t, err)
+		assert.Empty(t, got.Ingredients)
+	})
+}
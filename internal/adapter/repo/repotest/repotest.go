@@ -0,0 +1,17 @@
+// Package repotest is the contract test suite shared by the repository
+// implementations. Each function runs the behaviour the use cases rely on
+// against fresh repositories returned by the factory.
+package repotest
+
+import (
+	"time"
+)
+
+// at returns a fixed UTC timestamp on the test day.
+func at(hour, minute int) time.Time {
+	return time.Date(2025, 10, 19, hour, minute, 0, 0, time.UTC)
+}
+
+func day(d int) time.Time {
+	return time.Date(2025, 10, d, 0, 0, 0, 0, time.UTC)
+}
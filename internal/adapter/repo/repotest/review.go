@@ -0,0 +1,91 @@
+package repotest
+
+import (
+	"testing"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domReview "canteen-app/internal/domain/review"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ReviewRepository(t *testing.T, newRepo func(t *testing.T) usecase.ReviewRepository) {
+	t.Run("create and list", func(t *testing.T) {
+		repo := newRepo(t)
+		first := domReview.Review{StudentID: 7, OrderID: 1, DishID: 1, Rating: 5, Comment: "Очень вкусно", CreatedAt: at(13, 0)}
+		second := domReview.Review{StudentID: 8, OrderID: 2, DishID: 1, Rating: 2, CreatedAt: at(13, 5)}
+		other := domReview.Review{StudentID: 7, OrderID: 1, DishID: 2, Rating: 4, CreatedAt: at(13, 10)}
+
+		var err error
+		first.ID, err = repo.CreateReview(first)
+		require.NoError(t, err)
+		second.ID, err = repo.CreateReview(second)
+		require.NoError(t, err)
+		other.ID, err = repo.CreateReview(other)
+		require.NoError(t, err)
+
+		got, err := repo.GetReviewByID(first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, first, *got)
+
+		require.NoError(t, repo.SetHidden(second.ID, true))
+		second.Hidden = true
+
+		reviews, err := repo.ListReviews(1, false)
+		require.NoError(t, err)
+		assert.Equal(t, []domReview.Review{first}, reviews)
+
+		reviews, err = repo.ListReviews(1, true)
+		require.NoError(t, err)
+		assert.Equal(t, []domReview.Review{first, second}, reviews)
+
+		reviews, err = repo.ListReviews(0, true)
+		require.NoError(t, err)
+		assert.Equal(t, []domReview.Review{first, second, other}, reviews)
+	})
+
+	t.Run("one review per dish and order", func(t *testing.T) {
+		repo := newRepo(t)
+		_, err := repo.CreateReview(domReview.Review{StudentID: 7, OrderID: 1, DishID: 1, Rating: 5})
+		require.NoError(t, err)
+
+		_, err = repo.CreateReview(domReview.Review{StudentID: 7, OrderID: 1, DishID: 1, Rating: 1})
+		assert.ErrorIs(t, err, usecase.ErrAlreadyReviewed)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.GetReviewByID(42)
+		assert.ErrorIs(t, err, usecase.ErrReviewNotFound)
+		assert.ErrorIs(t, repo.SetHidden(42, true), usecase.ErrReviewNotFound)
+	})
+
+	t.Run("ratings", func(t *testing.T) {
+		repo := newRepo(t)
+		for i, r := range []struct {
+			dishID domMenu.DishID
+			rating int
+		}{{1, 5}, {1, 4}, {1, 2}, {2, 3}, {3, 5}} {
+			_, err := repo.CreateReview(domReview.Review{StudentID: 7, OrderID: domOrder.OrderID(i + 1), DishID: r.dishID, Rating: r.rating})
+			require.NoError(t, err)
+		}
+		reviews, err := repo.ListReviews(1, false)
+		require.NoError(t, err)
+		require.NoError(t, repo.SetHidden(reviews[0].ID, true))
+
+		ratings, err := repo.Ratings([]domMenu.DishID{1, 2, 4})
+		require.NoError(t, err)
+		assert.Equal(t, map[domMenu.DishID]domReview.Rating{
+			1: {DishID: 1, Average: 11.0 / 3, Count: 3},
+			2: {DishID: 2, Average: 3, Count: 1},
+		}, ratings, "hidden reviews still count, dishes without reviews are left out")
+
+		ratings, err = repo.Ratings(nil)
+		require.NoError(t, err)
+		assert.Empty(t, ratings)
+	})
+}
@@ -0,0 +1,93 @@
+package repotest
+
+import (
+	"testing"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domSubscription "canteen-app/internal/domain/subscription"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func SubscriptionRepository(t *testing.T, newRepo func(t *testing.T) usecase.SubscriptionRepository) {
+	newPlan := func(name string) domSubscription.Plan {
+		return domSubscription.Plan{
+			Name:         name,
+			MealType:     domMenu.Lunch,
+			StartDate:    day(1),
+			EndDate:      day(31),
+			WeekdaysOnly: true,
+			Price:        250000,
+		}
+	}
+
+	t.Run("plans", func(t *testing.T) {
+		repo := newRepo(t)
+		lunches, breakfasts := newPlan("Обеды"), newPlan("Завтраки")
+		breakfasts.MealType = domMenu.Breakfast
+		breakfasts.WeekdaysOnly = false
+
+		var err error
+		lunches.ID, err = repo.CreatePlan(lunches)
+		require.NoError(t, err)
+		breakfasts.ID, err = repo.CreatePlan(breakfasts)
+		require.NoError(t, err)
+
+		got, err := repo.GetPlanByID(lunches.ID)
+		require.NoError(t, err)
+		assert.Equal(t, lunches, *got)
+
+		plans, err := repo.ListPlans()
+		require.NoError(t, err)
+		assert.Equal(t, []domSubscription.Plan{lunches, breakfasts}, plans)
+
+		_, err = repo.GetPlanByID(42)
+		assert.ErrorIs(t, err, usecase.ErrPlanNotFound)
+	})
+
+	t.Run("subscriptions", func(t *testing.T) {
+		repo := newRepo(t)
+		plan := newPlan("Обеды")
+		var err error
+		plan.ID, err = repo.CreatePlan(plan)
+		require.NoError(t, err)
+
+		sub := domSubscription.Subscription{StudentID: 7, Plan: plan, RemainingMeals: 2, PurchasedAt: at(9, 0)}
+		sub.ID, err = repo.CreateSubscription(sub)
+		require.NoError(t, err)
+		_, err = repo.CreateSubscription(domSubscription.Subscription{StudentID: 8, Plan: plan, RemainingMeals: 2, PurchasedAt: at(9, 0)})
+		require.NoError(t, err)
+
+		got, err := repo.GetSubscriptionByID(sub.ID)
+		require.NoError(t, err)
+		assert.Equal(t, sub, *got)
+
+		subs, err := repo.ListSubscriptionsByStudent(7)
+		require.NoError(t, err)
+		assert.Equal(t, []domSubscription.Subscription{sub}, subs)
+
+		_, err = repo.GetSubscriptionByID(42)
+		assert.ErrorIs(t, err, usecase.ErrSubscriptionNotFound)
+	})
+
+	t.Run("decrement", func(t *testing.T) {
+		repo := newRepo(t)
+		plan := newPlan("Обеды")
+		var err error
+		plan.ID, err = repo.CreatePlan(plan)
+		require.NoError(t, err)
+		id, err := repo.CreateSubscription(domSubscription.Subscription{StudentID: 7, Plan: plan, RemainingMeals: 2})
+		require.NoError(t, err)
+
+		require.NoError(t, repo.DecrementRemaining(id))
+		require.NoError(t, repo.DecrementRemaining(id))
+		assert.ErrorIs(t, repo.DecrementRemaining(id), usecase.ErrSubscriptionUsedUp)
+		assert.ErrorIs(t, repo.DecrementRemaining(id+1), usecase.ErrSubscriptionNotFound)
+
+		got, err := repo.GetSubscriptionByID(id)
+		require.NoError(t, err)
+		assert.Zero(t, got.RemainingMeals)
+	})
+}
@@ -0,0 +1,675 @@
+package repotest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+	domMenu "canteen-app/internal/domain/menu"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func UserRepository(t *testing.T, newRepo func(t *testing.T) usecase.UserRepository) {
+	t.Run("create and get", func(t *testing.T) {
+		repo := newRepo(t)
+		user := domUser.User{Login: "slim", PasswordHash: "hash", Name: "Slim", Surname: "Shady", Role: "student", Email: "slim@example.com"}
+
+		id, err := repo.CreateUser(user)
+		require.NoError(t, err)
+		user.ID = id
+
+		got, err := repo.GetUserByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, user, *got)
+
+		got, err = repo.GetUserByLogin("slim")
+		require.NoError(t, err)
+		assert.Equal(t, user, *got)
+
+		got, err = repo.GetUserByEmail("slim@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, user, *got)
+	})
+
+	t.Run("duplicate email", func(t *testing.T) {
+		repo := newRepo(t)
+		_, err := repo.CreateUser(domUser.User{Login: "slim", Role: "student", Email: "slim@example.com"})
+		require.NoError(t, err)
+
+		_, err = repo.CreateUser(domUser.User{Login: "shady", Role: "student", Email: "slim@example.com"})
+		assert.ErrorIs(t, err, usecase.ErrEmailInUse)
+	})
+
+	t.Run("users without email", func(t *testing.T) {
+		repo := newRepo(t)
+		for _, login := range []string{"slim", "shady"} {
+			_, err := repo.CreateUser(domUser.User{Login: login, Role: "student"})
+			require.NoError(t, err, "an empty email is never taken")
+		}
+
+		_, err := repo.GetUserByEmail("")
+		assert.ErrorIs(t, err, usecase.ErrUserNotFound)
+	})
+
+	t.Run("duplicate login", func(t *testing.T) {
+		repo := newRepo(t)
+		_, err := repo.CreateUser(domUser.User{Login: "slim", Role: "student"})
+		require.NoError(t, err)
+
+		_, err = repo.CreateUser(domUser.User{Login: "slim", Role: "admin"})
+		assert.ErrorIs(t, err, usecase.ErrLoginInUse)
+	})
+
+	t.Run("distinct ids", func(t *testing.T) {
+		repo := newRepo(t)
+
+		ids := make(map[domUser.UserID]string)
+		for i := range 300 {
+			login := fmt.Sprintf("user-%d", i)
+			id, err := repo.CreateUser(domUser.User{Login: login, Role: "student"})
+			require.NoError(t, err)
+			require.NotZero(t, id)
+			require.NotContains(t, ids, id, "id handed out twice")
+			ids[id] = login
+		}
+
+		for id, login := range ids {
+			got, err := repo.GetUserByID(id)
+			require.NoError(t, err)
+			assert.Equal(t, login, got.Login)
+		}
+	})
+
+	t.Run("concurrent registrations with one login", func(t *testing.T) {
+		repo := newRepo(t)
+		const attempts = 16
+
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			created int
+		)
+		for range attempts {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				_, err := repo.CreateUser(domUser.User{Login: "slim", Role: "student"})
+				if err != nil && !errors.Is(err, usecase.ErrLoginInUse) {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if err == nil {
+					mu.Lock()
+					created++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 1, created)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.GetUserByID(42)
+		assert.ErrorIs(t, err, usecase.ErrUserNotFound)
+
+		_, err = repo.GetUserByLogin("nobody")
+		assert.ErrorIs(t, err, usecase.ErrUserNotFound)
+	})
+
+	t.Run("set password hash", func(t *testing.T) {
+		repo := newRepo(t)
+		id, err := repo.CreateUser(domUser.User{Login: "slim", Role: "employee"})
+		require.NoError(t, err)
+
+		require.NoError(t, repo.SetPasswordHash(id, "hash"))
+		got, err := repo.GetUserByLogin("slim")
+		require.NoError(t, err)
+		assert.Equal(t, "hash", got.PasswordHash)
+
+		assert.ErrorIs(t, repo.SetPasswordHash(42, "hash"), usecase.ErrUserNotFound)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		repo := newRepo(t)
+		id, err := repo.CreateUser(domUser.User{Login: "slim", PasswordHash: "hash", Name: "Slim", Surname: "Shady", Role: "student", Email: "slim@example.com"})
+		require.NoError(t, err)
+
+		require.NoError(t, repo.UpdateUser(domUser.User{ID: id, Login: "ignored", PasswordHash: "ignored", Name: "Marshall", Surname: "Mathers", Role: "employee", Email: "ignored@example.com", Blocked: true}))
+
+		got, err := repo.GetUserByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, domUser.User{ID: id, Login: "slim", PasswordHash: "hash", Name: "Marshall", Surname: "Mathers", Role: "employee", Email: "slim@example.com", Blocked: true}, *got)
+
+		assert.ErrorIs(t, repo.UpdateUser(domUser.User{ID: 42, Role: "student"}), usecase.ErrUserNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		repo := newRepo(t)
+		id, err := repo.CreateUser(domUser.User{Login: "slim", Role: "student"})
+		require.NoError(t, err)
+
+		require.NoError(t, repo.DeleteUser(id))
+		_, err = repo.GetUserByID(id)
+		assert.ErrorIs(t, err, usecase.ErrUserNotFound)
+		assert.ErrorIs(t, repo.DeleteUser(id), usecase.ErrUserNotFound)
+
+		_, err = repo.CreateUser(domUser.User{Login: "slim", Role: "student"})
+		assert.NoError(t, err, "the login is free again")
+	})
+
+	t.Run("list", func(t *testing.T) {
+		repo := newRepo(t)
+		var ids []domUser.UserID
+		for _, user := range []domUser.User{
+			{Login: "slim", Name: "Slim", Surname: "Shady", Role: "student"},
+			{Login: "cook", Name: "Maria", Surname: "Ivanova", Role: "employee"},
+			{Login: "marshall", Name: "Marshall", Surname: "Mathers", Role: "student"},
+			{Login: "boss", Name: "Anna", Surname: "Petrova", Role: "admin"},
+			{Login: "under_score", Name: "Ivan", Surname: "Sidorov", Role: "student"},
+		} {
+			id, err := repo.CreateUser(user)
+			require.NoError(t, err)
+			ids = append(ids, id)
+		}
+
+		loginsOf := func(users []domUser.User) []string {
+			logins := make([]string, 0, len(users))
+			for _, user := range users {
+				logins = append(logins, user.Login)
+			}
+			return logins
+		}
+
+		tests := []struct {
+			name      string
+			query     domUser.ListQuery
+			wantUsers []string
+			wantTotal int
+		}{
+			{name: "everyone", query: domUser.ListQuery{}, wantUsers: []string{"slim", "cook", "marshall", "boss", "under_score"}, wantTotal: 5},
+			{name: "by role", query: domUser.ListQuery{Role: "student"}, wantUsers: []string{"slim", "marshall", "under_score"}, wantTotal: 3},
+			{name: "search ignores case", query: domUser.ListQuery{Search: "MA"}, wantUsers: []string{"cook", "marshall"}, wantTotal: 2},
+			{name: "search by surname", query: domUser.ListQuery{Search: "ova"}, wantUsers: []string{"cook", "boss"}, wantTotal: 2},
+			{name: "search and role", query: domUser.ListQuery{Search: "ma", Role: "student"}, wantUsers: []string{"marshall"}, wantTotal: 1},
+			{name: "wildcards are literal", query: domUser.ListQuery{Search: "_"}, wantUsers: []string{"under_score"}, wantTotal: 1},
+			{name: "first page", query: domUser.ListQuery{Limit: 2}, wantUsers: []string{"slim", "cook"}, wantTotal: 5},
+			{name: "second page", query: domUser.ListQuery{Offset: 2, Limit: 2}, wantUsers: []string{"marshall", "boss"}, wantTotal: 5},
+			{name: "past the end", query: domUser.ListQuery{Offset: 10, Limit: 2}, wantUsers: []string{}, wantTotal: 5},
+			{name: "nothing matches", query: domUser.ListQuery{Search: "nobody"}, wantUsers: []string{}, wantTotal: 0},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				users, total, err := repo.ListUsers(tc.query)
+				require.NoError(t, err)
+				assert.Equal(t, tc.wantUsers, loginsOf(users))
+				assert.Equal(t, tc.wantTotal, total)
+			})
+		}
+
+		users, _, err := repo.ListUsers(domUser.ListQuery{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, ids[0], users[0].ID)
+	})
+}
+
+func RefreshTokenRepository(t *testing.T, newRepo func(t *testing.T) usecase.RefreshTokenRepository) {
+	// Validity is checked against the wall clock, so these fixtures are
+	// relative to it.
+	later := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	earlier := time.Now().Add(-time.Hour)
+
+	token := func(id string, userID domUser.UserID, familyID string, exp time.Time) domAuth.RefreshToken {
+		return domAuth.RefreshToken{
+			ID:         id,
+			UserID:     userID,
+			FamilyID:   familyID,
+			Client:     domAuth.Client{UserAgent: "Mozilla/5.0", IP: "192.0.2.1"},
+			CreatedAt:  at(9, 0),
+			LastUsedAt: at(9, 30),
+			ExpiresAt:  exp,
+		}
+	}
+	assertToken := func(t *testing.T, want domAuth.RefreshToken, got *domAuth.RefreshToken) {
+		t.Helper()
+		require.NotNil(t, got)
+		assert.Equal(t, want.ID, got.ID)
+		assert.Equal(t, want.UserID, got.UserID)
+		assert.Equal(t, want.FamilyID, got.FamilyID)
+		assert.Equal(t, want.Client, got.Client)
+		assert.True(t, want.CreatedAt.Equal(got.CreatedAt), "created at %s, want %s", got.CreatedAt, want.CreatedAt)
+		assert.True(t, want.LastUsedAt.Equal(got.LastUsedAt), "last used at %s, want %s", got.LastUsedAt, want.LastUsedAt)
+		assert.True(t, want.ExpiresAt.Equal(got.ExpiresAt), "expires at %s, want %s", got.ExpiresAt, want.ExpiresAt)
+		assert.Equal(t, want.Rotated(), got.Rotated())
+	}
+
+	t.Run("save and get", func(t *testing.T) {
+		repo := newRepo(t)
+		saved := token("token-1", 1, "token-1", later)
+		require.NoError(t, repo.Save(saved))
+
+		got, err := repo.Get("token-1")
+		require.NoError(t, err)
+		assertToken(t, saved, got)
+	})
+
+	t.Run("unknown and expired tokens", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.Save(token("expired", 1, "expired", earlier)))
+
+		_, err := repo.Get("missing")
+		assert.ErrorIs(t, err, usecase.ErrRefreshNotFound)
+		_, err = repo.Get("expired")
+		assert.ErrorIs(t, err, usecase.ErrRefreshNotFound)
+
+		err = repo.Rotate("missing", token("next", 1, "missing", later))
+		assert.ErrorIs(t, err, usecase.ErrRefreshNotFound)
+		err = repo.Rotate("expired", token("next", 1, "expired", later))
+		assert.ErrorIs(t, err, usecase.ErrRefreshNotFound)
+		_, err = repo.Get("next")
+		assert.ErrorIs(t, err, usecase.ErrRefreshNotFound, "successor stored for a failed rotation")
+
+		assert.NoError(t, repo.DeleteFamily("missing"))
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		repo := newRepo(t)
+		first := token("token-1", 1, "token-1", later)
+		second := token("token-2", 1, "token-1", later.Add(time.Minute))
+		require.NoError(t, repo.Save(first))
+
+		require.NoError(t, repo.Rotate("token-1", second))
+
+		got, err := repo.Get("token-1")
+		require.NoError(t, err)
+		assert.True(t, got.Rotated(), "rotated token is kept and marked")
+		got, err = repo.Get("token-2")
+		require.NoError(t, err)
+		assertToken(t, second, got)
+
+		err = repo.Rotate("token-1", token("token-3", 1, "token-1", later))
+		assert.ErrorIs(t, err, usecase.ErrRefreshRotated)
+		_, err = repo.Get("token-3")
+		assert.ErrorIs(t, err, usecase.ErrRefreshNotFound)
+	})
+
+	t.Run("delete family", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.Save(token("a-1", 1, "a-1", later)))
+		require.NoError(t, repo.Rotate("a-1", token("a-2", 1, "a-1", later)))
+		require.NoError(t, repo.Save(token("b-1", 1, "b-1", later)))
+
+		require.NoError(t, repo.DeleteFamily("a-1"))
+
+		for _, id := range []string{"a-1", "a-2"} {
+			_, err := repo.Get(id)
+			assert.ErrorIs(t, err, usecase.ErrRefreshNotFound, id)
+		}
+		_, err := repo.Get("b-1")
+		assert.NoError(t, err, "another family of the same user")
+	})
+
+	t.Run("list active and delete by user", func(t *testing.T) {
+		repo := newRepo(t)
+		phone := token("phone-1", 1, "phone-1", later)
+		phone.CreatedAt = at(8, 0)
+		laptop := token("laptop-1", 1, "laptop-1", later)
+		laptopNext := token("laptop-2", 1, "laptop-1", later.Add(time.Minute))
+		laptopNext.Client = domAuth.Client{UserAgent: "curl/8.0", IP: "198.51.100.7"}
+		laptopNext.LastUsedAt = at(10, 0)
+		require.NoError(t, repo.Save(laptop))
+		require.NoError(t, repo.Rotate("laptop-1", laptopNext))
+		require.NoError(t, repo.Save(phone))
+		require.NoError(t, repo.Save(token("expired", 1, "expired", earlier)))
+		require.NoError(t, repo.Save(token("other-user", 2, "other-user", later)))
+
+		got, err := repo.ListActive(1, time.Now())
+		require.NoError(t, err)
+		require.Len(t, got, 2, "one live token per family")
+		assertToken(t, phone, &got[0])
+		assertToken(t, laptopNext, &got[1])
+
+		require.NoError(t, repo.DeleteByUser(1))
+
+		got, err = repo.ListActive(1, time.Now())
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		assert.NotNil(t, got)
+		_, err = repo.Get("laptop-1")
+		assert.ErrorIs(t, err, usecase.ErrRefreshNotFound, "rotated tokens are deleted too")
+		_, err = repo.Get("other-user")
+		assert.NoError(t, err)
+	})
+
+	t.Run("concurrent rotations of one token", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.Save(token("token-0", 1, "token-0", later)))
+
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			rotated int
+		)
+		for i := range 16 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				err := repo.Rotate("token-0", token(fmt.Sprintf("token-%d", i+1), 1, "token-0", later))
+				if err != nil && !errors.Is(err, usecase.ErrRefreshRotated) {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if err == nil {
+					mu.Lock()
+					rotated++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 1, rotated)
+	})
+
+	t.Run("delete expired and count active", func(t *testing.T) {
+		repo := newRepo(t)
+		now := time.Now()
+		// Expiry times in another zone must compare by instant.
+		msk := time.FixedZone("MSK", 3*60*60)
+		require.NoError(t, repo.Save(token("expired", 1, "expired", now.Add(-time.Minute).In(msk))))
+		require.NoError(t, repo.Save(token("expired-long-ago", 1, "expired-long-ago", now.Add(-48*time.Hour))))
+		require.NoError(t, repo.Save(token("active", 1, "active", now.Add(time.Minute).In(msk))))
+		require.NoError(t, repo.Save(token("rotated", 2, "rotated", now.Add(time.Hour))))
+		require.NoError(t, repo.Rotate("rotated", token("active-other-user", 2, "rotated", now.Add(time.Hour))))
+
+		active, err := repo.CountActive(now)
+		require.NoError(t, err)
+		assert.Equal(t, 2, active, "rotated tokens are not active sessions")
+
+		deleted, err := repo.DeleteExpired(now)
+		require.NoError(t, err)
+		assert.Equal(t, 2, deleted)
+
+		deleted, err = repo.DeleteExpired(now)
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+
+		_, err = repo.Get("active")
+		assert.NoError(t, err)
+
+		active, err = repo.CountActive(now.Add(2 * time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, active)
+	})
+}
+
+func SecurityEventRepository(t *testing.T, newRepo func(t *testing.T) usecase.SecurityEventRepository) {
+	t.Run("record and list", func(t *testing.T) {
+		repo := newRepo(t)
+		events := []domAuth.SecurityEvent{
+			{UserID: 1, Kind: domAuth.RefreshTokenReuse, Details: "first", CreatedAt: at(10, 0)},
+			{UserID: 2, Kind: domAuth.RefreshTokenReuse, Details: "other user", CreatedAt: at(10, 30)},
+			{UserID: 1, Kind: domAuth.RefreshTokenReuse, Details: "second", CreatedAt: at(11, 0)},
+		}
+		for i := range events {
+			id, err := repo.RecordEvent(events[i])
+			require.NoError(t, err)
+			require.NotZero(t, id)
+			events[i].ID = id
+		}
+
+		got, err := repo.ListEvents(1)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		for i, want := range []domAuth.SecurityEvent{events[0], events[2]} {
+			assert.True(t, want.CreatedAt.Equal(got[i].CreatedAt))
+			got[i].CreatedAt = want.CreatedAt
+			assert.Equal(t, want, got[i])
+		}
+
+		got, err = repo.ListEvents(3)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		assert.NotNil(t, got)
+	})
+}
+
+func LoginAttemptRepository(t *testing.T, newRepo func(t *testing.T) usecase.LoginAttemptRepository) {
+	t.Run("count failures", func(t *testing.T) {
+		repo := newRepo(t)
+
+		got, err := repo.GetAttempts("login:slim")
+		require.NoError(t, err)
+		assert.Zero(t, got)
+
+		_, err = repo.RecordFailure("login:slim", at(10, 0), time.Hour)
+		require.NoError(t, err)
+		got, err = repo.RecordFailure("login:slim", at(10, 5), time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 2, got.Failures)
+		assert.True(t, at(10, 5).Equal(got.LastFailure))
+
+		stored, err := repo.GetAttempts("login:slim")
+		require.NoError(t, err)
+		assert.Equal(t, 2, stored.Failures)
+
+		other, err := repo.GetAttempts("ip:192.0.2.1")
+		require.NoError(t, err)
+		assert.Zero(t, other.Failures, "keys are counted apart")
+	})
+
+	t.Run("failures past the window are forgotten", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.RecordFailure("login:slim", at(10, 0), time.Hour)
+		require.NoError(t, err)
+		got, err := repo.RecordFailure("login:slim", at(11, 30), time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.Failures)
+	})
+
+	t.Run("lock and reset", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.RecordFailure("login:slim", at(10, 0), time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, repo.Lock("login:slim", at(10, 15)))
+
+		got, err := repo.GetAttempts("login:slim")
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.Failures, "locking keeps the failures")
+		assert.True(t, at(10, 15).Equal(got.LockedUntil))
+
+		got, err = repo.RecordFailure("login:slim", at(10, 1), time.Hour)
+		require.NoError(t, err)
+		assert.True(t, at(10, 15).Equal(got.LockedUntil), "a failure keeps the lock")
+
+		require.NoError(t, repo.ResetAttempts("login:slim"))
+		got, err = repo.GetAttempts("login:slim")
+		require.NoError(t, err)
+		assert.Zero(t, got)
+
+		assert.NoError(t, repo.ResetAttempts("login:nobody"))
+	})
+}
+
+// InvitationRepository expects user 1, the creator of the invitations, to
+// exist.
+func InvitationRepository(t *testing.T, newRepo func(t *testing.T) usecase.InvitationRepository) {
+	now := at(12, 0)
+	invitation := func(code string, maxUses int, createdAt, expiresAt time.Time) domAuth.Invitation {
+		return domAuth.Invitation{Code: code, Class: "5A", CreatedBy: 1, MaxUses: maxUses, CreatedAt: createdAt, ExpiresAt: expiresAt}
+	}
+
+	t.Run("create and list", func(t *testing.T) {
+		repo := newRepo(t)
+		older := invitation("OLDER", 30, at(9, 0), day(25))
+		newer := invitation("NEWER", 1, at(10, 0), day(25))
+		require.NoError(t, repo.CreateInvitation(older))
+		require.NoError(t, repo.CreateInvitation(newer))
+
+		assert.ErrorIs(t, repo.CreateInvitation(invitation("OLDER", 5, at(11, 0), day(25))), usecase.ErrInvitationExists)
+
+		got, err := repo.ListInvitations()
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		for i, want := range []domAuth.Invitation{newer, older} {
+			assert.True(t, want.CreatedAt.Equal(got[i].CreatedAt))
+			assert.True(t, want.ExpiresAt.Equal(got[i].ExpiresAt))
+			got[i].CreatedAt, got[i].ExpiresAt = want.CreatedAt, want.ExpiresAt
+			assert.Equal(t, want, got[i])
+		}
+	})
+
+	t.Run("use up and release", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreateInvitation(invitation("TWICE", 2, at(9, 0), day(25))))
+
+		for uses := 1; uses <= 2; uses++ {
+			got, err := repo.UseInvitation("TWICE", now)
+			require.NoError(t, err)
+			assert.Equal(t, uses, got.Uses)
+			assert.Equal(t, "5A", got.Class)
+		}
+		_, err := repo.UseInvitation("TWICE", now)
+		assert.ErrorIs(t, err, usecase.ErrInvitationInvalid)
+
+		require.NoError(t, repo.ReleaseInvitation("TWICE"))
+		_, err = repo.UseInvitation("TWICE", now)
+		assert.NoError(t, err)
+
+		require.NoError(t, repo.ReleaseInvitation("UNKNOWN"))
+	})
+
+	t.Run("expired and unknown codes", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreateInvitation(invitation("EXPIRED", 30, at(9, 0), at(11, 0))))
+
+		_, err := repo.UseInvitation("EXPIRED", now)
+		assert.ErrorIs(t, err, usecase.ErrInvitationInvalid)
+		_, err = repo.UseInvitation("UNKNOWN", now)
+		assert.ErrorIs(t, err, usecase.ErrInvitationInvalid)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreateInvitation(invitation("GONE", 30, at(9, 0), day(25))))
+
+		require.NoError(t, repo.DeleteInvitation("GONE"))
+		assert.ErrorIs(t, repo.DeleteInvitation("GONE"), usecase.ErrInvitationNotFound)
+		_, err := repo.UseInvitation("GONE", now)
+		assert.ErrorIs(t, err, usecase.ErrInvitationInvalid)
+	})
+
+	t.Run("concurrent uses", func(t *testing.T) {
+		repo := newRepo(t)
+		const maxUses = 5
+		require.NoError(t, repo.CreateInvitation(invitation("RACE", maxUses, at(9, 0), day(25))))
+
+		var (
+			wg   sync.WaitGroup
+			mu   sync.Mutex
+			used int
+		)
+		for range 4 * maxUses {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				_, err := repo.UseInvitation("RACE", now)
+				if errors.Is(err, usecase.ErrInvitationInvalid) {
+					return
+				}
+				if !assert.NoError(t, err) {
+					return
+				}
+				mu.Lock()
+				used++
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, maxUses, used)
+	})
+}
+
+// PasswordTokenRepository expects users 1 and 2 to exist.
+func PasswordTokenRepository(t *testing.T, newRepo func(t *testing.T) usecase.PasswordTokenRepository) {
+	now := at(12, 0)
+
+	t.Run("take once", func(t *testing.T) {
+		repo := newRepo(t)
+		token := domAuth.PasswordToken{Hash: "hash", UserID: 1, ExpiresAt: at(13, 0)}
+		require.NoError(t, repo.SavePasswordToken(token))
+		require.NoError(t, repo.SavePasswordToken(domAuth.PasswordToken{Hash: "other", UserID: 2, ExpiresAt: at(13, 0)}))
+
+		got, err := repo.TakePasswordToken("hash", now)
+		require.NoError(t, err)
+		assert.True(t, token.ExpiresAt.Equal(got.ExpiresAt))
+		got.ExpiresAt = token.ExpiresAt
+		assert.Equal(t, token, *got)
+
+		_, err = repo.TakePasswordToken("hash", now)
+		assert.ErrorIs(t, err, usecase.ErrPasswordTokenInvalid)
+
+		_, err = repo.TakePasswordToken("other", now)
+		assert.NoError(t, err)
+	})
+
+	t.Run("expired and unknown tokens", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.SavePasswordToken(domAuth.PasswordToken{Hash: "expired", UserID: 1, ExpiresAt: at(11, 0)}))
+
+		_, err := repo.TakePasswordToken("expired", now)
+		assert.ErrorIs(t, err, usecase.ErrPasswordTokenInvalid)
+		_, err = repo.TakePasswordToken("expired", at(10, 0))
+		assert.ErrorIs(t, err, usecase.ErrPasswordTokenInvalid, "an expired token is gone after the attempt")
+		_, err = repo.TakePasswordToken("unknown", now)
+		assert.ErrorIs(t, err, usecase.ErrPasswordTokenInvalid)
+	})
+}
+
+func DietaryProfileRepository(t *testing.T, newRepo func(t *testing.T) usecase.DietaryProfileRepository) {
+	t.Run("empty profile", func(t *testing.T) {
+		repo := newRepo(t)
+
+		got, err := repo.GetProfile(7)
+		require.NoError(t, err)
+		assert.Equal(t, domUser.DietaryProfile{UserID: 7}, *got)
+	})
+
+	t.Run("save and overwrite", func(t *testing.T) {
+		repo := newRepo(t)
+		profile := domUser.DietaryProfile{
+			UserID:       7,
+			Allergens:    []domMenu.Allergen{domMenu.Nuts, domMenu.Lactose},
+			Restrictions: "Вегетарианец",
+			UpdatedAt:    at(10, 0),
+		}
+		require.NoError(t, repo.SaveProfile(profile))
+
+		got, err := repo.GetProfile(7)
+		require.NoError(t, err)
+		assert.Equal(t, profile, *got)
+
+		profile.Allergens = []domMenu.Allergen{domMenu.Fish}
+		profile.UpdatedAt = at(11, 0)
+		require.NoError(t, repo.SaveProfile(profile))
+
+		got, err = repo.GetProfile(7)
+		require.NoError(t, err)
+		assert.Equal(t, profile, *got)
+	})
+}
@@ -0,0 +1,84 @@
+package repotest
+
+import (
+	"testing"
+
+	domWallet "canteen-app/internal/domain/wallet"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func WalletRepository(t *testing.T, newRepo func(t *testing.T) usecase.WalletRepository) {
+	t.Run("ledger and balance", func(t *testing.T) {
+		repo := newRepo(t)
+		topUp := domWallet.Entry{StudentID: 7, Kind: domWallet.TopUp, Amount: 50000, PaymentID: "pay-1", CreatedAt: at(9, 0)}
+		charge := domWallet.Entry{StudentID: 7, Kind: domWallet.Charge, Amount: -12000, OrderID: 1, CreatedAt: at(10, 0)}
+		other := domWallet.Entry{StudentID: 8, Kind: domWallet.Correction, Amount: 500, AuthorID: 1, Comment: "Ошибка", CreatedAt: at(11, 0)}
+
+		var err error
+		topUp.ID, err = repo.Append(topUp)
+		require.NoError(t, err)
+		charge.ID, err = repo.Append(charge)
+		require.NoError(t, err)
+		other.ID, err = repo.Append(other)
+		require.NoError(t, err)
+
+		entries, err := repo.ListEntries(7)
+		require.NoError(t, err)
+		assert.Equal(t, []domWallet.Entry{topUp, charge}, entries)
+
+		balance, err := repo.Balance(7)
+		require.NoError(t, err)
+		assert.EqualValues(t, 38000, balance)
+
+		balance, err = repo.Balance(9)
+		require.NoError(t, err)
+		assert.Zero(t, balance)
+
+		entries, err = repo.ListEntries(9)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("insufficient funds", func(t *testing.T) {
+		repo := newRepo(t)
+		_, err := repo.Append(domWallet.Entry{StudentID: 7, Kind: domWallet.TopUp, Amount: 10000})
+		require.NoError(t, err)
+
+		_, err = repo.Append(domWallet.Entry{StudentID: 7, Kind: domWallet.Charge, Amount: -10001, OrderID: 1})
+		assert.ErrorIs(t, err, usecase.ErrInsufficientFunds)
+
+		_, err = repo.Append(domWallet.Entry{StudentID: 7, Kind: domWallet.Charge, Amount: -10000, OrderID: 1})
+		require.NoError(t, err)
+
+		balance, err := repo.Balance(7)
+		require.NoError(t, err)
+		assert.Zero(t, balance)
+	})
+
+	t.Run("idempotency", func(t *testing.T) {
+		repo := newRepo(t)
+		_, err := repo.Append(domWallet.Entry{StudentID: 7, Kind: domWallet.TopUp, Amount: 10000, PaymentID: "pay-1"})
+		require.NoError(t, err)
+		_, err = repo.Append(domWallet.Entry{StudentID: 7, Kind: domWallet.TopUp, Amount: 10000, PaymentID: "pay-1"})
+		assert.ErrorIs(t, err, usecase.ErrAlreadyCredited)
+
+		_, err = repo.Append(domWallet.Entry{StudentID: 7, Kind: domWallet.Charge, Amount: -3000, OrderID: 1})
+		require.NoError(t, err)
+		_, err = repo.Append(domWallet.Entry{StudentID: 7, Kind: domWallet.Charge, Amount: -3000, OrderID: 1})
+		assert.ErrorIs(t, err, usecase.ErrAlreadyCharged)
+
+		_, err = repo.Append(domWallet.Entry{StudentID: 7, Kind: domWallet.Refund, Amount: 3000, OrderID: 1})
+		require.NoError(t, err, "a refund references the charged order")
+		_, err = repo.Append(domWallet.Entry{StudentID: 7, Kind: domWallet.Charge, Amount: -3000, OrderID: 1})
+		require.NoError(t, err, "a refunded order can be charged again")
+		_, err = repo.Append(domWallet.Entry{StudentID: 7, Kind: domWallet.Charge, Amount: -3000, OrderID: 1})
+		assert.ErrorIs(t, err, usecase.ErrAlreadyCharged)
+
+		balance, err := repo.Balance(7)
+		require.NoError(t, err)
+		assert.EqualValues(t, 7000, balance)
+	})
+}
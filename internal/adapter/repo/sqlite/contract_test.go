@@ -0,0 +1,120 @@
+package sqlite_test
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"canteen-app/internal/adapter/repo/repotest"
+	"canteen-app/internal/adapter/repo/sqlite"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/require"
+)
+
+// openTestDB gives every test its own migrated database file.
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "canteen.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := sqlite.NewMigrator(db)
+	require.NoError(t, err)
+	_, err = migrator.Up()
+	require.NoError(t, err)
+	return db
+}
+
+func TestUserRepo(t *testing.T) {
+	repotest.UserRepository(t, func(t *testing.T) usecase.UserRepository { return sqlite.NewUserRepo(openTestDB(t)) })
+}
+
+func TestRefreshRepo(t *testing.T) {
+	repotest.RefreshTokenRepository(t, func(t *testing.T) usecase.RefreshTokenRepository { return sqlite.NewRefreshRepo(openTestDB(t)) })
+}
+
+func TestSecurityEventRepo(t *testing.T) {
+	repotest.SecurityEventRepository(t, func(t *testing.T) usecase.SecurityEventRepository { return sqlite.NewSecurityEventRepo(openTestDB(t)) })
+}
+
+func TestInvitationRepo(t *testing.T) {
+	repotest.InvitationRepository(t, func(t *testing.T) usecase.InvitationRepository { return sqlite.NewInvitationRepo(openTestDB(t)) })
+}
+
+func TestPasswordTokenRepo(t *testing.T) {
+	repotest.PasswordTokenRepository(t, func(t *testing.T) usecase.PasswordTokenRepository {
+		return sqlite.NewPasswordTokenRepo(openTestDB(t))
+	})
+}
+
+func TestDietaryProfileRepo(t *testing.T) {
+	repotest.DietaryProfileRepository(t, func(t *testing.T) usecase.DietaryProfileRepository {
+		return sqlite.NewDietaryProfileRepo(openTestDB(t))
+	})
+}
+
+func TestMenuRepo(t *testing.T) {
+	repotest.MenuRepository(t, func(t *testing.T) usecase.MenuRepository { return sqlite.NewMenuRepo(openTestDB(t)) })
+}
+
+func TestOrderRepo(t *testing.T) {
+	repotest.OrderRepository(t, func(t *testing.T) usecase.OrderRepository { return sqlite.NewOrderRepo(openTestDB(t)) })
+}
+
+func TestWalletRepo(t *testing.T) {
+	repotest.WalletRepository(t, func(t *testing.T) usecase.WalletRepository { return sqlite.NewWalletRepo(openTestDB(t)) })
+}
+
+func TestPaymentRepo(t *testing.T) {
+	repotest.PaymentRepository(t, func(t *testing.T) usecase.PaymentRepository { return sqlite.NewPaymentRepo(openTestDB(t)) })
+}
+
+func TestSubscriptionRepo(t *testing.T) {
+	repotest.SubscriptionRepository(t, func(t *testing.T) usecase.SubscriptionRepository {
+		return sqlite.NewSubscriptionRepo(openTestDB(t))
+	})
+}
+
+func TestReviewRepo(t *testing.T) {
+	repotest.ReviewRepository(t, func(t *testing.T) usecase.ReviewRepository { return sqlite.NewReviewRepo(openTestDB(t)) })
+}
+
+func TestInventoryRepo(t *testing.T) {
+	repotest.InventoryRepository(t, func(t *testing.T) usecase.InventoryRepository { return sqlite.NewInventoryRepo(openTestDB(t)) })
+}
+
+func TestProcurementRepo(t *testing.T) {
+	repotest.ProcurementRepository(t, func(t *testing.T) usecase.ProcurementRepository {
+		return sqlite.NewProcurementRepo(openTestDB(t))
+	})
+}
+
+func TestRecipeRepo(t *testing.T) {
+	repotest.RecipeRepository(t, func(t *testing.T) usecase.RecipeRepository { return sqlite.NewRecipeRepo(openTestDB(t)) })
+}
+
+// TestReopen checks that data is kept in the file between connections.
+func TestReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "canteen.db")
+
+	db, err := sqlite.Open(path)
+	require.NoError(t, err)
+	migrator, err := sqlite.NewMigrator(db)
+	require.NoError(t, err)
+	_, err = migrator.Up()
+	require.NoError(t, err)
+	id, err := sqlite.NewUserRepo(db).CreateUser(domUser.User{Login: "slim", Role: "student"})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	db, err = sqlite.Open(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	user, err := sqlite.NewUserRepo(db).GetUserByLogin("slim")
+	require.NoError(t, err)
+	require.Equal(t, id, user.ID)
+}
@@ -0,0 +1,177 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	domInventory "canteen-app/internal/domain/inventory"
+	"canteen-app/internal/usecase"
+)
+
+type InventoryRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.InventoryRepository = (*InventoryRepo)(nil)
+
+func NewInventoryRepo(db *sql.DB) *InventoryRepo {
+	return &InventoryRepo{db: db}
+}
+
+func (r *InventoryRepo) CreateProduct(product domInventory.Product) (domInventory.ProductID, error) {
+	var id domInventory.ProductID
+	err := withTx(r.db, func(tx *sql.Tx) error {
+		if err := checkNameFree(tx, product.Name, 0); err != nil {
+			return err
+		}
+
+		res, err := tx.Exec(
+			`INSERT INTO products (name, unit, quantity, min_quantity) VALUES (?, ?, ?, ?)`,
+			product.Name, product.Unit, product.Quantity, product.MinQuantity,
+		)
+		if err != nil {
+			return err
+		}
+
+		lastID, err := res.LastInsertId()
+		id = domInventory.ProductID(lastID)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *InventoryRepo) UpdateProduct(product domInventory.Product) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		if _, err := getProduct(tx, product.ID); err != nil {
+			return err
+		}
+		if err := checkNameFree(tx, product.Name, product.ID); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(
+			`UPDATE products SET name = ?, unit = ?, min_quantity = ? WHERE id = ?`,
+			product.Name, product.Unit, product.MinQuantity, product.ID,
+		)
+		return err
+	})
+}
+
+func (r *InventoryRepo) GetProductByID(id domInventory.ProductID) (*domInventory.Product, error) {
+	product, err := getProduct(r.db, id)
+	if err != nil {
+		return &domInventory.Product{}, err
+	}
+	return &product, nil
+}
+
+func (r *InventoryRepo) ListProducts() ([]domInventory.Product, error) {
+	rows, err := r.db.Query(`SELECT id, name, unit, quantity, min_quantity FROM products ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	products := make([]domInventory.Product, 0)
+	for rows.Next() {
+		var p domInventory.Product
+		if err := rows.Scan(&p.ID, &p.Name, &p.Unit, &p.Quantity, &p.MinQuantity); err != nil {
+			return nil, err
+		}
+		products = append(products, p)
+	}
+	return products, rows.Err()
+}
+
+func (r *InventoryRepo) ApplyMovement(movement domInventory.Movement) (domInventory.MovementID, error) {
+	var id domInventory.MovementID
+	err := withTx(r.db, func(tx *sql.Tx) error {
+		product, err := getProduct(tx, movement.ProductID)
+		if err != nil {
+			return err
+		}
+		if product.Quantity+movement.Quantity < 0 {
+			return usecase.ErrInsufficientStock
+		}
+
+		if _, err := tx.Exec(
+			`UPDATE products SET quantity = ? WHERE id = ?`, product.Quantity+movement.Quantity, product.ID,
+		); err != nil {
+			return err
+		}
+
+		res, err := tx.Exec(
+			`INSERT INTO stock_movements (product_id, kind, quantity, dish_id, author_id, comment, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			movement.ProductID, movement.Kind, movement.Quantity, movement.DishID, movement.AuthorID, movement.Comment, movement.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		lastID, err := res.LastInsertId()
+		id = domInventory.MovementID(lastID)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *InventoryRepo) ListMovements(productID domInventory.ProductID) ([]domInventory.Movement, error) {
+	rows, err := r.db.Query(
+		`SELECT id, product_id, kind, quantity, dish_id, author_id, comment, created_at
+		FROM stock_movements WHERE product_id = ? ORDER BY id`, productID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	movements := make([]domInventory.Movement, 0)
+	for rows.Next() {
+		var m domInventory.Movement
+		if err := rows.Scan(&m.ID, &m.ProductID, &m.Kind, &m.Quantity, &m.DishID, &m.AuthorID, &m.Comment, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		movements = append(movements, m)
+	}
+	return movements, rows.Err()
+}
+
+func getProduct(q queryRower, id domInventory.ProductID) (domInventory.Product, error) {
+	var p domInventory.Product
+	err := q.QueryRow(
+		`SELECT id, name, unit, quantity, min_quantity FROM products WHERE id = ?`, id,
+	).Scan(&p.ID, &p.Name, &p.Unit, &p.Quantity, &p.MinQuantity)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domInventory.Product{}, usecase.ErrProductNotFound
+	}
+	return p, err
+}
+
+// checkNameFree compares names in Go: SQLite's NOCASE collation only folds
+// ASCII letters, and product names are mostly Cyrillic.
+func checkNameFree(tx *sql.Tx, name string, except domInventory.ProductID) error {
+	rows, err := tx.Query(`SELECT name FROM products WHERE id <> ?`, except)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var existing string
+		if err := rows.Scan(&existing); err != nil {
+			return err
+		}
+		if strings.EqualFold(existing, name) {
+			return usecase.ErrProductExists
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,261 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	domMenu "canteen-app/internal/domain/menu"
+	"canteen-app/internal/usecase"
+)
+
+type MenuRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.MenuRepository = (*MenuRepo)(nil)
+
+func NewMenuRepo(db *sql.DB) *MenuRepo {
+	return &MenuRepo{db: db}
+}
+
+func (r *MenuRepo) CreateDish(dish domMenu.Dish) (domMenu.DishID, error) {
+	allergens, err := marshalJSON(dish.Allergens)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := r.db.Exec(
+		`INSERT INTO dishes (name, description, price, weight, allergens) VALUES (?, ?, ?, ?, ?)`,
+		dish.Name, dish.Description, dish.Price, dish.Weight, allergens,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return domMenu.DishID(id), nil
+}
+
+func (r *MenuRepo) UpdateDish(dish domMenu.Dish) error {
+	allergens, err := marshalJSON(dish.Allergens)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(
+		`UPDATE dishes SET name = ?, description = ?, price = ?, weight = ?, allergens = ? WHERE id = ?`,
+		dish.Name, dish.Description, dish.Price, dish.Weight, allergens, dish.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return usecase.ErrDishNotFound
+	}
+	return nil
+}
+
+func (r *MenuRepo) GetDishByID(id domMenu.DishID) (*domMenu.Dish, error) {
+	dish, err := scanDish(r.db.QueryRow(
+		`SELECT id, name, description, price, weight, allergens FROM dishes WHERE id = ?`, id,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domMenu.Dish{}, usecase.ErrDishNotFound
+	}
+	if err != nil {
+		return &domMenu.Dish{}, err
+	}
+	return &dish, nil
+}
+
+func (r *MenuRepo) ListDishes() ([]domMenu.Dish, error) {
+	return r.listDishes(`SELECT id, name, description, price, weight, allergens FROM dishes ORDER BY id`)
+}
+
+func (r *MenuRepo) CreateMenu(menu domMenu.Menu) (domMenu.MenuID, error) {
+	dishIDs, portions, err := marshalMenuDishes(menu)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := r.db.Exec(
+		`INSERT INTO menus (date, meal_type, dish_ids, portions) VALUES (?, ?, ?, ?)`,
+		formatDate(menu.Date), menu.MealType, dishIDs, portions,
+	)
+	if isUniqueViolation(err) {
+		return 0, usecase.ErrMenuExists
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return domMenu.MenuID(id), nil
+}
+
+func (r *MenuRepo) UpdateMenu(menu domMenu.Menu) error {
+	dishIDs, portions, err := marshalMenuDishes(menu)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(`UPDATE menus SET dish_ids = ?, portions = ? WHERE id = ?`, dishIDs, portions, menu.ID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return usecase.ErrMenuNotFound
+	}
+	return nil
+}
+
+func (r *MenuRepo) GetMenuByID(id domMenu.MenuID) (*domMenu.Menu, error) {
+	menus, err := r.listMenus(`SELECT id, date, meal_type, dish_ids, portions FROM menus WHERE id = ?`, id)
+	if err != nil {
+		return &domMenu.Menu{}, err
+	}
+	if len(menus) == 0 {
+		return &domMenu.Menu{}, usecase.ErrMenuNotFound
+	}
+	return &menus[0], nil
+}
+
+func (r *MenuRepo) GetMenusByDate(date time.Time) ([]domMenu.Menu, error) {
+	return r.listMenus(`SELECT id, date, meal_type, dish_ids, portions FROM menus WHERE date = ? ORDER BY id`, formatDate(date))
+}
+
+// listMenus resolves the stored dish IDs to the current dishes, skipping the
+// ones that no longer exist.
+func (r *MenuRepo) listMenus(query string, args ...any) ([]domMenu.Menu, error) {
+	type menuRow struct {
+		menu    domMenu.Menu
+		dishIDs []domMenu.DishID
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var menuRows []menuRow
+	var allIDs []any
+	for rows.Next() {
+		var (
+			row               menuRow
+			dishIDs, portions string
+		)
+		if err := rows.Scan(&row.menu.ID, dateField{&row.menu.Date}, &row.menu.MealType, &dishIDs, &portions); err != nil {
+			return nil, err
+		}
+		if err := unmarshalJSON(dishIDs, &row.dishIDs); err != nil {
+			return nil, err
+		}
+		if err := unmarshalJSON(portions, &row.menu.Portions); err != nil {
+			return nil, err
+		}
+		for _, id := range row.dishIDs {
+			allIDs = append(allIDs, id)
+		}
+		menuRows = append(menuRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	dishes := make(map[domMenu.DishID]domMenu.Dish)
+	if len(allIDs) > 0 {
+		list, err := r.listDishes(
+			`SELECT id, name, description, price, weight, allergens FROM dishes WHERE id IN (?`+strings.Repeat(", ?", len(allIDs)-1)+`)`,
+			allIDs...,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, dish := range list {
+			dishes[dish.ID] = dish
+		}
+	}
+
+	menus := make([]domMenu.Menu, 0, len(menuRows))
+	for _, row := range menuRows {
+		row.menu.Dishes = make([]domMenu.Dish, 0, len(row.dishIDs))
+		for _, id := range row.dishIDs {
+			if dish, ok := dishes[id]; ok {
+				row.menu.Dishes = append(row.menu.Dishes, dish)
+			}
+		}
+		if row.menu.Portions == nil {
+			row.menu.Portions = make(map[domMenu.DishID]int)
+		}
+		menus = append(menus, row.menu)
+	}
+	return menus, nil
+}
+
+func (r *MenuRepo) listDishes(query string, args ...any) ([]domMenu.Dish, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dishes := make([]domMenu.Dish, 0)
+	for rows.Next() {
+		dish, err := scanDish(rows)
+		if err != nil {
+			return nil, err
+		}
+		dishes = append(dishes, dish)
+	}
+	return dishes, rows.Err()
+}
+
+func scanDish(row rowScanner) (domMenu.Dish, error) {
+	var (
+		dish      domMenu.Dish
+		allergens string
+	)
+	if err := row.Scan(&dish.ID, &dish.Name, &dish.Description, &dish.Price, &dish.Weight, &allergens); err != nil {
+		return domMenu.Dish{}, err
+	}
+	if err := unmarshalJSON(allergens, &dish.Allergens); err != nil {
+		return domMenu.Dish{}, err
+	}
+	return dish, nil
+}
+
+// marshalMenuDishes keeps the dish order of the menu and the planned portions
+// of its dishes only.
+func marshalMenuDishes(menu domMenu.Menu) (string, string, error) {
+	ids := make([]domMenu.DishID, 0, len(menu.Dishes))
+	portions := make(map[domMenu.DishID]int)
+	for _, dish := range menu.Dishes {
+		ids = append(ids, dish.ID)
+		if n := menu.Portions[dish.ID]; n > 0 {
+			portions[dish.ID] = n
+		}
+	}
+
+	dishIDs, err := marshalJSON(ids)
+	if err != nil {
+		return "", "", err
+	}
+	portionsJSON, err := marshalJSON(portions)
+	if err != nil {
+		return "", "", err
+	}
+	return dishIDs, portionsJSON, nil
+}
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS recipes;
+DROP TABLE IF EXISTS purchase_requests;
+DROP TABLE IF EXISTS stock_movements;
+DROP TABLE IF EXISTS products;
+DROP TABLE IF EXISTS reviews;
+DROP TABLE IF EXISTS subscriptions;
+DROP TABLE IF EXISTS subscription_plans;
+DROP TABLE IF EXISTS payments;
+DROP TABLE IF EXISTS wallet_entries;
+DROP TABLE IF EXISTS orders;
+DROP TABLE IF EXISTS menus;
+DROP TABLE IF EXISTS dishes;
+DROP TABLE IF EXISTS dietary_profiles;
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS users;
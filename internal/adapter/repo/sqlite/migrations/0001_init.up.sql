@@ -0,0 +1,157 @@
+CREATE TABLE IF NOT EXISTS users (
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    login         TEXT    NOT NULL UNIQUE,
+    password_hash TEXT    NOT NULL,
+    name          TEXT    NOT NULL,
+    surname       TEXT    NOT NULL,
+    role          TEXT    NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    token_id   TEXT     PRIMARY KEY,
+    user_id    INTEGER  NOT NULL,
+    expires_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_user_id_idx ON refresh_tokens (user_id);
+
+CREATE TABLE IF NOT EXISTS dietary_profiles (
+    user_id      INTEGER  PRIMARY KEY,
+    allergens    TEXT     NOT NULL,
+    restrictions TEXT     NOT NULL,
+    updated_at   DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS dishes (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    name        TEXT    NOT NULL,
+    description TEXT    NOT NULL,
+    price       INTEGER NOT NULL,
+    weight      INTEGER NOT NULL,
+    allergens   TEXT    NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS menus (
+    id        INTEGER PRIMARY KEY AUTOINCREMENT,
+    date      TEXT    NOT NULL,
+    meal_type TEXT    NOT NULL,
+    dish_ids  TEXT    NOT NULL,
+    portions  TEXT    NOT NULL,
+    UNIQUE (date, meal_type)
+);
+
+CREATE TABLE IF NOT EXISTS orders (
+    id                  INTEGER  PRIMARY KEY AUTOINCREMENT,
+    student_id          INTEGER  NOT NULL,
+    menu_id             INTEGER  NOT NULL,
+    date                TEXT     NOT NULL,
+    meal_type           TEXT     NOT NULL,
+    items               TEXT     NOT NULL,
+    total               INTEGER  NOT NULL,
+    status              TEXT     NOT NULL,
+    subscription_id     INTEGER  NOT NULL,
+    confirmed_allergens TEXT     NOT NULL,
+    created_at          DATETIME NOT NULL,
+    updated_at          DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS orders_student_id_idx ON orders (student_id);
+CREATE INDEX IF NOT EXISTS orders_date_idx ON orders (date);
+
+CREATE TABLE IF NOT EXISTS wallet_entries (
+    id         INTEGER  PRIMARY KEY AUTOINCREMENT,
+    student_id INTEGER  NOT NULL,
+    kind       TEXT     NOT NULL,
+    amount     INTEGER  NOT NULL,
+    order_id   INTEGER  NOT NULL,
+    payment_id TEXT     NOT NULL,
+    author_id  INTEGER  NOT NULL,
+    comment    TEXT     NOT NULL,
+    created_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS wallet_entries_student_id_idx ON wallet_entries (student_id);
+CREATE UNIQUE INDEX IF NOT EXISTS wallet_entries_charge_key ON wallet_entries (order_id) WHERE kind = 'charge' AND order_id <> 0;
+CREATE UNIQUE INDEX IF NOT EXISTS wallet_entries_top_up_key ON wallet_entries (payment_id) WHERE kind = 'top_up' AND payment_id <> '';
+
+CREATE TABLE IF NOT EXISTS payments (
+    id         TEXT     PRIMARY KEY,
+    student_id INTEGER  NOT NULL,
+    amount     INTEGER  NOT NULL,
+    status     TEXT     NOT NULL,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS subscription_plans (
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    name          TEXT    NOT NULL,
+    meal_type     TEXT    NOT NULL,
+    start_date    TEXT    NOT NULL,
+    end_date      TEXT    NOT NULL,
+    weekdays_only INTEGER NOT NULL,
+    price         INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS subscriptions (
+    id              INTEGER  PRIMARY KEY AUTOINCREMENT,
+    student_id      INTEGER  NOT NULL,
+    plan_id         INTEGER  NOT NULL,
+    remaining_meals INTEGER  NOT NULL,
+    purchased_at    DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS subscriptions_student_id_idx ON subscriptions (student_id);
+
+CREATE TABLE IF NOT EXISTS reviews (
+    id         INTEGER  PRIMARY KEY AUTOINCREMENT,
+    student_id INTEGER  NOT NULL,
+    order_id   INTEGER  NOT NULL,
+    dish_id    INTEGER  NOT NULL,
+    rating     INTEGER  NOT NULL,
+    comment    TEXT     NOT NULL,
+    hidden     INTEGER  NOT NULL,
+    created_at DATETIME NOT NULL,
+    UNIQUE (order_id, dish_id)
+);
+
+CREATE INDEX IF NOT EXISTS reviews_dish_id_idx ON reviews (dish_id);
+
+CREATE TABLE IF NOT EXISTS products (
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    name         TEXT    NOT NULL,
+    unit         TEXT    NOT NULL,
+    quantity     REAL    NOT NULL,
+    min_quantity REAL    NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id         INTEGER  PRIMARY KEY AUTOINCREMENT,
+    product_id INTEGER  NOT NULL,
+    kind       TEXT     NOT NULL,
+    quantity   REAL     NOT NULL,
+    dish_id    INTEGER  NOT NULL,
+    author_id  INTEGER  NOT NULL,
+    comment    TEXT     NOT NULL,
+    created_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS stock_movements_product_id_idx ON stock_movements (product_id);
+
+CREATE TABLE IF NOT EXISTS purchase_requests (
+    id             INTEGER  PRIMARY KEY AUTOINCREMENT,
+    author_id      INTEGER  NOT NULL,
+    items          TEXT     NOT NULL,
+    estimated_cost INTEGER  NOT NULL,
+    status         TEXT     NOT NULL,
+    approver_id    INTEGER  NOT NULL,
+    comments       TEXT     NOT NULL,
+    created_at     DATETIME NOT NULL,
+    updated_at     DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS recipes (
+    dish_id     INTEGER  PRIMARY KEY,
+    ingredients TEXT     NOT NULL,
+    updated_at  DATETIME NOT NULL
+);
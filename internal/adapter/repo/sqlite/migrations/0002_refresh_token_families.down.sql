@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS security_events;
+
+-- Without the rotation mark a rotated token would be usable again.
+DELETE FROM refresh_tokens WHERE rotated_at IS NOT NULL;
+
+DROP INDEX IF EXISTS refresh_tokens_family_id_idx;
+ALTER TABLE refresh_tokens DROP COLUMN rotated_at;
+ALTER TABLE refresh_tokens DROP COLUMN family_id;
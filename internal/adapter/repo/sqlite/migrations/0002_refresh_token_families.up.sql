@@ -0,0 +1,17 @@
+ALTER TABLE refresh_tokens ADD COLUMN family_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE refresh_tokens ADD COLUMN rotated_at DATETIME;
+
+-- Tokens issued before families existed each start their own.
+UPDATE refresh_tokens SET family_id = token_id;
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_family_id_idx ON refresh_tokens (family_id);
+
+CREATE TABLE IF NOT EXISTS security_events (
+    id         INTEGER  PRIMARY KEY AUTOINCREMENT,
+    user_id    INTEGER  NOT NULL,
+    kind       TEXT     NOT NULL,
+    details    TEXT     NOT NULL,
+    created_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS security_events_user_id_idx ON security_events (user_id);
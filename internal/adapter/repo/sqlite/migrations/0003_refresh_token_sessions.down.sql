@@ -0,0 +1,4 @@
+ALTER TABLE refresh_tokens DROP COLUMN last_used_at;
+ALTER TABLE refresh_tokens DROP COLUMN created_at;
+ALTER TABLE refresh_tokens DROP COLUMN ip;
+ALTER TABLE refresh_tokens DROP COLUMN user_agent;
@@ -0,0 +1,7 @@
+ALTER TABLE refresh_tokens ADD COLUMN user_agent TEXT NOT NULL DEFAULT '';
+ALTER TABLE refresh_tokens ADD COLUMN ip TEXT NOT NULL DEFAULT '';
+ALTER TABLE refresh_tokens ADD COLUMN created_at DATETIME;
+ALTER TABLE refresh_tokens ADD COLUMN last_used_at DATETIME;
+
+-- When existing sessions started is unknown; count them from now.
+UPDATE refresh_tokens SET created_at = datetime('now'), last_used_at = datetime('now');
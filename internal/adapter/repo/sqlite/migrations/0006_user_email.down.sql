@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS users_email_key;
+ALTER TABLE users DROP COLUMN email;
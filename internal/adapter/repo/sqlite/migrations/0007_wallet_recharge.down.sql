@@ -0,0 +1 @@
+CREATE UNIQUE INDEX IF NOT EXISTS wallet_entries_charge_key ON wallet_entries (order_id) WHERE kind = 'charge' AND order_id <> 0;
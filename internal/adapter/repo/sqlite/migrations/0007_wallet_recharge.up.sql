@@ -0,0 +1 @@
+DROP INDEX IF EXISTS wallet_entries_charge_key;
@@ -0,0 +1,147 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	domOrder "canteen-app/internal/domain/order"
+	domSubscription "canteen-app/internal/domain/subscription"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+const orderColumns = `id, student_id, menu_id, date, meal_type, items, total, status, subscription_id, confirmed_allergens, created_at, updated_at`
+
+type OrderRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.OrderRepository = (*OrderRepo)(nil)
+
+func NewOrderRepo(db *sql.DB) *OrderRepo {
+	return &OrderRepo{db: db}
+}
+
+func (r *OrderRepo) CreateOrder(order domOrder.Order) (domOrder.OrderID, error) {
+	items, err := marshalJSON(order.Items)
+	if err != nil {
+		return 0, err
+	}
+	allergens, err := marshalJSON(order.ConfirmedAllergens)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := r.db.Exec(
+		`INSERT INTO orders (student_id, menu_id, date, meal_type, items, total, status, subscription_id, confirmed_allergens, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		order.StudentID, order.MenuID, formatDate(order.Date), order.MealType, items, order.Total, order.Status,
+		order.SubscriptionID, allergens, order.CreatedAt, order.UpdatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return domOrder.OrderID(id), nil
+}
+
+func (r *OrderRepo) GetOrderByID(id domOrder.OrderID) (*domOrder.Order, error) {
+	order, err := scanOrder(r.db.QueryRow(`SELECT `+orderColumns+` FROM orders WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domOrder.Order{}, usecase.ErrOrderNotFound
+	}
+	if err != nil {
+		return &domOrder.Order{}, err
+	}
+	return &order, nil
+}
+
+func (r *OrderRepo) ListOrdersByStudent(studentID domUser.UserID) ([]domOrder.Order, error) {
+	return r.list(`SELECT `+orderColumns+` FROM orders WHERE student_id = ? ORDER BY id`, studentID)
+}
+
+func (r *OrderRepo) ListOrdersByDate(date time.Time, statuses ...domOrder.Status) ([]domOrder.Order, error) {
+	query := `SELECT ` + orderColumns + ` FROM orders WHERE date = ?`
+	args := []any{formatDate(date)}
+	if len(statuses) > 0 {
+		query += ` AND status IN (?` + strings.Repeat(", ?", len(statuses)-1) + `)`
+		for _, status := range statuses {
+			args = append(args, status)
+		}
+	}
+	return r.list(query+` ORDER BY id`, args...)
+}
+
+func (r *OrderRepo) UpdateStatus(id domOrder.OrderID, from, to domOrder.Status) error {
+	return r.update(id, from, `UPDATE orders SET status = ?, updated_at = ? WHERE id = ?`, to, time.Now(), id)
+}
+
+func (r *OrderRepo) MarkPaidBySubscription(id domOrder.OrderID, subID domSubscription.SubscriptionID) error {
+	return r.update(id, domOrder.Placed,
+		`UPDATE orders SET status = ?, subscription_id = ?, updated_at = ? WHERE id = ?`,
+		domOrder.Paid, subID, time.Now(), id,
+	)
+}
+
+// update runs the statement if the order is still in the given status.
+func (r *OrderRepo) update(id domOrder.OrderID, from domOrder.Status, query string, args ...any) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		var status domOrder.Status
+		err := tx.QueryRow(`SELECT status FROM orders WHERE id = ?`, id).Scan(&status)
+		if errors.Is(err, sql.ErrNoRows) {
+			return usecase.ErrOrderNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if status != from {
+			return usecase.ErrOrderStatus
+		}
+
+		_, err = tx.Exec(query, args...)
+		return err
+	})
+}
+
+func (r *OrderRepo) list(query string, args ...any) ([]domOrder.Order, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := make([]domOrder.Order, 0)
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, rows.Err()
+}
+
+func scanOrder(row rowScanner) (domOrder.Order, error) {
+	var (
+		order            domOrder.Order
+		items, allergens string
+	)
+	err := row.Scan(&order.ID, &order.StudentID, &order.MenuID, dateField{&order.Date}, &order.MealType, &items, &order.Total,
+		&order.Status, &order.SubscriptionID, &allergens, &order.CreatedAt, &order.UpdatedAt)
+	if err != nil {
+		return domOrder.Order{}, err
+	}
+	if err := unmarshalJSON(items, &order.Items); err != nil {
+		return domOrder.Order{}, err
+	}
+	if err := unmarshalJSON(allergens, &order.ConfirmedAllergens); err != nil {
+		return domOrder.Order{}, err
+	}
+	return order, nil
+}
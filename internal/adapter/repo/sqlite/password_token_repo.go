@@ -0,0 +1,54 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+	"canteen-app/internal/usecase"
+)
+
+type PasswordTokenRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.PasswordTokenRepository = (*PasswordTokenRepo)(nil)
+
+func NewPasswordTokenRepo(db *sql.DB) *PasswordTokenRepo {
+	return &PasswordTokenRepo{db: db}
+}
+
+func (r *PasswordTokenRepo) SavePasswordToken(token domAuth.PasswordToken) error {
+	_, err := r.db.Exec(
+		`INSERT INTO password_tokens (token_hash, user_id, expires_at) VALUES (?, ?, ?)
+		ON CONFLICT (token_hash) DO UPDATE SET user_id = excluded.user_id, expires_at = excluded.expires_at`,
+		token.Hash, token.UserID, token.ExpiresAt.UTC(),
+	)
+	return err
+}
+
+func (r *PasswordTokenRepo) TakePasswordToken(hash string, now time.Time) (*domAuth.PasswordToken, error) {
+	var token domAuth.PasswordToken
+	err := withTx(r.db, func(tx *sql.Tx) error {
+		err := tx.QueryRow(
+			`SELECT token_hash, user_id, expires_at FROM password_tokens WHERE token_hash = ?`, hash,
+		).Scan(&token.Hash, &token.UserID, &token.ExpiresAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			return usecase.ErrPasswordTokenInvalid
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`DELETE FROM password_tokens WHERE token_hash = ?`, hash)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !now.Before(token.ExpiresAt) {
+		return nil, usecase.ErrPasswordTokenInvalid
+	}
+	return &token, nil
+}
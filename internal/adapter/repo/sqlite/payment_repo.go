@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	domPayment "canteen-app/internal/domain/payment"
+	"canteen-app/internal/usecase"
+)
+
+type PaymentRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.PaymentRepository = (*PaymentRepo)(nil)
+
+func NewPaymentRepo(db *sql.DB) *PaymentRepo {
+	return &PaymentRepo{db: db}
+}
+
+func (r *PaymentRepo) CreatePayment(payment domPayment.Payment) error {
+	_, err := r.db.Exec(
+		`INSERT INTO payments (id, student_id, amount, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET student_id = excluded.student_id, amount = excluded.amount,
+			status = excluded.status, created_at = excluded.created_at, updated_at = excluded.updated_at`,
+		payment.ID, payment.StudentID, payment.Amount, payment.Status, payment.CreatedAt, payment.UpdatedAt,
+	)
+	return err
+}
+
+func (r *PaymentRepo) GetPaymentByID(id domPayment.PaymentID) (*domPayment.Payment, error) {
+	var payment domPayment.Payment
+	err := r.db.QueryRow(
+		`SELECT id, student_id, amount, status, created_at, updated_at FROM payments WHERE id = ?`, id,
+	).Scan(&payment.ID, &payment.StudentID, &payment.Amount, &payment.Status, &payment.CreatedAt, &payment.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domPayment.Payment{}, usecase.ErrPaymentNotFound
+	}
+	if err != nil {
+		return &domPayment.Payment{}, err
+	}
+	return &payment, nil
+}
+
+func (r *PaymentRepo) UpdateStatus(id domPayment.PaymentID, from, to domPayment.Status) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		var status domPayment.Status
+		err := tx.QueryRow(`SELECT status FROM payments WHERE id = ?`, id).Scan(&status)
+		if errors.Is(err, sql.ErrNoRows) {
+			return usecase.ErrPaymentNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if status != from {
+			return usecase.ErrPaymentStatus
+		}
+
+		_, err = tx.Exec(`UPDATE payments SET status = ?, updated_at = ? WHERE id = ?`, to, time.Now(), id)
+		return err
+	})
+}
@@ -0,0 +1,173 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	domProcurement "canteen-app/internal/domain/procurement"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+const requestColumns = `id, author_id, items, estimated_cost, status, approver_id, comments, created_at, updated_at`
+
+type ProcurementRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.ProcurementRepository = (*ProcurementRepo)(nil)
+
+func NewProcurementRepo(db *sql.DB) *ProcurementRepo {
+	return &ProcurementRepo{db: db}
+}
+
+func (r *ProcurementRepo) CreateRequest(request domProcurement.Request) (domProcurement.RequestID, error) {
+	items, err := marshalJSON(request.Items)
+	if err != nil {
+		return 0, err
+	}
+	comments, err := marshalJSON(request.Comments)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := r.db.Exec(
+		`INSERT INTO purchase_requests (author_id, items, estimated_cost, status, approver_id, comments, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		request.AuthorID, items, request.EstimatedCost, request.Status, request.ApproverID, comments, request.CreatedAt, request.UpdatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return domProcurement.RequestID(id), nil
+}
+
+func (r *ProcurementRepo) GetRequestByID(id domProcurement.RequestID) (*domProcurement.Request, error) {
+	request, err := getRequest(r.db, id)
+	if err != nil {
+		return &domProcurement.Request{}, err
+	}
+	return &request, nil
+}
+
+func (r *ProcurementRepo) ListRequests(statuses ...domProcurement.Status) ([]domProcurement.Request, error) {
+	query := `SELECT ` + requestColumns + ` FROM purchase_requests`
+	args := make([]any, 0, len(statuses))
+	if len(statuses) > 0 {
+		query += ` WHERE status IN (?` + strings.Repeat(", ?", len(statuses)-1) + `)`
+		for _, status := range statuses {
+			args = append(args, status)
+		}
+	}
+
+	rows, err := r.db.Query(query+` ORDER BY id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests := make([]domProcurement.Request, 0)
+	for rows.Next() {
+		request, err := scanRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, rows.Err()
+}
+
+func (r *ProcurementRepo) UpdateItems(id domProcurement.RequestID, items []domProcurement.Item, estimatedCost int64) error {
+	itemsJSON, err := marshalJSON(items)
+	if err != nil {
+		return err
+	}
+
+	return withTx(r.db, func(tx *sql.Tx) error {
+		request, err := getRequest(tx, id)
+		if err != nil {
+			return err
+		}
+		if request.Status != domProcurement.Draft {
+			return usecase.ErrPurchaseStatus
+		}
+
+		_, err = tx.Exec(
+			`UPDATE purchase_requests SET items = ?, estimated_cost = ?, updated_at = ? WHERE id = ?`,
+			itemsJSON, estimatedCost, time.Now(), id,
+		)
+		return err
+	})
+}
+
+func (r *ProcurementRepo) UpdateStatus(id domProcurement.RequestID, from, to domProcurement.Status, approverID domUser.UserID) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		request, err := getRequest(tx, id)
+		if err != nil {
+			return err
+		}
+		if request.Status != from {
+			return usecase.ErrPurchaseStatus
+		}
+		if approverID == 0 {
+			approverID = request.ApproverID
+		}
+
+		_, err = tx.Exec(
+			`UPDATE purchase_requests SET status = ?, approver_id = ?, updated_at = ? WHERE id = ?`,
+			to, approverID, time.Now(), id,
+		)
+		return err
+	})
+}
+
+func (r *ProcurementRepo) AddComment(id domProcurement.RequestID, comment domProcurement.Comment) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		request, err := getRequest(tx, id)
+		if err != nil {
+			return err
+		}
+
+		comments, err := marshalJSON(append(request.Comments, comment))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`UPDATE purchase_requests SET comments = ? WHERE id = ?`, comments, id)
+		return err
+	})
+}
+
+func getRequest(q queryRower, id domProcurement.RequestID) (domProcurement.Request, error) {
+	request, err := scanRequest(q.QueryRow(`SELECT `+requestColumns+` FROM purchase_requests WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domProcurement.Request{}, usecase.ErrPurchaseRequestNotFound
+	}
+	return request, err
+}
+
+func scanRequest(row rowScanner) (domProcurement.Request, error) {
+	var (
+		request         domProcurement.Request
+		items, comments string
+	)
+	err := row.Scan(&request.ID, &request.AuthorID, &items, &request.EstimatedCost, &request.Status,
+		&request.ApproverID, &comments, &request.CreatedAt, &request.UpdatedAt)
+	if err != nil {
+		return domProcurement.Request{}, err
+	}
+	if err := unmarshalJSON(items, &request.Items); err != nil {
+		return domProcurement.Request{}, err
+	}
+	if err := unmarshalJSON(comments, &request.Comments); err != nil {
+		return domProcurement.Request{}, err
+	}
+	return request, nil
+}
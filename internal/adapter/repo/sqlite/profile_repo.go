@@ -0,0 +1,51 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+type DietaryProfileRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.DietaryProfileRepository = (*DietaryProfileRepo)(nil)
+
+func NewDietaryProfileRepo(db *sql.DB) *DietaryProfileRepo {
+	return &DietaryProfileRepo{db: db}
+}
+
+func (r *DietaryProfileRepo) GetProfile(userID domUser.UserID) (*domUser.DietaryProfile, error) {
+	profile := domUser.DietaryProfile{UserID: userID}
+	var allergens string
+	err := r.db.QueryRow(
+		`SELECT allergens, restrictions, updated_at FROM dietary_profiles WHERE user_id = ?`, userID,
+	).Scan(&allergens, &profile.Restrictions, &profile.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &profile, nil
+	}
+	if err != nil {
+		return &domUser.DietaryProfile{}, err
+	}
+	if err := unmarshalJSON(allergens, &profile.Allergens); err != nil {
+		return &domUser.DietaryProfile{}, err
+	}
+	return &profile, nil
+}
+
+func (r *DietaryProfileRepo) SaveProfile(profile domUser.DietaryProfile) error {
+	allergens, err := marshalJSON(profile.Allergens)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(
+		`INSERT INTO dietary_profiles (user_id, allergens, restrictions, updated_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET allergens = excluded.allergens, restrictions = excluded.restrictions, updated_at = excluded.updated_at`,
+		profile.UserID, allergens, profile.Restrictions, profile.UpdatedAt,
+	)
+	return err
+}
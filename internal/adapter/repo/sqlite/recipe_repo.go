@@ -0,0 +1,57 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domRecipe "canteen-app/internal/domain/recipe"
+	"canteen-app/internal/usecase"
+)
+
+type RecipeRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.RecipeRepository = (*RecipeRepo)(nil)
+
+func NewRecipeRepo(db *sql.DB) *RecipeRepo {
+	return &RecipeRepo{db: db}
+}
+
+func (r *RecipeRepo) GetRecipe(dishID domMenu.DishID) (*domRecipe.Recipe, error) {
+	recipe := domRecipe.Recipe{DishID: dishID}
+	var ingredients string
+	err := r.db.QueryRow(
+		`SELECT ingredients, updated_at FROM recipes WHERE dish_id = ?`, dishID,
+	).Scan(&ingredients, &recipe.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &recipe, nil
+	}
+	if err != nil {
+		return &domRecipe.Recipe{}, err
+	}
+	if err := unmarshalJSON(ingredients, &recipe.Ingredients); err != nil {
+		return &domRecipe.Recipe{}, err
+	}
+	return &recipe, nil
+}
+
+func (r *RecipeRepo) SaveRecipe(recipe domRecipe.Recipe) error {
+	if len(recipe.Ingredients) == 0 {
+		_, err := r.db.Exec(`DELETE FROM recipes WHERE dish_id = ?`, recipe.DishID)
+		return err
+	}
+
+	ingredients, err := marshalJSON(recipe.Ingredients)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(
+		`INSERT INTO recipes (dish_id, ingredients, updated_at) VALUES (?, ?, ?)
+		ON CONFLICT (dish_id) DO UPDATE SET ingredients = excluded.ingredients, updated_at = excluded.updated_at`,
+		recipe.DishID, ingredients, recipe.UpdatedAt,
+	)
+	return err
+}
@@ -0,0 +1,145 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+const refreshColumns = `token_id, user_id, family_id, user_agent, ip, created_at, last_used_at, expires_at, rotated_at`
+
+// RefreshRepo stores times in UTC and compares them with julianday, which
+// understands the zone offset the driver writes, rather than as text.
+type RefreshRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.RefreshTokenRepository = (*RefreshRepo)(nil)
+
+func NewRefreshRepo(db *sql.DB) *RefreshRepo {
+	return &RefreshRepo{db: db}
+}
+
+func (r *RefreshRepo) Save(token domAuth.RefreshToken) error {
+	return saveRefresh(r.db, token)
+}
+
+func (r *RefreshRepo) Get(tokenID string) (*domAuth.RefreshToken, error) {
+	return getRefresh(r.db, tokenID)
+}
+
+func (r *RefreshRepo) Rotate(tokenID string, next domAuth.RefreshToken) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		token, err := getRefresh(tx, tokenID)
+		if err != nil {
+			return err
+		}
+		if token.Rotated() {
+			return usecase.ErrRefreshRotated
+		}
+
+		if _, err := tx.Exec(`UPDATE refresh_tokens SET rotated_at = ? WHERE token_id = ?`, time.Now().UTC(), tokenID); err != nil {
+			return err
+		}
+		return saveRefresh(tx, next)
+	})
+}
+
+func (r *RefreshRepo) ListActive(userID domUser.UserID, now time.Time) ([]domAuth.RefreshToken, error) {
+	rows, err := r.db.Query(
+		`SELECT `+refreshColumns+` FROM refresh_tokens
+		WHERE user_id = ? AND rotated_at IS NULL AND julianday(expires_at) > julianday(?)
+		ORDER BY julianday(created_at), family_id`,
+		userID, now.UTC(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]domAuth.RefreshToken, 0)
+	for rows.Next() {
+		token, err := scanRefresh(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, *token)
+	}
+	return tokens, rows.Err()
+}
+
+func (r *RefreshRepo) DeleteFamily(familyID string) error {
+	_, err := r.db.Exec(`DELETE FROM refresh_tokens WHERE family_id = ?`, familyID)
+	return err
+}
+
+func (r *RefreshRepo) DeleteByUser(userID domUser.UserID) error {
+	_, err := r.db.Exec(`DELETE FROM refresh_tokens WHERE user_id = ?`, userID)
+	return err
+}
+
+func (r *RefreshRepo) DeleteExpired(now time.Time) (int, error) {
+	res, err := r.db.Exec(`DELETE FROM refresh_tokens WHERE julianday(expires_at) <= julianday(?)`, now.UTC())
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func (r *RefreshRepo) CountActive(now time.Time) (int, error) {
+	var n int
+	err := r.db.QueryRow(
+		`SELECT COUNT(*) FROM refresh_tokens WHERE julianday(expires_at) > julianday(?) AND rotated_at IS NULL`,
+		now.UTC(),
+	).Scan(&n)
+	return n, err
+}
+
+func saveRefresh(db execer, token domAuth.RefreshToken) error {
+	var rotatedAt sql.NullTime
+	if token.Rotated() {
+		rotatedAt = sql.NullTime{Time: token.RotatedAt.UTC(), Valid: true}
+	}
+
+	_, err := db.Exec(
+		`INSERT INTO refresh_tokens (`+refreshColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (token_id) DO UPDATE SET user_id = excluded.user_id, family_id = excluded.family_id,
+			user_agent = excluded.user_agent, ip = excluded.ip, created_at = excluded.created_at,
+			last_used_at = excluded.last_used_at, expires_at = excluded.expires_at, rotated_at = excluded.rotated_at`,
+		token.ID, token.UserID, token.FamilyID, token.Client.UserAgent, token.Client.IP,
+		token.CreatedAt.UTC(), token.LastUsedAt.UTC(), token.ExpiresAt.UTC(), rotatedAt,
+	)
+	return err
+}
+
+func getRefresh(db queryRower, tokenID string) (*domAuth.RefreshToken, error) {
+	token, err := scanRefresh(db.QueryRow(
+		`SELECT `+refreshColumns+` FROM refresh_tokens WHERE token_id = ? AND julianday(expires_at) > julianday(?)`,
+		tokenID, time.Now().UTC(),
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, usecase.ErrRefreshNotFound
+	}
+	return token, err
+}
+
+func scanRefresh(row rowScanner) (*domAuth.RefreshToken, error) {
+	var (
+		token     domAuth.RefreshToken
+		rotatedAt sql.NullTime
+	)
+	err := row.Scan(
+		&token.ID, &token.UserID, &token.FamilyID, &token.Client.UserAgent, &token.Client.IP,
+		&token.CreatedAt, &token.LastUsedAt, &token.ExpiresAt, &rotatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	token.RotatedAt = rotatedAt.Time
+	return &token, nil
+}
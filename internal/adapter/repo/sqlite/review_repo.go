@@ -0,0 +1,127 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domReview "canteen-app/internal/domain/review"
+	"canteen-app/internal/usecase"
+)
+
+const reviewColumns = `id, student_id, order_id, dish_id, rating, comment, hidden, created_at`
+
+type ReviewRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.ReviewRepository = (*ReviewRepo)(nil)
+
+func NewReviewRepo(db *sql.DB) *ReviewRepo {
+	return &ReviewRepo{db: db}
+}
+
+func (r *ReviewRepo) CreateReview(review domReview.Review) (domReview.ReviewID, error) {
+	res, err := r.db.Exec(
+		`INSERT INTO reviews (student_id, order_id, dish_id, rating, comment, hidden, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		review.StudentID, review.OrderID, review.DishID, review.Rating, review.Comment, review.Hidden, review.CreatedAt,
+	)
+	if isUniqueViolation(err) {
+		return 0, usecase.ErrAlreadyReviewed
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return domReview.ReviewID(id), nil
+}
+
+func (r *ReviewRepo) GetReviewByID(id domReview.ReviewID) (*domReview.Review, error) {
+	review, err := scanReview(r.db.QueryRow(`SELECT `+reviewColumns+` FROM reviews WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domReview.Review{}, usecase.ErrReviewNotFound
+	}
+	if err != nil {
+		return &domReview.Review{}, err
+	}
+	return &review, nil
+}
+
+func (r *ReviewRepo) ListReviews(dishID domMenu.DishID, includeHidden bool) ([]domReview.Review, error) {
+	query := `SELECT ` + reviewColumns + ` FROM reviews WHERE (? = 0 OR dish_id = ?)`
+	if !includeHidden {
+		query += ` AND NOT hidden`
+	}
+
+	rows, err := r.db.Query(query+` ORDER BY id`, dishID, dishID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := make([]domReview.Review, 0)
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	return reviews, rows.Err()
+}
+
+func (r *ReviewRepo) SetHidden(id domReview.ReviewID, hidden bool) error {
+	res, err := r.db.Exec(`UPDATE reviews SET hidden = ? WHERE id = ?`, hidden, id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return usecase.ErrReviewNotFound
+	}
+	return nil
+}
+
+func (r *ReviewRepo) Ratings(dishIDs []domMenu.DishID) (map[domMenu.DishID]domReview.Rating, error) {
+	ratings := make(map[domMenu.DishID]domReview.Rating)
+	if len(dishIDs) == 0 {
+		return ratings, nil
+	}
+
+	args := make([]any, 0, len(dishIDs))
+	for _, id := range dishIDs {
+		args = append(args, id)
+	}
+
+	rows, err := r.db.Query(
+		`SELECT dish_id, AVG(rating), COUNT(*) FROM reviews
+		WHERE dish_id IN (?`+strings.Repeat(", ?", len(dishIDs)-1)+`) GROUP BY dish_id`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rating domReview.Rating
+		if err := rows.Scan(&rating.DishID, &rating.Average, &rating.Count); err != nil {
+			return nil, err
+		}
+		ratings[rating.DishID] = rating
+	}
+	return ratings, rows.Err()
+}
+
+func scanReview(row rowScanner) (domReview.Review, error) {
+	var review domReview.Review
+	err := row.Scan(&review.ID, &review.StudentID, &review.OrderID, &review.DishID, &review.Rating,
+		&review.Comment, &review.Hidden, &review.CreatedAt)
+	return review, err
+}
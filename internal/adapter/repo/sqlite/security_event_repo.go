@@ -0,0 +1,56 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+type SecurityEventRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.SecurityEventRepository = (*SecurityEventRepo)(nil)
+
+func NewSecurityEventRepo(db *sql.DB) *SecurityEventRepo {
+	return &SecurityEventRepo{db: db}
+}
+
+func (r *SecurityEventRepo) RecordEvent(event domAuth.SecurityEvent) (domAuth.SecurityEventID, error) {
+	res, err := r.db.Exec(
+		`INSERT INTO security_events (user_id, kind, details, created_at) VALUES (?, ?, ?, ?)`,
+		event.UserID, event.Kind, event.Details, event.CreatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return domAuth.SecurityEventID(id), nil
+}
+
+func (r *SecurityEventRepo) ListEvents(userID domUser.UserID) ([]domAuth.SecurityEvent, error) {
+	rows, err := r.db.Query(
+		`SELECT id, user_id, kind, details, created_at FROM security_events WHERE user_id = ? ORDER BY id`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]domAuth.SecurityEvent, 0)
+	for rows.Next() {
+		var event domAuth.SecurityEvent
+		if err := rows.Scan(&event.ID, &event.UserID, &event.Kind, &event.Details, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
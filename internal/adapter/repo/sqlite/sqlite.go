@@ -0,0 +1,137 @@
+// Package sqlite implements the repositories on top of an embedded SQLite
+// database file, for deployments that run on a single server.
+package sqlite
+
+import (
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"canteen-app/internal/adapter/repo/migrations"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// MigrationsDir is where the migrations live in the source tree; new ones
+// are created there.
+const MigrationsDir = "internal/adapter/repo/sqlite/migrations"
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+const dateLayout = "2006-01-02"
+
+// Open opens the database file, creating an empty one if needed. The schema
+// is managed by the migrator. SQLite allows a single writer, so the pool is
+// limited to one connection and every transaction sees the database
+// exclusively.
+func Open(path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite3", "file:"+path+"?_busy_timeout=5000&_journal_mode=WAL")
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite: %w", err)
+	}
+	db.SetMaxOpenConns(1)
+
+	return db, nil
+}
+
+func NewMigrator(db *sql.DB) (*migrations.Migrator, error) {
+	fsys, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	return migrations.New(db, fsys)
+}
+
+func withTx(db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// isUniqueViolation also covers primary keys, which SQLite reports with a
+// code of their own.
+func isUniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique || sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+}
+
+// Lists of value objects, such as order items, are kept in JSON columns.
+func marshalJSON(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func unmarshalJSON(data string, v any) error {
+	if data == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(data), v)
+}
+
+// Calendar dates are stored as YYYY-MM-DD and read back as UTC midnight,
+// the way the use cases truncate them.
+func formatDate(t time.Time) string {
+	return t.Format(dateLayout)
+}
+
+func parseDate(s string) (time.Time, error) {
+	return time.Parse(dateLayout, s)
+}
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// dateField scans a date column written by formatDate.
+type dateField struct {
+	t *time.Time
+}
+
+func (f dateField) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("unsupported date value %T", src)
+	}
+
+	t, err := parseDate(s)
+	if err != nil {
+		return err
+	}
+	*f.t = t
+	return nil
+}
+
+// queryRower is implemented by both *sql.DB and *sql.Tx.
+type queryRower interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
@@ -0,0 +1,166 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+
+	domSubscription "canteen-app/internal/domain/subscription"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+const (
+	planColumns         = `p.id, p.name, p.meal_type, p.start_date, p.end_date, p.weekdays_only, p.price`
+	subscriptionColumns = `s.id, s.student_id, s.remaining_meals, s.purchased_at, ` + planColumns
+)
+
+type SubscriptionRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.SubscriptionRepository = (*SubscriptionRepo)(nil)
+
+func NewSubscriptionRepo(db *sql.DB) *SubscriptionRepo {
+	return &SubscriptionRepo{db: db}
+}
+
+func (r *SubscriptionRepo) CreatePlan(plan domSubscription.Plan) (domSubscription.PlanID, error) {
+	res, err := r.db.Exec(
+		`INSERT INTO subscription_plans (name, meal_type, start_date, end_date, weekdays_only, price) VALUES (?, ?, ?, ?, ?, ?)`,
+		plan.Name, plan.MealType, formatDate(plan.StartDate), formatDate(plan.EndDate), plan.WeekdaysOnly, plan.Price,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return domSubscription.PlanID(id), nil
+}
+
+func (r *SubscriptionRepo) GetPlanByID(id domSubscription.PlanID) (*domSubscription.Plan, error) {
+	plan, err := scanPlan(r.db.QueryRow(`SELECT `+planColumns+` FROM subscription_plans p WHERE p.id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domSubscription.Plan{}, usecase.ErrPlanNotFound
+	}
+	if err != nil {
+		return &domSubscription.Plan{}, err
+	}
+	return &plan, nil
+}
+
+func (r *SubscriptionRepo) ListPlans() ([]domSubscription.Plan, error) {
+	rows, err := r.db.Query(`SELECT ` + planColumns + ` FROM subscription_plans p ORDER BY p.id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	plans := make([]domSubscription.Plan, 0)
+	for rows.Next() {
+		plan, err := scanPlan(rows)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+	return plans, rows.Err()
+}
+
+// CreateSubscription stores a reference to the plan; plans are never changed
+// after they are created.
+func (r *SubscriptionRepo) CreateSubscription(sub domSubscription.Subscription) (domSubscription.SubscriptionID, error) {
+	res, err := r.db.Exec(
+		`INSERT INTO subscriptions (student_id, plan_id, remaining_meals, purchased_at) VALUES (?, ?, ?, ?)`,
+		sub.StudentID, sub.Plan.ID, sub.RemainingMeals, sub.PurchasedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return domSubscription.SubscriptionID(id), nil
+}
+
+func (r *SubscriptionRepo) GetSubscriptionByID(id domSubscription.SubscriptionID) (*domSubscription.Subscription, error) {
+	sub, err := scanSubscription(r.db.QueryRow(
+		`SELECT `+subscriptionColumns+` FROM subscriptions s JOIN subscription_plans p ON p.id = s.plan_id WHERE s.id = ?`, id,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domSubscription.Subscription{}, usecase.ErrSubscriptionNotFound
+	}
+	if err != nil {
+		return &domSubscription.Subscription{}, err
+	}
+	return &sub, nil
+}
+
+func (r *SubscriptionRepo) ListSubscriptionsByStudent(studentID domUser.UserID) ([]domSubscription.Subscription, error) {
+	rows, err := r.db.Query(
+		`SELECT `+subscriptionColumns+` FROM subscriptions s JOIN subscription_plans p ON p.id = s.plan_id
+		WHERE s.student_id = ? ORDER BY s.id`, studentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subs := make([]domSubscription.Subscription, 0)
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	return subs, rows.Err()
+}
+
+func (r *SubscriptionRepo) DecrementRemaining(id domSubscription.SubscriptionID) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		var remaining int
+		err := tx.QueryRow(`SELECT remaining_meals FROM subscriptions WHERE id = ?`, id).Scan(&remaining)
+		if errors.Is(err, sql.ErrNoRows) {
+			return usecase.ErrSubscriptionNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if remaining <= 0 {
+			return usecase.ErrSubscriptionUsedUp
+		}
+
+		_, err = tx.Exec(`UPDATE subscriptions SET remaining_meals = remaining_meals - 1 WHERE id = ?`, id)
+		return err
+	})
+}
+
+func scanPlan(row rowScanner) (domSubscription.Plan, error) {
+	var plan domSubscription.Plan
+	if err := row.Scan(planFields(&plan)...); err != nil {
+		return domSubscription.Plan{}, err
+	}
+	return plan, nil
+}
+
+func scanSubscription(row rowScanner) (domSubscription.Subscription, error) {
+	var sub domSubscription.Subscription
+	fields := append([]any{&sub.ID, &sub.StudentID, &sub.RemainingMeals, &sub.PurchasedAt}, planFields(&sub.Plan)...)
+	if err := row.Scan(fields...); err != nil {
+		return domSubscription.Subscription{}, err
+	}
+	return sub, nil
+}
+
+func planFields(plan *domSubscription.Plan) []any {
+	return []any{
+		&plan.ID, &plan.Name, &plan.MealType,
+		dateField{&plan.StartDate}, dateField{&plan.EndDate},
+		&plan.WeekdaysOnly, &plan.Price,
+	}
+}
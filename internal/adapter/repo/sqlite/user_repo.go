@@ -0,0 +1,164 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+)
+
+type UserRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.UserRepository = (*UserRepo)(nil)
+
+func NewUserRepo(db *sql.DB) *UserRepo {
+	return &UserRepo{db: db}
+}
+
+const userColumns = `id, login, password_hash, name, surname, role, email, blocked`
+
+func scanUser(row rowScanner) (domUser.User, error) {
+	var user domUser.User
+	err := row.Scan(&user.ID, &user.Login, &user.PasswordHash, &user.Name, &user.Surname, &user.Role, &user.Email, &user.Blocked)
+	return user, err
+}
+
+func (r *UserRepo) CreateUser(user domUser.User) (domUser.UserID, error) {
+	res, err := r.db.Exec(
+		`INSERT INTO users (login, password_hash, name, surname, role, email, blocked) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		user.Login, user.PasswordHash, user.Name, user.Surname, user.Role, user.Email, user.Blocked,
+	)
+	if isUniqueViolation(err) {
+		return 0, takenColumn(err)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return domUser.UserID(id), nil
+}
+
+func (r *UserRepo) GetUserByID(id domUser.UserID) (*domUser.User, error) {
+	return r.getUser(`SELECT `+userColumns+` FROM users WHERE id = ?`, id)
+}
+
+func (r *UserRepo) GetUserByLogin(login string) (*domUser.User, error) {
+	return r.getUser(`SELECT `+userColumns+` FROM users WHERE login = ?`, login)
+}
+
+func (r *UserRepo) GetUserByEmail(email string) (*domUser.User, error) {
+	return r.getUser(`SELECT `+userColumns+` FROM users WHERE email = ? AND email <> ''`, email)
+}
+
+func (r *UserRepo) getUser(query string, arg any) (*domUser.User, error) {
+	user, err := scanUser(r.db.QueryRow(query, arg))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domUser.User{}, usecase.ErrUserNotFound
+	}
+	if err != nil {
+		return &domUser.User{}, err
+	}
+	return &user, nil
+}
+
+func (r *UserRepo) SetPasswordHash(id domUser.UserID, hash string) error {
+	res, err := r.db.Exec(`UPDATE users SET password_hash = ? WHERE id = ?`, hash, id)
+	return userAffected(res, err)
+}
+
+// ListUsers ignores case for ASCII letters only, as SQLite LIKE does.
+func (r *UserRepo) ListUsers(query domUser.ListQuery) ([]domUser.User, int, error) {
+	where := ` WHERE 1 = 1`
+	var args []any
+	if query.Role != "" {
+		where += ` AND role = ?`
+		args = append(args, query.Role)
+	}
+	if query.Search != "" {
+		pattern := likePattern(query.Search)
+		where += ` AND (login LIKE ? ESCAPE '\' OR name LIKE ? ESCAPE '\' OR surname LIKE ? ESCAPE '\')`
+		args = append(args, pattern, pattern, pattern)
+	}
+
+	var total int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM users`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	limit := -1
+	if query.Limit > 0 {
+		limit = query.Limit
+	}
+	rows, err := r.db.Query(
+		`SELECT `+userColumns+` FROM users`+where+` ORDER BY id LIMIT ? OFFSET ?`,
+		append(args, limit, max(query.Offset, 0))...,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	users := make([]domUser.User, 0)
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+func (r *UserRepo) UpdateUser(user domUser.User) error {
+	res, err := r.db.Exec(
+		`UPDATE users SET name = ?, surname = ?, role = ?, blocked = ? WHERE id = ?`,
+		user.Name, user.Surname, user.Role, user.Blocked, user.ID,
+	)
+	return userAffected(res, err)
+}
+
+func (r *UserRepo) DeleteUser(id domUser.UserID) error {
+	res, err := r.db.Exec(`DELETE FROM users WHERE id = ?`, id)
+	return userAffected(res, err)
+}
+
+// takenColumn tells a taken email from a taken login by the constraint
+// SQLite names in the error.
+func takenColumn(err error) error {
+	if strings.Contains(err.Error(), "users.email") {
+		return usecase.ErrEmailInUse
+	}
+	return usecase.ErrLoginInUse
+}
+
+// userAffected maps an update of no rows to ErrUserNotFound.
+func userAffected(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return usecase.ErrUserNotFound
+	}
+	return nil
+}
+
+// likePattern matches values containing s, with the LIKE wildcards in s
+// escaped by a backslash.
+func likePattern(s string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s) + "%"
+}
@@ -0,0 +1,113 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
+	"canteen-app/internal/usecase"
+)
+
+type WalletRepo struct {
+	db *sql.DB
+}
+
+var _ usecase.WalletRepository = (*WalletRepo)(nil)
+
+func NewWalletRepo(db *sql.DB) *WalletRepo {
+	return &WalletRepo{db: db}
+}
+
+func (r *WalletRepo) Append(entry domWallet.Entry) (domWallet.EntryID, error) {
+	var id domWallet.EntryID
+	err := withTx(r.db, func(tx *sql.Tx) error {
+		if entry.Kind == domWallet.Charge && entry.OrderID != 0 {
+			if err := checkNotExists(tx, usecase.ErrAlreadyCharged,
+				`SELECT COUNT(CASE WHEN kind = ? THEN 1 END) > COUNT(CASE WHEN kind = ? THEN 1 END)
+				FROM wallet_entries WHERE order_id = ?`, domWallet.Charge, domWallet.Refund, entry.OrderID,
+			); err != nil {
+				return err
+			}
+		}
+
+		if entry.Kind == domWallet.TopUp && entry.PaymentID != "" {
+			if err := checkNotExists(tx, usecase.ErrAlreadyCredited,
+				`SELECT EXISTS (SELECT 1 FROM wallet_entries WHERE kind = ? AND payment_id = ?)`, entry.Kind, entry.PaymentID,
+			); err != nil {
+				return err
+			}
+		}
+
+		if entry.Amount < 0 {
+			balance, err := balance(tx, entry.StudentID)
+			if err != nil {
+				return err
+			}
+			if balance+entry.Amount < 0 {
+				return usecase.ErrInsufficientFunds
+			}
+		}
+
+		res, err := tx.Exec(
+			`INSERT INTO wallet_entries (student_id, kind, amount, order_id, payment_id, author_id, comment, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			entry.StudentID, entry.Kind, entry.Amount, entry.OrderID, entry.PaymentID, entry.AuthorID, entry.Comment, entry.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		lastID, err := res.LastInsertId()
+		id = domWallet.EntryID(lastID)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *WalletRepo) ListEntries(studentID domUser.UserID) ([]domWallet.Entry, error) {
+	rows, err := r.db.Query(
+		`SELECT id, student_id, kind, amount, order_id, payment_id, author_id, comment, created_at
+		FROM wallet_entries WHERE student_id = ? ORDER BY id`, studentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]domWallet.Entry, 0)
+	for rows.Next() {
+		var e domWallet.Entry
+		if err := rows.Scan(&e.ID, &e.StudentID, &e.Kind, &e.Amount, &e.OrderID, &e.PaymentID, &e.AuthorID, &e.Comment, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func (r *WalletRepo) Balance(studentID domUser.UserID) (int64, error) {
+	return balance(r.db, studentID)
+}
+
+func balance(q queryRower, studentID domUser.UserID) (int64, error) {
+	var balance int64
+	err := q.QueryRow(
+		`SELECT COALESCE(SUM(amount), 0) FROM wallet_entries WHERE student_id = ?`, studentID,
+	).Scan(&balance)
+	return balance, err
+}
+
+// checkNotExists runs an EXISTS query and reports errExists when it matches.
+func checkNotExists(tx *sql.Tx, errExists error, query string, args ...any) error {
+	var exists bool
+	if err := tx.QueryRow(query, args...).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return errExists
+	}
+	return nil
+}
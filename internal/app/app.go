@@ -1,39 +1,337 @@
 package app
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	"canteen-app/internal/adapter/http"
 	jwtadapter "canteen-app/internal/adapter/jwt"
+	"canteen-app/internal/adapter/mail/outbox"
+	"canteen-app/internal/adapter/metrics"
+	"canteen-app/internal/adapter/payment/fake"
+	"canteen-app/internal/adapter/ratelimit"
+	"canteen-app/internal/adapter/repo/migrations"
 	"canteen-app/internal/adapter/repo/ram_storage"
+	"canteen-app/internal/adapter/repo/sqlite"
 	"canteen-app/internal/adapter/security/password"
+	"canteen-app/internal/config"
+	domAuth "canteen-app/internal/domain/auth"
+	domPayment "canteen-app/internal/domain/payment"
 	"canteen-app/internal/usecase"
-
-	"github.com/gin-gonic/gin"
 )
 
+// App owns the HTTP server and the resources behind it. Run serves until the
+// context is cancelled; Start and Shutdown give tests finer control.
 type App struct {
-	router *gin.Engine
+	server          *nethttp.Server
+	shutdownTimeout time.Duration
+	janitor         *usecase.RefreshJanitor
+	// closers are called on shutdown in order, after the server has drained:
+	// background workers first, then the storage they use.
+	closers []func() error
+
+	mu           sync.Mutex // guards listener
+	listener     net.Listener
+	serveErr     chan error
+	shutdownOnce sync.Once
+	shutdownErr  error
+}
+
+// repositories are the storage ports of the use cases.
+type repositories struct {
+	users         usecase.UserRepository
+	refresh       usecase.RefreshTokenRepository
+	events        usecase.SecurityEventRepository
+	invitations   usecase.InvitationRepository
+	passwords     usecase.PasswordTokenRepository
+	menus         usecase.MenuRepository
+	orders        usecase.OrderRepository
+	wallets       usecase.WalletRepository
+	payments      usecase.PaymentRepository
+	subscriptions usecase.SubscriptionRepository
+	profiles      usecase.DietaryProfileRepository
+	reviews       usecase.ReviewRepository
+	inventory     usecase.InventoryRepository
+	procurement   usecase.ProcurementRepository
+	recipes       usecase.RecipeRepository
+
+	// attempts are short-lived, so they stay in memory whatever the
+	// storage; a restart only forgets failed sign-ins early.
+	attempts usecase.LoginAttemptRepository
+}
+
+func newRAMRepositories() repositories {
+	return repositories{
+		users:         ram_storage.NewUserRepo(),
+		refresh:       ram_storage.NewRefreshRepo(),
+		events:        ram_storage.NewSecurityEventRepo(),
+		invitations:   ram_storage.NewInvitationRepo(),
+		passwords:     ram_storage.NewPasswordTokenRepo(),
+		menus:         ram_storage.NewMenuRepo(),
+		orders:        ram_storage.NewOrderRepo(),
+		wallets:       ram_storage.NewWalletRepo(),
+		payments:      ram_storage.NewPaymentRepo(),
+		subscriptions: ram_storage.NewSubscriptionRepo(),
+		profiles:      ram_storage.NewDietaryProfileRepo(),
+		reviews:       ram_storage.NewReviewRepo(),
+		inventory:     ram_storage.NewInventoryRepo(),
+		procurement:   ram_storage.NewProcurementRepo(),
+		recipes:       ram_storage.NewRecipeRepo(),
+		attempts:      ram_storage.NewLoginAttemptRepo(),
+	}
+}
+
+func newSQLiteRepositories(db *sql.DB) repositories {
+	return repositories{
+		users:         sqlite.NewUserRepo(db),
+		refresh:       sqlite.NewRefreshRepo(db),
+		events:        sqlite.NewSecurityEventRepo(db),
+		invitations:   sqlite.NewInvitationRepo(db),
+		passwords:     sqlite.NewPasswordTokenRepo(db),
+		menus:         sqlite.NewMenuRepo(db),
+		orders:        sqlite.NewOrderRepo(db),
+		wallets:       sqlite.NewWalletRepo(db),
+		payments:      sqlite.NewPaymentRepo(db),
+		subscriptions: sqlite.NewSubscriptionRepo(db),
+		profiles:      sqlite.NewDietaryProfileRepo(db),
+		reviews:       sqlite.NewReviewRepo(db),
+		inventory:     sqlite.NewInventoryRepo(db),
+		procurement:   sqlite.NewProcurementRepo(db),
+		recipes:       sqlite.NewRecipeRepo(db),
+		attempts:      ram_storage.NewLoginAttemptRepo(),
+	}
 }
 
-func New() (*App, error) {
-	userRepo := ram_storage.NewUserRepo()
-	refreshRepo := ram_storage.NewRefreshRepo()
+func New(cfg config.Config) (*App, error) {
+	var (
+		db    *sql.DB
+		err   error
+		repos repositories
+	)
 
-	accessTTL := time.Hour * 4
-	refreshTTL := time.Hour * 24 * 30
+	switch cfg.Storage.Driver {
+	case config.StoragePostgres:
+		// Postgres only has the account tables so far. Accounts that survive
+		// a restart while their wallets, orders and subscriptions do not
+		// would lose money, so it is refused until the rest exists.
+		return nil, errPostgresIncomplete
+	case config.StorageSQLite:
+		if db, err = sqlite.Open(cfg.Storage.SQLitePath); err != nil {
+			return nil, err
+		}
+		if err := checkSchema(db, sqlite.NewMigrator); err != nil {
+			return nil, err
+		}
+		repos = newSQLiteRepositories(db)
+	default:
+		repos = newRAMRepositories()
+	}
 
-	tokenSvc := jwtadapter.NewJWTTokenService([]byte("SECRET"), []byte("SECRET2"), accessTTL, refreshTTL, "issuer")
+	accessTTL := cfg.Auth.AccessTTL
+	refreshTTL := cfg.Auth.RefreshTTL
+
+	tokenSvc := jwtadapter.NewJWTTokenService([]byte(cfg.Auth.AccessSecret), []byte(cfg.Auth.RefreshSecret), accessTTL, refreshTTL, cfg.Auth.Issuer)
 	bhasher := password.BcryptHasher{}
-	authUC := usecase.NewAuthUseCase(userRepo, tokenSvc, refreshRepo, bhasher)
+	guard := usecase.NewLoginGuard(repos.attempts, lockoutPolicy(cfg.Auth.Lockout), lockoutPolicy(cfg.Auth.IPLockout))
+	authUC := usecase.NewAuthUseCase(repos.users, tokenSvc, repos.refresh, repos.events, repos.invitations, bhasher, guard)
+	accountUC := usecase.NewAccountUseCase(repos.users, repos.refresh, repos.invitations, repos.passwords, bhasher, guard, cfg.Auth.SetPasswordTTL)
+	// A fresh install has nobody who could create accounts, so the admin
+	// named in the config is created on start and gets a set-password link.
+	if login := cfg.Auth.BootstrapAdmin; login != "" {
+		setup, err := accountUC.EnsureAdmin(login)
+		if err != nil {
+			if db != nil {
+				db.Close()
+			}
+			return nil, fmt.Errorf("bootstrap admin %q: %w", login, err)
+		}
+		if setup != nil {
+			log.Printf("created admin %q; set its password at %s/set-password?token=%s (valid until %s)",
+				login, strings.TrimSuffix(cfg.HTTP.PublicURL, "/"), url.QueryEscape(setup.Token), setup.ExpiresAt.Format(time.RFC3339))
+		}
+	}
+	mailer, err := outbox.NewOutbox(cfg.Mail.OutboxDir, cfg.Mail.From)
+	if err != nil {
+		if db != nil {
+			db.Close()
+		}
+		return nil, err
+	}
+	resetUC := usecase.NewPasswordResetUseCase(repos.users, repos.passwords, mailer, strings.TrimSuffix(cfg.HTTP.PublicURL, "/")+"/set-password?token=", cfg.Auth.ResetPasswordTTL)
+	menuUC := usecase.NewMenuUseCase(repos.menus)
+	recipeUC := usecase.NewRecipeUseCase(repos.recipes, repos.menus, repos.inventory)
+	orderUC := usecase.NewOrderUseCase(repos.orders, repos.menus, repos.wallets, repos.subscriptions, repos.profiles, recipeUC)
+	walletUC := usecase.NewWalletUseCase(repos.wallets, repos.users)
+	// The fake acquirer pays whatever it is asked to, so it and its checkout
+	// page only exist in dev; elsewhere top-ups fail until a real gateway is
+	// wired in.
+	var (
+		gateway usecase.PaymentGateway = noGateway{}
+		fakeGW  *fake.Gateway
+	)
+	if cfg.Env == config.EnvDev {
+		fakeGW = fake.NewGateway([]byte(cfg.Payments.WebhookSecret), "/fake-gateway/checkout", strings.TrimSuffix(cfg.HTTP.PublicURL, "/")+"/api/payments/webhook")
+		gateway = fakeGW
+	} else {
+		log.Printf("no payment gateway in %s, wallet top-ups are disabled", cfg.Env)
+	}
+	paymentUC := usecase.NewPaymentUseCase(repos.payments, repos.wallets, gateway)
+	subscriptionUC := usecase.NewSubscriptionUseCase(repos.subscriptions, repos.wallets)
+	profileUC := usecase.NewProfileUseCase(repos.profiles)
+	reviewUC := usecase.NewReviewUseCase(repos.reviews, repos.orders)
+	inventoryUC := usecase.NewInventoryUseCase(repos.inventory, repos.menus)
+	procurementUC := usecase.NewProcurementUseCase(repos.procurement, repos.inventory)
 	validator := http.NewValidator()
-	router := http.NewRouter(authUC, accessTTL, refreshTTL, tokenSvc, validator)
+	signInLimiter := ratelimit.NewMemory(cfg.HTTP.SignInLimit.Requests, cfg.HTTP.SignInLimit.Window)
+	router, err := http.NewRouter(authUC, accountUC, resetUC, menuUC, orderUC, walletUC, paymentUC, subscriptionUC, profileUC, reviewUC, inventoryUC, procurementUC, recipeUC, accessTTL, refreshTTL, cfg.HTTP.SecureCookies, cfg.HTTP.TrustedProxies, cfg.HTTP.PublicURL, signInLimiter, tokenSvc, validator)
+	if err != nil {
+		if db != nil {
+			db.Close()
+		}
+		return nil, err
+	}
+
+	if fakeGW != nil {
+		fakeGW.RegisterRoutes(router, "/orders")
+	}
+
+	a := &App{
+		server: &nethttp.Server{
+			Addr:              cfg.HTTP.Addr,
+			Handler:           router,
+			ReadTimeout:       cfg.HTTP.ReadTimeout,
+			ReadHeaderTimeout: cfg.HTTP.ReadTimeout,
+			WriteTimeout:      cfg.HTTP.WriteTimeout,
+			IdleTimeout:       cfg.HTTP.IdleTimeout,
+		},
+		shutdownTimeout: cfg.HTTP.ShutdownTimeout,
+		janitor:         usecase.NewRefreshJanitor(repos.refresh, metrics.Sessions{}, cfg.Auth.RefreshCleanupInterval),
+		serveErr:        make(chan error, 1),
+	}
+	a.closers = append(a.closers, a.janitor.Stop)
+	if db != nil {
+		a.closers = append(a.closers, db.Close)
+	}
+	return a, nil
+}
+
+var errPostgresIncomplete = errors.New("postgres storage only keeps accounts so far, use sqlite")
+
+var errNoPaymentGateway = errors.New("no payment gateway configured")
+
+// noGateway stands in for the payment gateway outside dev, where the fake one
+// must not run: every payment fails.
+type noGateway struct{}
+
+func (noGateway) CreatePayment(domPayment.PaymentID, int64, string) (string, error) {
+	return "", errNoPaymentGateway
+}
+
+func (noGateway) ParseWebhook([]byte, string) (domPayment.Event, error) {
+	return domPayment.Event{}, errNoPaymentGateway
+}
+
+func lockoutPolicy(c config.LockoutConfig) domAuth.LockoutPolicy {
+	return domAuth.LockoutPolicy{
+		FreeFailures:    c.FreeFailures,
+		BaseDelay:       c.BaseDelay,
+		MaxDelay:        c.MaxDelay,
+		MaxFailures:     c.MaxFailures,
+		LockoutDuration: c.LockoutDuration,
+		Window:          c.Window,
+	}
+}
+
+// checkSchema refuses to work with a database whose schema is behind the
+// migrations of this build. The database is closed on failure.
+func checkSchema(db *sql.DB, newMigrator func(*sql.DB) (*migrations.Migrator, error)) error {
+	migrator, err := newMigrator(db)
+	if err == nil {
+		err = migrator.Check()
+	}
+	if err != nil {
+		db.Close()
+		return fmt.Errorf("%w; run `migrate up` first", err)
+	}
+	return nil
+}
+
+// Start binds the listen address and serves requests in the background,
+// along with the background workers.
+func (a *App) Start() error {
+	ln, err := net.Listen("tcp", a.server.Addr)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	a.listener = ln
+	a.mu.Unlock()
+
+	a.janitor.Start()
+
+	go func() {
+		if err := a.server.Serve(ln); !errors.Is(err, nethttp.ErrServerClosed) {
+			a.serveErr <- err
+		}
+	}()
+	return nil
+}
+
+// Addr is the address the server listens on once started.
+func (a *App) Addr() net.Addr {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.listener == nil {
+		return nil
+	}
+	return a.listener.Addr()
+}
+
+// Run serves until ctx is cancelled or the server fails, then shuts down,
+// giving in-flight requests the configured drain deadline.
+func (a *App) Run(ctx context.Context) error {
+	if err := a.Start(); err != nil {
+		return errors.Join(err, a.Shutdown(context.Background()))
+	}
+	log.Printf("listening on %s", a.Addr())
+
+	var serveErr error
+	select {
+	case <-ctx.Done():
+		log.Printf("shutting down, waiting up to %s for requests to finish", a.shutdownTimeout)
+	case serveErr = <-a.serveErr:
+	}
 
-	return &App{
-		router: router,
-	}, nil
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), a.shutdownTimeout)
+	defer cancel()
+	return errors.Join(serveErr, a.Shutdown(shutdownCtx))
 }
 
-func (a App) Run(port string) error {
-	return a.router.Run(port)
+// Shutdown stops accepting connections and waits for in-flight requests
+// until ctx is done, then stops the background workers and closes the
+// storage. Only the first call has an effect.
+func (a *App) Shutdown(ctx context.Context) error {
+	a.shutdownOnce.Do(func() {
+		var errs []error
+		if err := a.server.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("drain http server: %w", err))
+		}
+		for _, closeFn := range a.closers {
+			if err := closeFn(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		a.shutdownErr = errors.Join(errs...)
+	})
+	return a.shutdownErr
 }
@@ -0,0 +1,211 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"canteen-app/internal/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestApp builds the app on the default config, adjusted by modify.
+func newTestApp(t *testing.T, modify ...func(*config.Config)) *App {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	t.Chdir("../..") // templates are loaded relative to the repository root
+
+	cfg := config.Default()
+	cfg.HTTP.Addr = "127.0.0.1:0"
+	cfg.HTTP.ShutdownTimeout = time.Second
+	cfg.Mail.OutboxDir = t.TempDir()
+	for _, m := range modify {
+		m(&cfg)
+	}
+
+	a, err := New(cfg)
+	require.NoError(t, err)
+	return a
+}
+
+func TestApp_StartShutdown(t *testing.T) {
+	a := newTestApp(t)
+	require.NoError(t, a.Start())
+	url := "http://" + a.Addr().String() + "/api/menu/dishes"
+
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	require.NoError(t, a.Shutdown(context.Background()))
+	assert.NoError(t, a.Shutdown(context.Background()), "second call is a no-op")
+
+	_, err = http.Get(url)
+	assert.Error(t, err, "server no longer accepts connections")
+}
+
+func TestApp_ShutdownDrainsRequests(t *testing.T) {
+	a := newTestApp(t)
+	started := make(chan struct{})
+	a.server.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var order []string
+	a.closers = []func() error{
+		func() error { order = append(order, "worker"); return nil },
+		func() error { order = append(order, "storage"); return nil },
+	}
+	require.NoError(t, a.Start())
+
+	done := make(chan int)
+	go func() {
+		resp, err := http.Get("http://" + a.Addr().String())
+		if err != nil {
+			done <- 0
+			return
+		}
+		resp.Body.Close()
+		done <- resp.StatusCode
+	}()
+	<-started
+
+	require.NoError(t, a.Shutdown(context.Background()))
+	assert.Equal(t, http.StatusOK, <-done, "in-flight request completes")
+	assert.Equal(t, []string{"worker", "storage"}, order)
+}
+
+func TestApp_ShutdownDeadline(t *testing.T) {
+	a := newTestApp(t)
+	started, release := make(chan struct{}), make(chan struct{})
+	a.server.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})
+	closed := false
+	a.closers = []func() error{func() error { closed = true; return nil }}
+	require.NoError(t, a.Start())
+	defer close(release)
+
+	go func() {
+		if resp, err := http.Get("http://" + a.Addr().String()); err == nil {
+			resp.Body.Close()
+		}
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := a.Shutdown(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, closed, "resources are released even when the drain times out")
+}
+
+func TestApp_RunStopsOnCancel(t *testing.T) {
+	a := newTestApp(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	result := make(chan error)
+	go func() { result <- a.Run(ctx) }()
+
+	require.Eventually(t, func() bool { return a.Addr() != nil }, time.Second, 10*time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-result:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run did not return after cancel")
+	}
+}
+
+func TestApp_RunFailsOnBusyAddress(t *testing.T) {
+	first := newTestApp(t)
+	require.NoError(t, first.Start())
+	defer first.Shutdown(context.Background())
+
+	cfg := config.Default()
+	cfg.HTTP.Addr = first.Addr().String()
+	cfg.Mail.OutboxDir = t.TempDir()
+	second, err := New(cfg)
+	require.NoError(t, err)
+
+	err = second.Run(context.Background())
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, context.Canceled))
+}
+
+func TestApp_NoFakeGatewayInProd(t *testing.T) {
+	a := newTestApp(t, func(c *config.Config) { c.Env = config.EnvProd })
+
+	for _, route := range a.server.Handler.(*gin.Engine).Routes() {
+		assert.False(t, strings.HasPrefix(route.Path, "/fake-gateway/"), "%s %s is mounted in prod", route.Method, route.Path)
+	}
+}
+
+func TestApp_RefusesIncompletePostgres(t *testing.T) {
+	cfg := config.Default()
+	cfg.Storage.Driver = config.StoragePostgres
+	cfg.Storage.PostgresDSN = "postgres://canteen@localhost/canteen"
+
+	_, err := New(cfg)
+	assert.ErrorIs(t, err, errPostgresIncomplete)
+}
+
+func TestApp_ForwardedForIsNotTrusted(t *testing.T) {
+	// signIn tries a wrong password for a fresh login each time, so that
+	// only the limits per client IP come into play.
+	signIn := func(a *App, n int, forwardedFor string) *httptest.ResponseRecorder {
+		body := fmt.Sprintf(`{"login":"nobody%d","password":"wrong"}`, n)
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/login", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		w := httptest.NewRecorder()
+		a.server.Handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("rate limit", func(t *testing.T) {
+		a := newTestApp(t, func(c *config.Config) { c.HTTP.SignInLimit.Requests = 2 })
+
+		assert.Equal(t, http.StatusUnauthorized, signIn(a, 1, "203.0.113.1").Code)
+		assert.Equal(t, http.StatusUnauthorized, signIn(a, 2, "203.0.113.2").Code)
+		w := signIn(a, 3, "203.0.113.3")
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Contains(t, w.Body.String(), "too many requests")
+	})
+
+	t.Run("ip lockout", func(t *testing.T) {
+		a := newTestApp(t, func(c *config.Config) {
+			c.Auth.IPLockout = config.LockoutConfig{BaseDelay: time.Hour, MaxDelay: time.Hour, Window: time.Hour}
+		})
+
+		assert.Equal(t, http.StatusUnauthorized, signIn(a, 1, "203.0.113.1").Code)
+		w := signIn(a, 2, "203.0.113.2")
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Contains(t, w.Body.String(), "too many sign-in attempts")
+	})
+
+	t.Run("trusted proxy", func(t *testing.T) {
+		a := newTestApp(t, func(c *config.Config) {
+			c.HTTP.SignInLimit.Requests = 2
+			c.HTTP.TrustedProxies = []string{"192.0.2.1"} // the peer of httptest requests
+		})
+
+		for n := 1; n <= 3; n++ {
+			assert.Equal(t, http.StatusUnauthorized, signIn(a, n, fmt.Sprintf("203.0.113.%d", n)).Code)
+		}
+	})
+}
@@ -0,0 +1,207 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	jwtadapter "canteen-app/internal/adapter/jwt"
+	"canteen-app/internal/config"
+	domUser "canteen-app/internal/domain/user"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	admins   = []domUser.Role{domUser.RoleAdmin}
+	staff    = []domUser.Role{domUser.RoleAdmin, domUser.RoleEmployee}
+	students = []domUser.Role{domUser.RoleStudent}
+)
+
+// routeRoles lists every route of the app with the roles allowed on it; nil
+// stands for routes open to anyone, or to any signed-in user.
+var routeRoles = []struct {
+	method  string
+	path    string
+	allowed []domUser.Role
+}{
+	{"POST", "/api/auth/register", nil},
+	{"POST", "/api/auth/login", nil},
+	{"POST", "/api/auth/logout", nil},
+	{"GET", "/api/auth/refresh", nil},
+	{"GET", "/api/auth/sessions", nil},
+	{"DELETE", "/api/auth/sessions", nil},
+	{"DELETE", "/api/auth/sessions/:id", nil},
+	{"POST", "/api/auth/set-password", nil},
+	{"POST", "/api/auth/forgot-password", nil},
+	{"DELETE", "/api/admin/users/:id/sessions", admins},
+	{"POST", "/api/admin/users", admins},
+	{"GET", "/api/admin/users", admins},
+	{"GET", "/api/admin/users/:id", admins},
+	{"PUT", "/api/admin/users/:id/role", admins},
+	{"POST", "/api/admin/users/:id/block", admins},
+	{"POST", "/api/admin/users/:id/unblock", admins},
+	{"POST", "/api/admin/users/:id/unlock", admins},
+	{"POST", "/api/admin/users/:id/reset-password", admins},
+	{"DELETE", "/api/admin/users/:id", admins},
+	{"POST", "/api/admin/invitations", admins},
+	{"GET", "/api/admin/invitations", admins},
+	{"DELETE", "/api/admin/invitations/:code", admins},
+
+	{"GET", "/api/inventory/low-stock", staff},
+	{"GET", "/api/inventory/products", staff},
+	{"POST", "/api/inventory/products", staff},
+	{"GET", "/api/inventory/products/:id", staff},
+	{"PUT", "/api/inventory/products/:id", staff},
+	{"GET", "/api/inventory/products/:id/movements", staff},
+	{"POST", "/api/inventory/products/:id/movements", staff},
+
+	{"GET", "/api/menu", nil},
+	{"GET", "/api/menu/:id", nil},
+	{"GET", "/api/menu/dishes", nil},
+	{"POST", "/api/menu", staff},
+	{"PUT", "/api/menu/:id", staff},
+	{"POST", "/api/menu/dishes", staff},
+	{"PUT", "/api/menu/dishes/:id", staff},
+	{"GET", "/api/menu/dishes/:id/recipe", staff},
+	{"PUT", "/api/menu/dishes/:id/recipe", staff},
+	{"GET", "/api/menu/:id/coverage", staff},
+	{"PUT", "/api/menu/:id/portions", staff},
+
+	{"GET", "/api/orders", students},
+	{"POST", "/api/orders", students},
+	{"GET", "/api/orders/:id", students},
+	{"POST", "/api/orders/:id/pay", students},
+	{"POST", "/api/orders/:id/cancel", students},
+	{"GET", "/api/orders/queue", staff},
+	{"POST", "/api/orders/:id/prepare", staff},
+	{"POST", "/api/orders/:id/issue", staff},
+
+	{"POST", "/api/payments/webhook", nil},
+	{"POST", "/api/payments/top-up", students},
+	{"GET", "/api/payments/:id", students},
+
+	{"GET", "/api/procurement/requests", staff},
+	{"POST", "/api/procurement/requests", staff},
+	{"GET", "/api/procurement/requests/:id", staff},
+	{"PUT", "/api/procurement/requests/:id/items", staff},
+	{"POST", "/api/procurement/requests/:id/submit", staff},
+	{"POST", "/api/procurement/requests/:id/comments", staff},
+	{"POST", "/api/procurement/requests/:id/fulfill", staff},
+	{"POST", "/api/procurement/requests/:id/approve", admins},
+	{"POST", "/api/procurement/requests/:id/reject", admins},
+
+	{"GET", "/api/profile/diet", students},
+	{"PUT", "/api/profile/diet", students},
+
+	{"GET", "/api/reviews", nil},
+	{"POST", "/api/reviews", students},
+	{"GET", "/api/reviews/moderation", admins},
+	{"POST", "/api/reviews/:id/hide", admins},
+	{"POST", "/api/reviews/:id/unhide", admins},
+
+	{"GET", "/api/subscriptions/plans", nil},
+	{"POST", "/api/subscriptions/plans", admins},
+	{"GET", "/api/subscriptions", students},
+	{"POST", "/api/subscriptions/plans/:id/purchase", students},
+
+	{"GET", "/api/wallet", students},
+	{"GET", "/api/wallet/students/:id", admins},
+	{"POST", "/api/wallet/students/:id/entries", admins},
+
+	{"GET", "/swagger/*any", nil},
+	{"GET", "/debug/vars", admins},
+	{"GET", "/fake-gateway/checkout/:id", nil},
+	{"POST", "/fake-gateway/checkout/:id", nil},
+
+	{"GET", "/register", nil},
+	{"POST", "/register", nil},
+	{"GET", "/login", nil},
+	{"POST", "/login", nil},
+	{"POST", "/logout", nil},
+	{"GET", "/set-password", nil},
+	{"POST", "/set-password", nil},
+	{"GET", "/forgot-password", nil},
+	{"POST", "/forgot-password", nil},
+	{"GET", "/home", nil},
+
+	{"GET", "/admin/users", admins},
+	{"GET", "/admin/users/:id", admins},
+	{"POST", "/admin/users/:id/role", admins},
+	{"POST", "/admin/users/:id/block", admins},
+	{"POST", "/admin/users/:id/unblock", admins},
+	{"POST", "/admin/users/:id/unlock", admins},
+	{"POST", "/admin/users/:id/reset-password", admins},
+	{"POST", "/admin/users/:id/delete", admins},
+
+	{"GET", "/inventory", staff},
+	{"POST", "/inventory/products", staff},
+	{"POST", "/inventory/products/:id/movements", staff},
+
+	{"GET", "/orders", students},
+	{"POST", "/orders", students},
+	{"POST", "/orders/:id/pay", students},
+	{"POST", "/orders/:id/cancel", students},
+	{"GET", "/orders/queue", staff},
+	{"POST", "/orders/:id/prepare", staff},
+	{"POST", "/orders/:id/issue", staff},
+
+	{"POST", "/payments/top-up", students},
+
+	{"GET", "/procurement", staff},
+	{"POST", "/procurement/requests", staff},
+	{"POST", "/procurement/requests/:id/submit", staff},
+	{"POST", "/procurement/requests/:id/comments", staff},
+	{"POST", "/procurement/requests/:id/fulfill", staff},
+	{"POST", "/procurement/requests/:id/approve", admins},
+	{"POST", "/procurement/requests/:id/reject", admins},
+
+	{"GET", "/profile", students},
+	{"POST", "/profile", students},
+}
+
+func TestRoutes_EveryRouteIsListed(t *testing.T) {
+	a := newTestApp(t)
+
+	listed := make(map[string]bool, len(routeRoles))
+	for _, route := range routeRoles {
+		listed[route.method+" "+route.path] = true
+	}
+
+	for _, route := range a.server.Handler.(*gin.Engine).Routes() {
+		assert.True(t, listed[route.Method+" "+route.Path], "%s %s is missing from routeRoles", route.Method, route.Path)
+	}
+}
+
+func TestRoutes_Roles(t *testing.T) {
+	a := newTestApp(t)
+	cfg := config.Default()
+	tokenSvc := jwtadapter.NewJWTTokenService([]byte(cfg.Auth.AccessSecret), []byte(cfg.Auth.RefreshSecret), cfg.Auth.AccessTTL, cfg.Auth.RefreshTTL, cfg.Auth.Issuer)
+
+	roles := []domUser.Role{domUser.RoleAdmin, domUser.RoleEmployee, domUser.RoleStudent, "janitor"}
+
+	for _, route := range routeRoles {
+		for _, role := range roles {
+			t.Run(route.method+" "+route.path+" as "+string(role), func(t *testing.T) {
+				token, err := tokenSvc.GenerateAccessToken(1, role)
+				require.NoError(t, err)
+
+				path := strings.NewReplacer(":id", "1", ":code", "K7QX2MPA", "*any", "index.html").Replace(route.path)
+				req := httptest.NewRequest(route.method, path, nil)
+				req.Header.Set("Authorization", "Bearer "+token)
+				req.AddCookie(&http.Cookie{Name: "access_token", Value: token})
+
+				w := httptest.NewRecorder()
+				a.server.Handler.ServeHTTP(w, req)
+
+				forbidden := w.Code == http.StatusForbidden && strings.Contains(w.Body.String(), `"error":"forbidden"`)
+				wantForbidden := route.allowed != nil && !slices.Contains(route.allowed, role)
+				assert.Equal(t, wantForbidden, forbidden, "status %d", w.Code)
+			})
+		}
+	}
+}
@@ -1 +1,440 @@
+// Package config loads the application settings from an optional YAML file
+// and CANTEEN_* environment variables, which take precedence over the file.
 package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environments.
+const (
+	EnvDev  = "dev"
+	EnvProd = "prod"
+)
+
+// Storage backends.
+const (
+	StorageRAM      = "ram"
+	StoragePostgres = "postgres"
+	StorageSQLite   = "sqlite"
+)
+
+// Development defaults for the secrets; Validate rejects them outside dev.
+const (
+	defaultAccessSecret  = "SECRET"
+	defaultRefreshSecret = "SECRET2"
+	defaultPaymentSecret = "PAYMENT_SECRET"
+)
+
+// minSecretLen applies outside dev.
+const minSecretLen = 32
+
+var ErrInvalidConfig = errors.New("invalid config")
+
+type Config struct {
+	// Env is dev or prod. Development defaults are only accepted in dev.
+	Env      string         `yaml:"env"`
+	HTTP     HTTPConfig     `yaml:"http"`
+	Auth     AuthConfig     `yaml:"auth"`
+	Storage  StorageConfig  `yaml:"storage"`
+	Payments PaymentsConfig `yaml:"payments"`
+	Mail     MailConfig     `yaml:"mail"`
+}
+
+type HTTPConfig struct {
+	Addr string `yaml:"addr"`
+	// PublicURL is the address clients and the payment gateway reach the
+	// server at.
+	PublicURL string `yaml:"public_url"`
+	// SecureCookies marks cookies Secure; enable when served over HTTPS.
+	SecureCookies bool `yaml:"secure_cookies"`
+	// TrustedProxies are the IPs or CIDR ranges of the reverse proxies whose
+	// X-Forwarded-For header is believed. With none, the client IP used for
+	// rate limits, lockouts and the session list is the peer address.
+	TrustedProxies []string `yaml:"trusted_proxies"`
+
+	ReadTimeout  time.Duration `yaml:"read_timeout"`
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+	IdleTimeout  time.Duration `yaml:"idle_timeout"`
+	// ShutdownTimeout is how long in-flight requests may take to finish
+	// after a shutdown signal.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// SignInLimit caps the requests a client IP may send to each sign-in,
+	// registration, set-password and forgot-password route.
+	SignInLimit RateLimitConfig `yaml:"sign_in_limit"`
+}
+
+type RateLimitConfig struct {
+	Requests int           `yaml:"requests"`
+	Window   time.Duration `yaml:"window"`
+}
+
+type AuthConfig struct {
+	AccessSecret  string        `yaml:"access_secret"`
+	RefreshSecret string        `yaml:"refresh_secret"`
+	AccessTTL     time.Duration `yaml:"access_ttl"`
+	RefreshTTL    time.Duration `yaml:"refresh_ttl"`
+	Issuer        string        `yaml:"issuer"`
+	// RefreshCleanupInterval is how often expired refresh tokens are purged.
+	RefreshCleanupInterval time.Duration `yaml:"refresh_cleanup_interval"`
+	// SetPasswordTTL is how long the set-password link of an account
+	// created by an admin stays valid.
+	SetPasswordTTL time.Duration `yaml:"set_password_ttl"`
+	// ResetPasswordTTL is how long a mailed password reset link stays
+	// valid.
+	ResetPasswordTTL time.Duration `yaml:"reset_password_ttl"`
+	// BootstrapAdmin is the login of an admin account created on start if
+	// missing; its set-password link is written to the log.
+	BootstrapAdmin string `yaml:"bootstrap_admin"`
+	// Lockout throttles wrong passwords per login, IPLockout per client IP.
+	Lockout   LockoutConfig `yaml:"lockout"`
+	IPLockout LockoutConfig `yaml:"ip_lockout"`
+}
+
+// LockoutConfig mirrors auth.LockoutPolicy: after FreeFailures wrong
+// passwords each attempt waits BaseDelay, doubled per failure up to
+// MaxDelay, and MaxFailures, unless 0, lock sign-in for LockoutDuration.
+// Failures older than Window are forgotten.
+type LockoutConfig struct {
+	FreeFailures    int           `yaml:"free_failures"`
+	BaseDelay       time.Duration `yaml:"base_delay"`
+	MaxDelay        time.Duration `yaml:"max_delay"`
+	MaxFailures     int           `yaml:"max_failures"`
+	LockoutDuration time.Duration `yaml:"lockout_duration"`
+	Window          time.Duration `yaml:"window"`
+}
+
+type StorageConfig struct {
+	// Driver selects where data is kept. Postgres only has the account
+	// tables so far: its schema can be migrated, but the app refuses to run
+	// on it until it keeps everything, as SQLite does.
+	Driver      string `yaml:"driver"`
+	PostgresDSN string `yaml:"postgres_dsn"`
+	SQLitePath  string `yaml:"sqlite_path"`
+}
+
+type PaymentsConfig struct {
+	WebhookSecret string `yaml:"webhook_secret"`
+}
+
+type MailConfig struct {
+	// OutboxDir receives outgoing mail, one .eml file per message, for
+	// whatever delivers it onwards to pick up.
+	OutboxDir string `yaml:"outbox_dir"`
+	// From is the sender address of outgoing mail.
+	From string `yaml:"from"`
+}
+
+func Default() Config {
+	return Config{
+		Env: EnvDev,
+		HTTP: HTTPConfig{
+			Addr:            ":8080",
+			PublicURL:       "http://localhost:8080",
+			ReadTimeout:     15 * time.Second,
+			WriteTimeout:    30 * time.Second,
+			IdleTimeout:     2 * time.Minute,
+			ShutdownTimeout: 15 * time.Second,
+			SignInLimit: RateLimitConfig{
+				Requests: 30,
+				Window:   time.Minute,
+			},
+		},
+		Auth: AuthConfig{
+			AccessSecret:  defaultAccessSecret,
+			RefreshSecret: defaultRefreshSecret,
+			AccessTTL:     4 * time.Hour,
+			RefreshTTL:    30 * 24 * time.Hour,
+			Issuer:        "canteen-app",
+
+			RefreshCleanupInterval: 10 * time.Minute,
+			SetPasswordTTL:         72 * time.Hour,
+			ResetPasswordTTL:       time.Hour,
+
+			Lockout: LockoutConfig{
+				FreeFailures:    3,
+				BaseDelay:       time.Second,
+				MaxDelay:        time.Minute,
+				MaxFailures:     10,
+				LockoutDuration: 15 * time.Minute,
+				Window:          time.Hour,
+			},
+			// A whole school may share one address, so it is only slowed
+			// down, never locked.
+			IPLockout: LockoutConfig{
+				FreeFailures: 20,
+				BaseDelay:    time.Second,
+				MaxDelay:     30 * time.Second,
+				Window:       time.Hour,
+			},
+		},
+		Storage: StorageConfig{
+			Driver:     StorageRAM,
+			SQLitePath: "canteen.db",
+		},
+		Payments: PaymentsConfig{
+			WebhookSecret: defaultPaymentSecret,
+		},
+		Mail: MailConfig{
+			OutboxDir: "outbox",
+			From:      "canteen@localhost",
+		},
+	}
+}
+
+// Load starts from Default, applies the YAML file at path, if any, then the
+// environment, and validates the result. Environment variables:
+//
+//	CANTEEN_ENV                  dev (default) or prod
+//	CANTEEN_HTTP_ADDR            listen address, :8080 by default
+//	CANTEEN_PUBLIC_URL           external base URL of the server
+//	CANTEEN_SECURE_COOKIES       true to mark cookies Secure
+//	CANTEEN_TRUSTED_PROXIES      comma-separated IPs or CIDRs of reverse proxies
+//	CANTEEN_HTTP_READ_TIMEOUT    e.g. 15s
+//	CANTEEN_HTTP_WRITE_TIMEOUT   e.g. 30s
+//	CANTEEN_HTTP_IDLE_TIMEOUT    e.g. 2m
+//	CANTEEN_SHUTDOWN_TIMEOUT     drain deadline on shutdown, e.g. 15s
+//	CANTEEN_SIGN_IN_LIMIT        sign-in requests per client IP and window
+//	CANTEEN_SIGN_IN_WINDOW       window of CANTEEN_SIGN_IN_LIMIT, e.g. 1m
+//	CANTEEN_ACCESS_SECRET        JWT access token signing key
+//	CANTEEN_REFRESH_SECRET       JWT refresh token signing key
+//	CANTEEN_ACCESS_TTL           access token lifetime, e.g. 4h
+//	CANTEEN_REFRESH_TTL          refresh token lifetime, e.g. 720h
+//	CANTEEN_JWT_ISSUER           JWT issuer
+//	CANTEEN_REFRESH_CLEANUP      expired refresh token purge interval, e.g. 10m
+//	CANTEEN_SET_PASSWORD_TTL     set-password link lifetime, e.g. 72h
+//	CANTEEN_RESET_PASSWORD_TTL   mailed password reset link lifetime, e.g. 1h
+//	CANTEEN_BOOTSTRAP_ADMIN      login of an admin to create on start if missing
+//	CANTEEN_LOCKOUT_FAILURES     wrong passwords that lock a login, 0 to never lock
+//	CANTEEN_LOCKOUT_DURATION     how long a login stays locked, e.g. 15m
+//	CANTEEN_STORAGE              ram (default), postgres or sqlite
+//	CANTEEN_POSTGRES_DSN         connection string, required for postgres
+//	CANTEEN_SQLITE_PATH          database file for sqlite, canteen.db by default
+//	CANTEEN_PAYMENT_SECRET       payment webhook signing key
+//	CANTEEN_MAIL_OUTBOX          directory outgoing mail is written to, outbox by default
+//	CANTEEN_MAIL_FROM            sender address of outgoing mail
+func Load(path string) (Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return Config{}, fmt.Errorf("read config: %w", err)
+		}
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&cfg); err != nil {
+			return Config{}, fmt.Errorf("parse config %s: %w", path, err)
+		}
+	}
+
+	if err := cfg.applyEnv(os.LookupEnv); err != nil {
+		return Config{}, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) applyEnv(lookup func(string) (string, bool)) error {
+	strs := map[string]*string{
+		"CANTEEN_ENV":             &c.Env,
+		"CANTEEN_HTTP_ADDR":       &c.HTTP.Addr,
+		"CANTEEN_PUBLIC_URL":      &c.HTTP.PublicURL,
+		"CANTEEN_ACCESS_SECRET":   &c.Auth.AccessSecret,
+		"CANTEEN_REFRESH_SECRET":  &c.Auth.RefreshSecret,
+		"CANTEEN_JWT_ISSUER":      &c.Auth.Issuer,
+		"CANTEEN_BOOTSTRAP_ADMIN": &c.Auth.BootstrapAdmin,
+		"CANTEEN_STORAGE":         &c.Storage.Driver,
+		"CANTEEN_POSTGRES_DSN":    &c.Storage.PostgresDSN,
+		"CANTEEN_SQLITE_PATH":     &c.Storage.SQLitePath,
+		"CANTEEN_PAYMENT_SECRET":  &c.Payments.WebhookSecret,
+		"CANTEEN_MAIL_OUTBOX":     &c.Mail.OutboxDir,
+		"CANTEEN_MAIL_FROM":       &c.Mail.From,
+	}
+	for name, field := range strs {
+		if v, ok := lookup(name); ok && v != "" {
+			*field = v
+		}
+	}
+
+	durations := map[string]*time.Duration{
+		"CANTEEN_HTTP_READ_TIMEOUT":  &c.HTTP.ReadTimeout,
+		"CANTEEN_HTTP_WRITE_TIMEOUT": &c.HTTP.WriteTimeout,
+		"CANTEEN_HTTP_IDLE_TIMEOUT":  &c.HTTP.IdleTimeout,
+		"CANTEEN_SHUTDOWN_TIMEOUT":   &c.HTTP.ShutdownTimeout,
+		"CANTEEN_ACCESS_TTL":         &c.Auth.AccessTTL,
+		"CANTEEN_REFRESH_TTL":        &c.Auth.RefreshTTL,
+		"CANTEEN_REFRESH_CLEANUP":    &c.Auth.RefreshCleanupInterval,
+		"CANTEEN_SET_PASSWORD_TTL":   &c.Auth.SetPasswordTTL,
+		"CANTEEN_RESET_PASSWORD_TTL": &c.Auth.ResetPasswordTTL,
+		"CANTEEN_SIGN_IN_WINDOW":     &c.HTTP.SignInLimit.Window,
+		"CANTEEN_LOCKOUT_DURATION":   &c.Auth.Lockout.LockoutDuration,
+	}
+	for name, field := range durations {
+		if v, ok := lookup(name); ok && v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return fmt.Errorf("%w: %s: %v", ErrInvalidConfig, name, err)
+			}
+			*field = d
+		}
+	}
+
+	ints := map[string]*int{
+		"CANTEEN_SIGN_IN_LIMIT":    &c.HTTP.SignInLimit.Requests,
+		"CANTEEN_LOCKOUT_FAILURES": &c.Auth.Lockout.MaxFailures,
+	}
+	for name, field := range ints {
+		if v, ok := lookup(name); ok && v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("%w: %s: %v", ErrInvalidConfig, name, err)
+			}
+			*field = n
+		}
+	}
+
+	if v, ok := lookup("CANTEEN_TRUSTED_PROXIES"); ok && v != "" {
+		c.HTTP.TrustedProxies = strings.Split(v, ",")
+		for i := range c.HTTP.TrustedProxies {
+			c.HTTP.TrustedProxies[i] = strings.TrimSpace(c.HTTP.TrustedProxies[i])
+		}
+	}
+
+	if v, ok := lookup("CANTEEN_SECURE_COOKIES"); ok && v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("%w: CANTEEN_SECURE_COOKIES: %v", ErrInvalidConfig, err)
+		}
+		c.HTTP.SecureCookies = b
+	}
+
+	return nil
+}
+
+// Validate checks the settings are usable. Outside dev it also refuses the
+// built-in development secrets and secrets shorter than 32 bytes.
+func (c Config) Validate() error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	switch c.Env {
+	case EnvDev, EnvProd:
+	default:
+		fail("unknown env %q", c.Env)
+	}
+
+	if c.HTTP.Addr == "" {
+		fail("http.addr is required")
+	}
+	if c.HTTP.PublicURL == "" {
+		fail("http.public_url is required")
+	}
+	if c.HTTP.ReadTimeout < 0 || c.HTTP.WriteTimeout < 0 || c.HTTP.IdleTimeout < 0 {
+		fail("http timeouts must not be negative")
+	}
+	if c.HTTP.ShutdownTimeout <= 0 {
+		fail("http.shutdown_timeout must be positive")
+	}
+	if c.HTTP.SignInLimit.Requests <= 0 || c.HTTP.SignInLimit.Window <= 0 {
+		fail("http.sign_in_limit requests and window must be positive")
+	}
+	for _, proxy := range c.HTTP.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			fail("http.trusted_proxies: %q is neither an IP nor a CIDR range", proxy)
+		}
+	}
+
+	if c.Auth.AccessTTL <= 0 || c.Auth.RefreshTTL <= 0 {
+		fail("auth token lifetimes must be positive")
+	} else if c.Auth.AccessTTL > c.Auth.RefreshTTL {
+		fail("auth.access_ttl must not exceed auth.refresh_ttl")
+	}
+	if c.Auth.RefreshCleanupInterval <= 0 {
+		fail("auth.refresh_cleanup_interval must be positive")
+	}
+	if c.Auth.SetPasswordTTL <= 0 {
+		fail("auth.set_password_ttl must be positive")
+	}
+	if c.Auth.ResetPasswordTTL <= 0 {
+		fail("auth.reset_password_ttl must be positive")
+	}
+	c.Auth.Lockout.validate("auth.lockout", fail)
+	c.Auth.IPLockout.validate("auth.ip_lockout", fail)
+	if c.Auth.AccessSecret == c.Auth.RefreshSecret {
+		fail("auth.access_secret and auth.refresh_secret must differ")
+	}
+
+	secrets := []struct {
+		name, value, dev string
+	}{
+		{"auth.access_secret", c.Auth.AccessSecret, defaultAccessSecret},
+		{"auth.refresh_secret", c.Auth.RefreshSecret, defaultRefreshSecret},
+		{"payments.webhook_secret", c.Payments.WebhookSecret, defaultPaymentSecret},
+	}
+	for _, s := range secrets {
+		switch {
+		case s.value == "":
+			fail("%s is required", s.name)
+		case c.Env == EnvDev:
+		case s.value == s.dev:
+			fail("%s must be changed from the development default in %s", s.name, c.Env)
+		case len(s.value) < minSecretLen:
+			fail("%s must be at least %d bytes in %s", s.name, minSecretLen, c.Env)
+		}
+	}
+
+	switch c.Storage.Driver {
+	case StorageRAM:
+	case StoragePostgres:
+		if c.Storage.PostgresDSN == "" {
+			fail("storage.postgres_dsn is required for %s storage", c.Storage.Driver)
+		}
+	case StorageSQLite:
+		if c.Storage.SQLitePath == "" {
+			fail("storage.sqlite_path is required for %s storage", c.Storage.Driver)
+		}
+	default:
+		fail("unknown storage %q", c.Storage.Driver)
+	}
+
+	if c.Mail.OutboxDir == "" {
+		fail("mail.outbox_dir is required")
+	}
+	if c.Mail.From == "" {
+		fail("mail.from is required")
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, errors.Join(errs...))
+	}
+	return nil
+}
+
+func (l LockoutConfig) validate(name string, fail func(format string, args ...any)) {
+	if l.FreeFailures < 0 || l.MaxFailures < 0 {
+		fail("%s failure counts must not be negative", name)
+	}
+	if l.BaseDelay <= 0 || l.MaxDelay < l.BaseDelay {
+		fail("%s.base_delay must be positive and not exceed max_delay", name)
+	}
+	if l.MaxFailures > 0 && l.LockoutDuration <= 0 {
+		fail("%s.lockout_duration must be positive when max_failures is set", name)
+	}
+	if l.Window <= 0 {
+		fail("%s.window must be positive", name)
+	}
+}
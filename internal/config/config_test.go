@@ -0,0 +1,208 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	prodAccessSecret  = "access-secret-access-secret-access-secret"
+	prodRefreshSecret = "refresh-secret-refresh-secret-refresh-secret"
+	prodPaymentSecret = "payment-secret-payment-secret-payment-secret"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	cfg, err := Load("")
+	require.NoError(t, err)
+	assert.Equal(t, Default(), cfg)
+}
+
+func TestLoad_FileAndEnv(t *testing.T) {
+	path := writeConfig(t, `
+env: prod
+http:
+  addr: ":9090"
+  public_url: "https://canteen.example.com"
+  secure_cookies: true
+  read_timeout: 10s
+auth:
+  access_secret: "`+prodAccessSecret+`"
+  refresh_secret: "`+prodRefreshSecret+`"
+  access_ttl: 15m
+storage:
+  driver: sqlite
+  sqlite_path: /var/lib/canteen.db
+payments:
+  webhook_secret: "`+prodPaymentSecret+`"
+mail:
+  outbox_dir: /var/spool/canteen/outbox
+`)
+	t.Setenv("CANTEEN_HTTP_ADDR", ":7070")
+	t.Setenv("CANTEEN_REFRESH_TTL", "48h")
+	t.Setenv("CANTEEN_SHUTDOWN_TIMEOUT", "5s")
+	t.Setenv("CANTEEN_BOOTSTRAP_ADMIN", "director")
+	t.Setenv("CANTEEN_LOCKOUT_FAILURES", "5")
+	t.Setenv("CANTEEN_RESET_PASSWORD_TTL", "30m")
+	t.Setenv("CANTEEN_MAIL_FROM", "canteen@canteen.example.com")
+	t.Setenv("CANTEEN_TRUSTED_PROXIES", "10.0.0.0/8, 192.0.2.1")
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, EnvProd, cfg.Env)
+	assert.Equal(t, ":7070", cfg.HTTP.Addr, "environment overrides the file")
+	assert.True(t, cfg.HTTP.SecureCookies)
+	assert.Equal(t, []string{"10.0.0.0/8", "192.0.2.1"}, cfg.HTTP.TrustedProxies)
+	assert.Equal(t, 5*time.Second, cfg.HTTP.ShutdownTimeout)
+	assert.Equal(t, 10*time.Second, cfg.HTTP.ReadTimeout)
+	assert.Equal(t, 15*time.Minute, cfg.Auth.AccessTTL)
+	assert.Equal(t, 48*time.Hour, cfg.Auth.RefreshTTL)
+	assert.Equal(t, "canteen-app", cfg.Auth.Issuer, "unset values keep their defaults")
+	assert.Equal(t, "director", cfg.Auth.BootstrapAdmin)
+	assert.Equal(t, 5, cfg.Auth.Lockout.MaxFailures)
+	assert.Equal(t, 30*time.Minute, cfg.Auth.ResetPasswordTTL)
+	assert.Equal(t, "/var/spool/canteen/outbox", cfg.Mail.OutboxDir)
+	assert.Equal(t, "canteen@canteen.example.com", cfg.Mail.From)
+	assert.Equal(t, StorageSQLite, cfg.Storage.Driver)
+	assert.Equal(t, "/var/lib/canteen.db", cfg.Storage.SQLitePath)
+}
+
+func TestLoad_Example(t *testing.T) {
+	cfg, err := Load("../../config.example.yaml")
+	require.NoError(t, err, "the example config starts as shipped")
+	assert.Equal(t, EnvProd, cfg.Env)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		env  map[string]string
+		want string
+	}{
+		{name: "missing file", want: "read config"},
+		{name: "unknown field", file: "http:\n  port: 8080\n", want: "field port not found"},
+		{name: "bad duration", file: "auth:\n  access_ttl: soon\n", want: "parse config"},
+		{name: "bad env duration", env: map[string]string{"CANTEEN_ACCESS_TTL": "soon"}, want: "CANTEEN_ACCESS_TTL"},
+		{name: "bad env int", env: map[string]string{"CANTEEN_SIGN_IN_LIMIT": "many"}, want: "CANTEEN_SIGN_IN_LIMIT"},
+		{name: "bad env bool", env: map[string]string{"CANTEEN_SECURE_COOKIES": "sure"}, want: "CANTEEN_SECURE_COOKIES"},
+		{name: "invalid result", env: map[string]string{"CANTEEN_STORAGE": "mongo"}, want: `unknown storage "mongo"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			path := ""
+			switch {
+			case tt.file != "":
+				path = writeConfig(t, tt.file)
+			case tt.name == "missing file":
+				path = filepath.Join(t.TempDir(), "missing.yaml")
+			}
+
+			_, err := Load(path)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	prod := func() Config {
+		cfg := Default()
+		cfg.Env = EnvProd
+		cfg.Auth.AccessSecret = prodAccessSecret
+		cfg.Auth.RefreshSecret = prodRefreshSecret
+		cfg.Payments.WebhookSecret = prodPaymentSecret
+		return cfg
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		want   []string
+	}{
+		{name: "dev defaults"},
+		{name: "prod with own secrets", modify: func(c *Config) { *c = prod() }},
+		{
+			name:   "prod with default secrets",
+			modify: func(c *Config) { c.Env = EnvProd },
+			want: []string{
+				"auth.access_secret must be changed",
+				"auth.refresh_secret must be changed",
+				"payments.webhook_secret must be changed",
+			},
+		},
+		{
+			name: "prod with short secret",
+			modify: func(c *Config) {
+				*c = prod()
+				c.Auth.AccessSecret = "short"
+			},
+			want: []string{"auth.access_secret must be at least 32 bytes"},
+		},
+		{name: "unknown env", modify: func(c *Config) { c.Env = "staging" }, want: []string{`unknown env "staging"`}},
+		{name: "same secrets", modify: func(c *Config) { c.Auth.RefreshSecret = c.Auth.AccessSecret }, want: []string{"must differ"}},
+		{name: "empty secret", modify: func(c *Config) { c.Payments.WebhookSecret = "" }, want: []string{"payments.webhook_secret is required"}},
+		{name: "zero ttl", modify: func(c *Config) { c.Auth.AccessTTL = 0 }, want: []string{"must be positive"}},
+		{name: "access outlives refresh", modify: func(c *Config) { c.Auth.AccessTTL = 1000 * time.Hour }, want: []string{"must not exceed"}},
+		{name: "no addr", modify: func(c *Config) { c.HTTP.Addr = "" }, want: []string{"http.addr is required"}},
+		{name: "negative timeout", modify: func(c *Config) { c.HTTP.WriteTimeout = -time.Second }, want: []string{"must not be negative"}},
+		{name: "no drain deadline", modify: func(c *Config) { c.HTTP.ShutdownTimeout = 0 }, want: []string{"http.shutdown_timeout must be positive"}},
+		{name: "no refresh cleanup interval", modify: func(c *Config) { c.Auth.RefreshCleanupInterval = 0 }, want: []string{"auth.refresh_cleanup_interval must be positive"}},
+		{name: "no set-password ttl", modify: func(c *Config) { c.Auth.SetPasswordTTL = 0 }, want: []string{"auth.set_password_ttl must be positive"}},
+		{name: "no reset-password ttl", modify: func(c *Config) { c.Auth.ResetPasswordTTL = 0 }, want: []string{"auth.reset_password_ttl must be positive"}},
+		{name: "bad trusted proxy", modify: func(c *Config) { c.HTTP.TrustedProxies = []string{"proxy.local"} }, want: []string{`"proxy.local" is neither an IP nor a CIDR range`}},
+		{name: "no sign-in limit", modify: func(c *Config) { c.HTTP.SignInLimit.Requests = 0 }, want: []string{"http.sign_in_limit requests and window must be positive"}},
+		{name: "lockout without duration", modify: func(c *Config) { c.Auth.Lockout.LockoutDuration = 0 }, want: []string{"auth.lockout.lockout_duration must be positive"}},
+		{name: "ip lockout delays", modify: func(c *Config) { c.Auth.IPLockout.MaxDelay = 0 }, want: []string{"auth.ip_lockout.base_delay must be positive"}},
+		{name: "postgres without dsn", modify: func(c *Config) { c.Storage.Driver = StoragePostgres }, want: []string{"storage.postgres_dsn is required"}},
+		{
+			name:   "sqlite without path",
+			modify: func(c *Config) { c.Storage.Driver, c.Storage.SQLitePath = StorageSQLite, "" },
+			want:   []string{"storage.sqlite_path is required"},
+		},
+		{
+			name:   "no mail outbox",
+			modify: func(c *Config) { c.Mail.OutboxDir, c.Mail.From = "", "" },
+			want:   []string{"mail.outbox_dir is required", "mail.from is required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			if tt.modify != nil {
+				tt.modify(&cfg)
+			}
+
+			err := cfg.Validate()
+			if len(tt.want) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrInvalidConfig)
+			for _, want := range tt.want {
+				assert.True(t, strings.Contains(err.Error(), want), "%q not in %q", want, err.Error())
+			}
+		})
+	}
+}
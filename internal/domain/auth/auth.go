@@ -8,7 +8,7 @@ import (
 
 type Claims struct {
 	UserID    domUser.UserID
-	Role      string
+	Role      domUser.Role
 	ExpiresAt time.Time
 }
 
@@ -16,3 +16,136 @@ type Tokens struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 }
+
+// Client describes the device a request comes from.
+type Client struct {
+	UserAgent string
+	IP        string
+}
+
+// RefreshToken is an issued refresh token. The tokens rotated from one login
+// form a family. A rotated token is kept, with RotatedAt set, until it
+// expires, so that presenting it again is recognised as reuse. CreatedAt is
+// when the family started and LastUsedAt when this token was issued; Client
+// is the device it was issued to.
+type RefreshToken struct {
+	ID         string
+	UserID     domUser.UserID
+	FamilyID   string
+	Client     Client
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	RotatedAt  time.Time
+}
+
+func (t RefreshToken) Rotated() bool {
+	return !t.RotatedAt.IsZero()
+}
+
+// Session is a login on one device, represented by the live token of a
+// refresh token family. Its ID is the family ID.
+type Session struct {
+	ID         string
+	UserID     domUser.UserID
+	Client     Client
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	// Current marks the session of the request.
+	Current bool
+}
+
+type SecurityEventID int64
+
+type SecurityEventKind string
+
+const (
+	// RefreshTokenReuse is recorded when a rotated refresh token is presented
+	// again, which means it has most likely been stolen.
+	RefreshTokenReuse SecurityEventKind = "refresh_token_reuse"
+	// AccountLocked is recorded when too many wrong passwords lock a login.
+	AccountLocked SecurityEventKind = "account_locked"
+)
+
+type SecurityEvent struct {
+	ID        SecurityEventID
+	UserID    domUser.UserID
+	Kind      SecurityEventKind
+	Details   string
+	CreatedAt time.Time
+}
+
+// Invitation lets the students of a class register themselves, up to MaxUses
+// of them, until it expires.
+type Invitation struct {
+	Code      string
+	Class     string
+	CreatedBy domUser.UserID
+	MaxUses   int
+	Uses      int
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (i Invitation) Usable(now time.Time) bool {
+	return i.Uses < i.MaxUses && now.Before(i.ExpiresAt)
+}
+
+// PasswordToken is a one-time secret that lets a user set a password. Only
+// the SHA-256 of the secret, hex-encoded, is stored.
+type PasswordToken struct {
+	Hash      string
+	UserID    domUser.UserID
+	ExpiresAt time.Time
+}
+
+// AccountSetup is what an admin gets back for an account created for someone
+// else: Token, the secret of the password token, goes to the new user.
+type AccountSetup struct {
+	UserID    domUser.UserID
+	Token     string
+	ExpiresAt time.Time
+}
+
+// Attempts are the recent failed sign-ins under one key, a login or a client
+// IP.
+type Attempts struct {
+	Failures    int
+	LastFailure time.Time
+	LockedUntil time.Time
+}
+
+// LockoutPolicy slows down password guessing. Past FreeFailures failures the
+// next attempt has to wait BaseDelay, doubled with every further failure up
+// to MaxDelay. MaxFailures failures lock the key for LockoutDuration; zero
+// never locks. Failures are forgotten Window after the last one.
+type LockoutPolicy struct {
+	FreeFailures    int
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	MaxFailures     int
+	LockoutDuration time.Duration
+	Window          time.Duration
+}
+
+// RetryAt is the earliest time the next attempt is let through.
+func (p LockoutPolicy) RetryAt(a Attempts) time.Time {
+	retry := a.LockedUntil
+	if extra := a.Failures - p.FreeFailures; extra > 0 {
+		delay := p.MaxDelay
+		// Shifting past MaxDelay would only overflow.
+		if extra < 32 && p.BaseDelay<<(extra-1) < p.MaxDelay {
+			delay = p.BaseDelay << (extra - 1)
+		}
+		if at := a.LastFailure.Add(delay); at.After(retry) {
+			retry = at
+		}
+	}
+	return retry
+}
+
+// Locks reports whether a key with the attempts has to be locked.
+func (p LockoutPolicy) Locks(a Attempts) bool {
+	return p.MaxFailures > 0 && a.Failures >= p.MaxFailures
+}
@@ -0,0 +1,68 @@
+package inventory
+
+import (
+	"time"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domUser "canteen-app/internal/domain/user"
+)
+
+type ProductID int64
+
+type MovementID int64
+
+type Unit string
+
+const (
+	Kilogram   Unit = "kg"
+	Gram       Unit = "g"
+	Liter      Unit = "l"
+	Milliliter Unit = "ml"
+	Piece      Unit = "pcs"
+)
+
+var Units = []Unit{Kilogram, Gram, Liter, Milliliter, Piece}
+
+func (u Unit) Valid() bool {
+	for _, known := range Units {
+		if u == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Product is a stock item of the kitchen. Quantity is measured in Unit and
+// changes only through movements.
+type Product struct {
+	ID          ProductID
+	Name        string
+	Unit        Unit
+	Quantity    float64
+	MinQuantity float64 // low-stock threshold
+}
+
+func (p Product) IsLow() bool {
+	return p.Quantity <= p.MinQuantity
+}
+
+type MovementKind string
+
+const (
+	Receipt     MovementKind = "receipt"
+	WriteOff    MovementKind = "write_off"
+	Consumption MovementKind = "consumption"
+)
+
+// Movement is a single change of product stock. Quantity is signed: receipts
+// are positive, write-offs and consumption are negative.
+type Movement struct {
+	ID        MovementID
+	ProductID ProductID
+	Kind      MovementKind
+	Quantity  float64
+	DishID    domMenu.DishID // set for consumption by a dish
+	AuthorID  domUser.UserID
+	Comment   string
+	CreatedAt time.Time
+}
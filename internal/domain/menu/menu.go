@@ -0,0 +1,74 @@
+package menu
+
+import "time"
+
+type DishID int64
+
+type MenuID int64
+
+type MealType string
+
+const (
+	Breakfast MealType = "breakfast"
+	Lunch     MealType = "lunch"
+)
+
+// Allergen is one of the fixed allergen tags a dish can carry and a student
+// can declare in their dietary profile.
+type Allergen string
+
+const (
+	Gluten    Allergen = "gluten"
+	Lactose   Allergen = "lactose"
+	Nuts      Allergen = "nuts"
+	Peanuts   Allergen = "peanuts"
+	Eggs      Allergen = "eggs"
+	Fish      Allergen = "fish"
+	Shellfish Allergen = "shellfish"
+	Soy       Allergen = "soy"
+	Sesame    Allergen = "sesame"
+	Celery    Allergen = "celery"
+	Mustard   Allergen = "mustard"
+)
+
+var Allergens = []Allergen{Gluten, Lactose, Nuts, Peanuts, Eggs, Fish, Shellfish, Soy, Sesame, Celery, Mustard}
+
+func (a Allergen) Valid() bool {
+	for _, known := range Allergens {
+		if a == known {
+			return true
+		}
+	}
+	return false
+}
+
+type Dish struct {
+	ID          DishID
+	Name        string
+	Description string
+	Price       int64 // in kopecks
+	Weight      int   // portion weight in grams
+	Allergens   []Allergen
+}
+
+// Conflicts returns the dish allergens that are present in the given list.
+func (d Dish) Conflicts(allergens []Allergen) []Allergen {
+	var conflicts []Allergen
+	for _, a := range d.Allergens {
+		for _, b := range allergens {
+			if a == b {
+				conflicts = append(conflicts, a)
+				break
+			}
+		}
+	}
+	return conflicts
+}
+
+type Menu struct {
+	ID       MenuID
+	Date     time.Time
+	MealType MealType
+	Dishes   []Dish
+	Portions map[DishID]int // planned portions per dish
+}
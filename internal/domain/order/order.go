@@ -0,0 +1,62 @@
+package order
+
+import (
+	"time"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domSubscription "canteen-app/internal/domain/subscription"
+	domUser "canteen-app/internal/domain/user"
+)
+
+type OrderID int64
+
+type Status string
+
+const (
+	Placed    Status = "placed"
+	Paid      Status = "paid"
+	Prepared  Status = "prepared"
+	Issued    Status = "issued"
+	Cancelled Status = "cancelled"
+)
+
+var transitions = map[Status][]Status{
+	Placed:   {Paid, Cancelled},
+	Paid:     {Prepared, Cancelled},
+	Prepared: {Issued},
+}
+
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+type Item struct {
+	DishID   domMenu.DishID
+	Name     string
+	Price    int64
+	Quantity int
+}
+
+type Order struct {
+	ID        OrderID
+	StudentID domUser.UserID
+	MenuID    domMenu.MenuID
+	Date      time.Time
+	MealType  domMenu.MealType
+	Items     []Item
+	Total     int64
+	Status    Status
+	// SubscriptionID is set when the order was paid by a subscription
+	// instead of the wallet.
+	SubscriptionID domSubscription.SubscriptionID
+	// ConfirmedAllergens lists the allergens from the student's dietary
+	// profile that they explicitly accepted when placing the order.
+	ConfirmedAllergens []domMenu.Allergen
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
@@ -0,0 +1,38 @@
+package payment
+
+import (
+	"time"
+
+	domUser "canteen-app/internal/domain/user"
+)
+
+type PaymentID string
+
+type Status string
+
+const (
+	Pending   Status = "pending"
+	Succeeded Status = "succeeded"
+	Declined  Status = "declined"
+	Expired   Status = "expired"
+)
+
+func (s Status) IsFinal() bool {
+	return s == Succeeded || s == Declined || s == Expired
+}
+
+type Payment struct {
+	ID        PaymentID
+	StudentID domUser.UserID
+	Amount    int64 // in kopecks
+	Status    Status
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Event is a payment status notification received from the gateway webhook.
+type Event struct {
+	PaymentID PaymentID
+	Status    Status
+	Amount    int64
+}
@@ -0,0 +1,77 @@
+package procurement
+
+import (
+	"time"
+
+	domInventory "canteen-app/internal/domain/inventory"
+	domUser "canteen-app/internal/domain/user"
+)
+
+type RequestID int64
+
+type Status string
+
+const (
+	Draft     Status = "draft"
+	Submitted Status = "submitted"
+	Approved  Status = "approved"
+	Rejected  Status = "rejected"
+	Fulfilled Status = "fulfilled"
+)
+
+var Statuses = []Status{Draft, Submitted, Approved, Rejected, Fulfilled}
+
+func (s Status) Valid() bool {
+	for _, known := range Statuses {
+		if s == known {
+			return true
+		}
+	}
+	return false
+}
+
+var transitions = map[Status][]Status{
+	Draft:     {Submitted},
+	Submitted: {Approved, Rejected},
+	Approved:  {Fulfilled},
+}
+
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Item is a product to buy. Name and Unit are copied from the product when
+// the request is drafted.
+type Item struct {
+	ProductID     domInventory.ProductID
+	Name          string
+	Unit          domInventory.Unit
+	Quantity      float64
+	EstimatedCost int64 // for the whole quantity, in kopecks
+}
+
+type Comment struct {
+	AuthorID  domUser.UserID
+	Text      string
+	CreatedAt time.Time
+}
+
+// Request is a purchase request drafted by canteen staff. It goes through
+// admin approval; fulfilling an approved request posts its items to the
+// inventory as receipts.
+type Request struct {
+	ID            RequestID
+	AuthorID      domUser.UserID
+	Items         []Item
+	EstimatedCost int64 // in kopecks
+	Status        Status
+	ApproverID    domUser.UserID // admin who approved or rejected the request
+	Comments      []Comment
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
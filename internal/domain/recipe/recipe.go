@@ -0,0 +1,59 @@
+package recipe
+
+import (
+	"time"
+
+	domInventory "canteen-app/internal/domain/inventory"
+	domMenu "canteen-app/internal/domain/menu"
+)
+
+// Ingredient is the amount of a product, in the product unit, that goes into
+// one portion of a dish.
+type Ingredient struct {
+	ProductID domInventory.ProductID
+	Quantity  float64
+}
+
+// Recipe is the bill of materials of a dish. A dish without ingredients has no
+// recipe and is not tracked in the inventory.
+type Recipe struct {
+	DishID      domMenu.DishID
+	Ingredients []Ingredient
+	UpdatedAt   time.Time
+}
+
+// Shortage is a product whose stock does not cover the planned portions of
+// a menu.
+type Shortage struct {
+	ProductID domInventory.ProductID
+	Name      string
+	Unit      domInventory.Unit
+	Required  float64
+	Available float64
+}
+
+// DishCoverage compares the planned portions of a dish with the number of
+// portions the current stock is enough for. Available is -1 for dishes
+// without a recipe.
+type DishCoverage struct {
+	DishID    domMenu.DishID
+	Name      string
+	Planned   int
+	Available int
+}
+
+func (d DishCoverage) Covered() bool {
+	return d.Available < 0 || d.Planned <= d.Available
+}
+
+// Coverage is the stock check of a menu. Dishes are checked one by one,
+// Shortages account for all planned portions of the menu together.
+type Coverage struct {
+	MenuID    domMenu.MenuID
+	Dishes    []DishCoverage
+	Shortages []Shortage
+}
+
+func (c Coverage) Covered() bool {
+	return len(c.Shortages) == 0
+}
@@ -0,0 +1,37 @@
+package review
+
+import (
+	"time"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domUser "canteen-app/internal/domain/user"
+)
+
+type ReviewID int64
+
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+// Review is a student's rating of a dish they received in an issued order.
+// Hidden reviews are moderated by an admin: their comment is not shown to
+// other users, but the rating still counts towards the dish rating.
+type Review struct {
+	ID        ReviewID
+	StudentID domUser.UserID
+	OrderID   domOrder.OrderID
+	DishID    domMenu.DishID
+	Rating    int
+	Comment   string
+	Hidden    bool
+	CreatedAt time.Time
+}
+
+// Rating is the aggregate of all reviews of a dish.
+type Rating struct {
+	DishID  domMenu.DishID
+	Average float64
+	Count   int
+}
@@ -0,0 +1,63 @@
+package subscription
+
+import (
+	"time"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domUser "canteen-app/internal/domain/user"
+)
+
+type PlanID int64
+
+type SubscriptionID int64
+
+// Plan is a season ticket offered by the canteen, e.g. "lunch, weekdays,
+// October". It covers one meal of the given type on every day of the
+// [StartDate, EndDate] range, skipping weekends when WeekdaysOnly is set.
+type Plan struct {
+	ID           PlanID
+	Name         string
+	MealType     domMenu.MealType
+	StartDate    time.Time
+	EndDate      time.Time
+	WeekdaysOnly bool
+	Price        int64 // in kopecks
+}
+
+func (p Plan) Covers(date time.Time, mealType domMenu.MealType) bool {
+	if mealType != p.MealType || date.Before(p.StartDate) || date.After(p.EndDate) {
+		return false
+	}
+	if p.WeekdaysOnly {
+		wd := date.Weekday()
+		return wd != time.Saturday && wd != time.Sunday
+	}
+	return true
+}
+
+// DaysFrom returns the number of covered days between from and the end of the plan.
+func (p Plan) DaysFrom(from time.Time) int {
+	if from.Before(p.StartDate) {
+		from = p.StartDate
+	}
+
+	days := 0
+	for d := from; !d.After(p.EndDate); d = d.AddDate(0, 0, 1) {
+		if p.Covers(d, p.MealType) {
+			days++
+		}
+	}
+	return days
+}
+
+type Subscription struct {
+	ID             SubscriptionID
+	StudentID      domUser.UserID
+	Plan           Plan
+	RemainingMeals int
+	PurchasedAt    time.Time
+}
+
+func (s Subscription) Covers(date time.Time, mealType domMenu.MealType) bool {
+	return s.RemainingMeals > 0 && s.Plan.Covers(date, mealType)
+}
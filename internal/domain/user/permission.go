@@ -0,0 +1,65 @@
+package user
+
+// Permission names an action guarded by authorization.
+type Permission string
+
+const (
+	PermMenuEdit           Permission = "menu:edit"
+	PermRecipesManage      Permission = "recipes:manage"
+	PermOrdersPlace        Permission = "orders:place"
+	PermOrdersIssue        Permission = "orders:issue"
+	PermPaymentsTopUp      Permission = "payments:top_up"
+	PermWalletView         Permission = "wallet:view"
+	PermWalletsManage      Permission = "wallets:manage"
+	PermSubscriptionsBuy   Permission = "subscriptions:buy"
+	PermPlansEdit          Permission = "plans:edit"
+	PermProfileEdit        Permission = "profile:edit"
+	PermReviewsWrite       Permission = "reviews:write"
+	PermReviewsModerate    Permission = "reviews:moderate"
+	PermInventoryManage    Permission = "inventory:manage"
+	PermProcurementRequest Permission = "procurement:request"
+	PermProcurementApprove Permission = "procurement:approve"
+	PermUsersManage        Permission = "users:manage"
+	PermMetricsView        Permission = "metrics:view"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin: {
+		PermMenuEdit,
+		PermRecipesManage,
+		PermOrdersIssue,
+		PermWalletsManage,
+		PermPlansEdit,
+		PermReviewsModerate,
+		PermInventoryManage,
+		PermProcurementRequest,
+		PermProcurementApprove,
+		PermUsersManage,
+		PermMetricsView,
+	},
+	RoleEmployee: {
+		PermMenuEdit,
+		PermRecipesManage,
+		PermOrdersIssue,
+		PermInventoryManage,
+		PermProcurementRequest,
+	},
+	RoleStudent: {
+		PermOrdersPlace,
+		PermPaymentsTopUp,
+		PermWalletView,
+		PermSubscriptionsBuy,
+		PermProfileEdit,
+		PermReviewsWrite,
+	},
+}
+
+// Can reports whether the role is granted the permission.
+func (r Role) Can(p Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
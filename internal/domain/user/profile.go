@@ -0,0 +1,16 @@
+package user
+
+import (
+	"time"
+
+	domMenu "canteen-app/internal/domain/menu"
+)
+
+// DietaryProfile holds the allergens a student has declared and any other
+// dietary restrictions in free form.
+type DietaryProfile struct {
+	UserID       UserID
+	Allergens    []domMenu.Allergen
+	Restrictions string
+	UpdatedAt    time.Time
+}
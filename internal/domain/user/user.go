@@ -2,11 +2,39 @@ package user
 
 type UserID int64
 
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"
+	RoleEmployee Role = "employee"
+	RoleStudent  Role = "student"
+)
+
+// Valid reports whether r is one of the known roles.
+func (r Role) Valid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
 type User struct {
 	ID           UserID
 	Login        string
 	PasswordHash string
 	Name         string
 	Surname      string
-	Role         string
+	Role         Role
+	// Email is optional; password reset links are sent to it.
+	Email string
+	// Blocked users cannot sign in or refresh their tokens.
+	Blocked bool
+}
+
+// ListQuery selects a page of users ordered by ID. Search matches a part of
+// the login, name or surname regardless of case; an empty Role matches every
+// role and a zero Limit every user past Offset.
+type ListQuery struct {
+	Search string
+	Role   Role
+	Offset int
+	Limit  int
 }
@@ -0,0 +1,44 @@
+package wallet
+
+import (
+	"time"
+
+	domOrder "canteen-app/internal/domain/order"
+	domPayment "canteen-app/internal/domain/payment"
+	domUser "canteen-app/internal/domain/user"
+)
+
+type EntryID int64
+
+type EntryKind string
+
+const (
+	TopUp      EntryKind = "top_up"
+	Charge     EntryKind = "charge"
+	Refund     EntryKind = "refund"
+	Correction EntryKind = "correction"
+
+	SubscriptionPurchase EntryKind = "subscription"
+)
+
+// Entry is a single record of the append-only wallet ledger. Amount is signed:
+// credits are positive, debits are negative.
+type Entry struct {
+	ID        EntryID
+	StudentID domUser.UserID
+	Kind      EntryKind
+	Amount    int64 // in kopecks
+	OrderID   domOrder.OrderID
+	PaymentID domPayment.PaymentID
+	AuthorID  domUser.UserID
+	Comment   string
+	CreatedAt time.Time
+}
+
+func Balance(entries []Entry) int64 {
+	var balance int64
+	for _, e := range entries {
+		balance += e.Amount
+	}
+	return balance
+}
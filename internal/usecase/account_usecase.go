@@ -0,0 +1,275 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+)
+
+// invitationCodeLen is the length of the code in bytes before encoding;
+// 5 bytes are 8 characters, easy to dictate to a class.
+const invitationCodeLen = 5
+
+type accountUseCase struct {
+	users          UserRepository
+	refreshRepo    RefreshTokenRepository
+	invitations    InvitationRepository
+	passwordTokens PasswordTokenRepository
+	hasher         PasswordHasher
+	guard          *LoginGuard
+	setPasswordTTL time.Duration
+}
+
+// NewAccountUseCase manages who may have an account: class invitations for
+// students and accounts created by an admin, whose owners set the password
+// with a one-time token valid for setPasswordTTL, and the accounts admins
+// look after afterwards.
+func NewAccountUseCase(users UserRepository, refreshRepo RefreshTokenRepository, invitations InvitationRepository, passwordTokens PasswordTokenRepository, hasher PasswordHasher, guard *LoginGuard, setPasswordTTL time.Duration) *accountUseCase {
+	return &accountUseCase{
+		users:          users,
+		refreshRepo:    refreshRepo,
+		invitations:    invitations,
+		passwordTokens: passwordTokens,
+		hasher:         hasher,
+		guard:          guard,
+		setPasswordTTL: setPasswordTTL,
+	}
+}
+
+func (uc *accountUseCase) CreateInvitation(createdBy domUser.UserID, class string, maxUses int, ttl time.Duration) (*domAuth.Invitation, error) {
+	now := time.Now()
+	invitation := domAuth.Invitation{
+		Class:     class,
+		CreatedBy: createdBy,
+		MaxUses:   maxUses,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+
+	// A clash of random codes is unlikely, but the code space is small
+	// enough to retry rather than fail.
+	for range 3 {
+		code, err := newInvitationCode()
+		if err != nil {
+			return nil, err
+		}
+		invitation.Code = code
+
+		err = uc.invitations.CreateInvitation(invitation)
+		if errors.Is(err, ErrInvitationExists) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &invitation, nil
+	}
+	return nil, ErrInvitationExists
+}
+
+func (uc *accountUseCase) ListInvitations() ([]domAuth.Invitation, error) {
+	return uc.invitations.ListInvitations()
+}
+
+func (uc *accountUseCase) RevokeInvitation(code string) error {
+	return uc.invitations.DeleteInvitation(code)
+}
+
+// CreateAccount creates an account without a password. The returned token
+// lets its owner set one.
+func (uc *accountUseCase) CreateAccount(login, name, surname, email string, role domUser.Role) (*domAuth.AccountSetup, error) {
+	if !role.Valid() {
+		return nil, ErrInvalidRole
+	}
+
+	id, err := uc.users.CreateUser(domUser.User{Login: login, Name: name, Surname: surname, Email: normalizeEmail(email), Role: role})
+	if err != nil {
+		return nil, err
+	}
+	return issuePasswordToken(uc.passwordTokens, id, uc.setPasswordTTL)
+}
+
+// EnsureAdmin creates an admin account with the login unless a user with it
+// exists already, in which case it returns nil. It lets the first admin in
+// when nobody can create accounts yet.
+func (uc *accountUseCase) EnsureAdmin(login string) (*domAuth.AccountSetup, error) {
+	if _, err := uc.users.GetUserByLogin(login); err == nil {
+		return nil, nil
+	} else if !errors.Is(err, ErrUserNotFound) {
+		return nil, err
+	}
+
+	setup, err := uc.CreateAccount(login, "Admin", "Admin", "", domUser.RoleAdmin)
+	if errors.Is(err, ErrLoginInUse) {
+		return nil, nil
+	}
+	return setup, err
+}
+
+// SetPassword sets the password of the user the token was issued to and
+// uses the token up. Whoever knew the old password is logged out everywhere,
+// and a lockout after wrong guesses of it is lifted.
+func (uc *accountUseCase) SetPassword(token, password string) error {
+	stored, err := uc.passwordTokens.TakePasswordToken(hashSecret(token), time.Now())
+	if err != nil {
+		return err
+	}
+
+	hash, err := uc.hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+	if err := uc.users.SetPasswordHash(stored.UserID, hash); err != nil {
+		return err
+	}
+	if err := uc.refreshRepo.DeleteByUser(stored.UserID); err != nil {
+		return err
+	}
+
+	user, err := uc.users.GetUserByID(stored.UserID)
+	if err != nil {
+		return err
+	}
+	return uc.guard.Unlock(user.Login)
+}
+
+func (uc *accountUseCase) ListUsers(query domUser.ListQuery) ([]domUser.User, int, error) {
+	return uc.users.ListUsers(query)
+}
+
+func (uc *accountUseCase) GetUser(id domUser.UserID) (*domUser.User, error) {
+	return uc.users.GetUserByID(id)
+}
+
+// ChangeRole logs the user out everywhere, so that the old role is gone once
+// the access tokens already issued expire.
+func (uc *accountUseCase) ChangeRole(adminID, id domUser.UserID, role domUser.Role) (*domUser.User, error) {
+	if !role.Valid() {
+		return nil, ErrInvalidRole
+	}
+	user, err := uc.updateOther(adminID, id, func(user *domUser.User) { user.Role = role })
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.refreshRepo.DeleteByUser(id); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// SetBlocked blocks or unblocks the user. Blocking logs the user out
+// everywhere; access tokens already issued stay valid until they expire.
+func (uc *accountUseCase) SetBlocked(adminID, id domUser.UserID, blocked bool) (*domUser.User, error) {
+	user, err := uc.updateOther(adminID, id, func(user *domUser.User) { user.Blocked = blocked })
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		if err := uc.refreshRepo.DeleteByUser(id); err != nil {
+			return nil, err
+		}
+	}
+	return user, nil
+}
+
+// ResetPassword clears the password of the user, logs the user out
+// everywhere and returns a token to set a new one with.
+func (uc *accountUseCase) ResetPassword(id domUser.UserID) (*domAuth.AccountSetup, error) {
+	if err := uc.users.SetPasswordHash(id, ""); err != nil {
+		return nil, err
+	}
+	if err := uc.refreshRepo.DeleteByUser(id); err != nil {
+		return nil, err
+	}
+	return issuePasswordToken(uc.passwordTokens, id, uc.setPasswordTTL)
+}
+
+// UnlockUser lifts the lockout after too many wrong passwords, so that the
+// user can try again right away.
+func (uc *accountUseCase) UnlockUser(id domUser.UserID) error {
+	user, err := uc.users.GetUserByID(id)
+	if err != nil {
+		return err
+	}
+	return uc.guard.Unlock(user.Login)
+}
+
+func (uc *accountUseCase) DeleteUser(adminID, id domUser.UserID) error {
+	if adminID == id {
+		return ErrOwnAccount
+	}
+	if err := uc.users.DeleteUser(id); err != nil {
+		return err
+	}
+	return uc.refreshRepo.DeleteByUser(id)
+}
+
+// updateOther applies change to a user other than the admin, so that the
+// last admin cannot lock everybody out by accident.
+func (uc *accountUseCase) updateOther(adminID, id domUser.UserID, change func(*domUser.User)) (*domUser.User, error) {
+	if adminID == id {
+		return nil, ErrOwnAccount
+	}
+
+	user, err := uc.users.GetUserByID(id)
+	if err != nil {
+		return nil, err
+	}
+	change(user)
+	if err := uc.users.UpdateUser(*user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func issuePasswordToken(passwordTokens PasswordTokenRepository, userID domUser.UserID, ttl time.Duration) (*domAuth.AccountSetup, error) {
+	token, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	err = passwordTokens.SavePasswordToken(domAuth.PasswordToken{
+		Hash:      hashSecret(token),
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domAuth.AccountSetup{UserID: userID, Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// normalizeEmail makes emails differing in case or surrounding spaces one.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func newInvitationCode() (string, error) {
+	buf := make([]byte, invitationCodeLen)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf), nil
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
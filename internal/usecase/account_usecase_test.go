@@ -0,0 +1,279 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountUseCase_RegisterWithInvitation(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	invitations := ram_storage.NewInvitationRepo()
+	authUC := usecase.NewAuthUseCase(users, newTokenService(), ram_storage.NewRefreshRepo(), ram_storage.NewSecurityEventRepo(), invitations, plainHasher{}, newLoginGuard())
+	accountUC := usecase.NewAccountUseCase(users, ram_storage.NewRefreshRepo(), invitations, ram_storage.NewPasswordTokenRepo(), plainHasher{}, newLoginGuard(), time.Hour)
+
+	invitation, err := accountUC.CreateInvitation(1, "7Б", 2, time.Hour)
+	require.NoError(t, err)
+	assert.Len(t, invitation.Code, 8)
+
+	_, err = authUC.Register("slim", "password", "Slim", "Shady", "", invitation.Code, domAuth.Client{})
+	require.NoError(t, err)
+
+	user, err := users.GetUserByLogin("slim")
+	require.NoError(t, err)
+	assert.Equal(t, domUser.RoleStudent, user.Role, "self-registration only makes students")
+
+	_, err = authUC.Register("slim", "password", "Slim", "Shady", "", invitation.Code, domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrLoginInUse)
+
+	_, err = authUC.Register("marshall", "password", "Marshall", "Mathers", "", invitation.Code, domAuth.Client{})
+	require.NoError(t, err)
+
+	_, err = authUC.Register("eminem", "password", "Em", "Inem", "", invitation.Code, domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvitationInvalid, "the invitation is used up")
+
+	listed, err := accountUC.ListInvitations()
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, 2, listed[0].Uses, "a failed registration does not use the invitation")
+}
+
+func TestAccountUseCase_RegisterWithBadInvitation(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	invitations := ram_storage.NewInvitationRepo()
+	authUC := usecase.NewAuthUseCase(users, newTokenService(), ram_storage.NewRefreshRepo(), ram_storage.NewSecurityEventRepo(), invitations, plainHasher{}, newLoginGuard())
+	accountUC := usecase.NewAccountUseCase(users, ram_storage.NewRefreshRepo(), invitations, ram_storage.NewPasswordTokenRepo(), plainHasher{}, newLoginGuard(), time.Hour)
+
+	_, err := authUC.Register("slim", "password", "Slim", "Shady", "", "NOSUCHCD", domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvitationInvalid)
+
+	_, err = users.CreateUser(domUser.User{Login: "director", Role: domUser.RoleAdmin})
+	require.NoError(t, err)
+	_, err = authUC.Register("director", "password", "Slim", "Shady", "", "NOSUCHCD", domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvitationInvalid, "a taken login is not revealed without a valid code")
+	assert.NotErrorIs(t, err, usecase.ErrLoginInUse)
+
+	expired, err := accountUC.CreateInvitation(1, "7Б", 30, -time.Minute)
+	require.NoError(t, err)
+	_, err = authUC.Register("slim", "password", "Slim", "Shady", "", expired.Code, domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvitationInvalid)
+
+	revoked, err := accountUC.CreateInvitation(1, "7Б", 30, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, accountUC.RevokeInvitation(revoked.Code))
+	_, err = authUC.Register("slim", "password", "Slim", "Shady", "", revoked.Code, domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvitationInvalid)
+
+	assert.ErrorIs(t, accountUC.RevokeInvitation(revoked.Code), usecase.ErrInvitationNotFound)
+
+	_, err = users.GetUserByLogin("slim")
+	assert.ErrorIs(t, err, usecase.ErrUserNotFound)
+}
+
+func TestAccountUseCase_CreateAccountAndSetPassword(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	invitations := ram_storage.NewInvitationRepo()
+	authUC := usecase.NewAuthUseCase(users, newTokenService(), ram_storage.NewRefreshRepo(), ram_storage.NewSecurityEventRepo(), invitations, plainHasher{}, newLoginGuard())
+	accountUC := usecase.NewAccountUseCase(users, ram_storage.NewRefreshRepo(), invitations, ram_storage.NewPasswordTokenRepo(), plainHasher{}, newLoginGuard(), time.Hour)
+
+	_, err := accountUC.CreateAccount("cook", "Maria", "Ivanova", "", "janitor")
+	assert.ErrorIs(t, err, usecase.ErrInvalidRole)
+
+	setup, err := accountUC.CreateAccount("cook", "Maria", "Ivanova", " Maria.Ivanova@Example.com", domUser.RoleEmployee)
+	require.NoError(t, err)
+	assert.NotEmpty(t, setup.Token)
+
+	_, err = authUC.Login("cook", "", domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvalidCredentials, "no password before the link is used")
+
+	require.NoError(t, accountUC.SetPassword(setup.Token, "password"))
+	assert.ErrorIs(t, accountUC.SetPassword(setup.Token, "another"), usecase.ErrPasswordTokenInvalid, "the link works once")
+
+	_, err = authUC.Login("cook", "password", domAuth.Client{})
+	require.NoError(t, err)
+
+	user, err := users.GetUserByLogin("cook")
+	require.NoError(t, err)
+	assert.Equal(t, setup.UserID, user.ID)
+	assert.Equal(t, domUser.RoleEmployee, user.Role)
+	assert.Equal(t, "maria.ivanova@example.com", user.Email, "emails are stored in lower case")
+
+	_, err = accountUC.CreateAccount("cook", "Maria", "Ivanova", "", domUser.RoleEmployee)
+	assert.ErrorIs(t, err, usecase.ErrLoginInUse)
+
+	_, err = accountUC.CreateAccount("cook2", "Maria", "Ivanova", "MARIA.IVANOVA@example.com", domUser.RoleEmployee)
+	assert.ErrorIs(t, err, usecase.ErrEmailInUse)
+}
+
+func TestAccountUseCase_SetPasswordExpired(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	accountUC := usecase.NewAccountUseCase(users, ram_storage.NewRefreshRepo(), ram_storage.NewInvitationRepo(), ram_storage.NewPasswordTokenRepo(), plainHasher{}, newLoginGuard(), -time.Minute)
+
+	setup, err := accountUC.CreateAccount("cook", "Maria", "Ivanova", "", domUser.RoleEmployee)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, accountUC.SetPassword(setup.Token, "password"), usecase.ErrPasswordTokenInvalid)
+	assert.ErrorIs(t, accountUC.SetPassword("unknown", "password"), usecase.ErrPasswordTokenInvalid)
+}
+
+func TestAccountUseCase_EnsureAdmin(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	accountUC := usecase.NewAccountUseCase(users, ram_storage.NewRefreshRepo(), ram_storage.NewInvitationRepo(), ram_storage.NewPasswordTokenRepo(), plainHasher{}, newLoginGuard(), time.Hour)
+
+	setup, err := accountUC.EnsureAdmin("director")
+	require.NoError(t, err)
+	require.NotNil(t, setup)
+
+	again, err := accountUC.EnsureAdmin("director")
+	require.NoError(t, err)
+	assert.Nil(t, again, "an existing admin is left alone")
+
+	require.NoError(t, accountUC.SetPassword(setup.Token, "password"))
+	user, err := users.GetUserByLogin("director")
+	require.NoError(t, err)
+	assert.Equal(t, domUser.RoleAdmin, user.Role)
+}
+
+func TestAccountUseCase_BlockUser(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	refresh := ram_storage.NewRefreshRepo()
+	invitations := ram_storage.NewInvitationRepo()
+	authUC := usecase.NewAuthUseCase(users, newTokenService(), refresh, ram_storage.NewSecurityEventRepo(), invitations, plainHasher{}, newLoginGuard())
+	accountUC := usecase.NewAccountUseCase(users, refresh, invitations, ram_storage.NewPasswordTokenRepo(), plainHasher{}, newLoginGuard(), time.Hour)
+
+	adminID, err := users.CreateUser(domUser.User{Login: "director", Role: domUser.RoleAdmin})
+	require.NoError(t, err)
+	id, err := users.CreateUser(domUser.User{Login: "slim", PasswordHash: "password", Role: domUser.RoleStudent})
+	require.NoError(t, err)
+
+	tokens, err := authUC.Login("slim", "password", domAuth.Client{})
+	require.NoError(t, err)
+
+	_, err = accountUC.SetBlocked(adminID, adminID, true)
+	assert.ErrorIs(t, err, usecase.ErrOwnAccount)
+
+	blocked, err := accountUC.SetBlocked(adminID, id, true)
+	require.NoError(t, err)
+	assert.True(t, blocked.Blocked)
+
+	_, err = authUC.Refresh(tokens.RefreshToken, domAuth.Client{})
+	assert.Error(t, err, "blocking revokes the refresh tokens")
+	_, err = authUC.Login("slim", "password", domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrUserBlocked)
+
+	_, err = accountUC.SetBlocked(adminID, id, false)
+	require.NoError(t, err)
+	_, err = authUC.Login("slim", "password", domAuth.Client{})
+	assert.NoError(t, err)
+
+	_, err = accountUC.SetBlocked(adminID, 42, true)
+	assert.ErrorIs(t, err, usecase.ErrUserNotFound)
+}
+
+func TestAccountUseCase_ManageUsers(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	refresh := ram_storage.NewRefreshRepo()
+	invitations := ram_storage.NewInvitationRepo()
+	authUC := usecase.NewAuthUseCase(users, newTokenService(), refresh, ram_storage.NewSecurityEventRepo(), invitations, plainHasher{}, newLoginGuard())
+	accountUC := usecase.NewAccountUseCase(users, refresh, invitations, ram_storage.NewPasswordTokenRepo(), plainHasher{}, newLoginGuard(), time.Hour)
+
+	adminID, err := users.CreateUser(domUser.User{Login: "director", Role: domUser.RoleAdmin})
+	require.NoError(t, err)
+	id, err := users.CreateUser(domUser.User{Login: "cook", PasswordHash: "password", Role: domUser.RoleStudent})
+	require.NoError(t, err)
+
+	_, err = accountUC.ChangeRole(adminID, id, "janitor")
+	assert.ErrorIs(t, err, usecase.ErrInvalidRole)
+	_, err = accountUC.ChangeRole(adminID, adminID, domUser.RoleStudent)
+	assert.ErrorIs(t, err, usecase.ErrOwnAccount)
+
+	changed, err := accountUC.ChangeRole(adminID, id, domUser.RoleEmployee)
+	require.NoError(t, err)
+	assert.Equal(t, domUser.RoleEmployee, changed.Role)
+
+	listed, total, err := accountUC.ListUsers(domUser.ListQuery{Role: domUser.RoleEmployee})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, listed, 1)
+	assert.Equal(t, id, listed[0].ID)
+
+	tokens, err := authUC.Login("cook", "password", domAuth.Client{})
+	require.NoError(t, err)
+
+	setup, err := accountUC.ResetPassword(id)
+	require.NoError(t, err)
+	_, err = authUC.Refresh(tokens.RefreshToken, domAuth.Client{})
+	assert.Error(t, err, "a reset logs the user out")
+	_, err = authUC.Login("cook", "password", domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+
+	require.NoError(t, accountUC.SetPassword(setup.Token, "another"))
+	_, err = authUC.Login("cook", "another", domAuth.Client{})
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, accountUC.DeleteUser(adminID, adminID), usecase.ErrOwnAccount)
+	require.NoError(t, accountUC.DeleteUser(adminID, id))
+	_, err = accountUC.GetUser(id)
+	assert.ErrorIs(t, err, usecase.ErrUserNotFound)
+	assert.ErrorIs(t, accountUC.DeleteUser(adminID, id), usecase.ErrUserNotFound)
+}
+
+func TestAccountUseCase_DemoteAdmin(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	refresh := ram_storage.NewRefreshRepo()
+	invitations := ram_storage.NewInvitationRepo()
+	tokenSvc := newTokenService()
+	authUC := usecase.NewAuthUseCase(users, tokenSvc, refresh, ram_storage.NewSecurityEventRepo(), invitations, plainHasher{}, newLoginGuard())
+	accountUC := usecase.NewAccountUseCase(users, refresh, invitations, ram_storage.NewPasswordTokenRepo(), plainHasher{}, newLoginGuard(), time.Hour)
+
+	adminID, err := users.CreateUser(domUser.User{Login: "director", Role: domUser.RoleAdmin})
+	require.NoError(t, err)
+	id, err := users.CreateUser(domUser.User{Login: "deputy", PasswordHash: "password", Role: domUser.RoleAdmin})
+	require.NoError(t, err)
+
+	tokens, err := authUC.Login("deputy", "password", domAuth.Client{})
+	require.NoError(t, err)
+
+	_, err = accountUC.ChangeRole(adminID, id, domUser.RoleEmployee)
+	require.NoError(t, err)
+
+	_, err = authUC.Refresh(tokens.RefreshToken, domAuth.Client{})
+	assert.Error(t, err, "the admin rights cannot be refreshed")
+
+	tokens, err = authUC.Login("deputy", "password", domAuth.Client{})
+	require.NoError(t, err)
+	claims, err := tokenSvc.ParseAccessToken(tokens.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, domUser.RoleEmployee, claims.Role)
+}
+
+func TestAccountUseCase_UnlockUser(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	refresh := ram_storage.NewRefreshRepo()
+	invitations := ram_storage.NewInvitationRepo()
+	policy := domAuth.LockoutPolicy{MaxFailures: 1, LockoutDuration: time.Hour, Window: time.Hour}
+	guard := usecase.NewLoginGuard(ram_storage.NewLoginAttemptRepo(), policy, domAuth.LockoutPolicy{Window: time.Hour})
+	authUC := usecase.NewAuthUseCase(users, newTokenService(), refresh, ram_storage.NewSecurityEventRepo(), invitations, plainHasher{}, guard)
+	accountUC := usecase.NewAccountUseCase(users, refresh, invitations, ram_storage.NewPasswordTokenRepo(), plainHasher{}, guard, time.Hour)
+
+	id, err := users.CreateUser(domUser.User{Login: "slim", PasswordHash: "password", Role: domUser.RoleStudent})
+	require.NoError(t, err)
+
+	_, err = authUC.Login("slim", "wrong", domAuth.Client{})
+	require.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+	_, err = authUC.Login("slim", "password", domAuth.Client{})
+	require.ErrorIs(t, err, usecase.ErrAccountLocked)
+
+	require.NoError(t, accountUC.UnlockUser(id))
+	_, err = authUC.Login("slim", "password", domAuth.Client{})
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, accountUC.UnlockUser(42), usecase.ErrUserNotFound)
+}
@@ -1,6 +1,10 @@
 package usecase
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	domAuth "canteen-app/internal/domain/auth"
 	domUser "canteen-app/internal/domain/user"
 )
@@ -8,22 +12,36 @@ import (
 type authUseCase struct {
 	users       UserRepository
 	refreshRepo RefreshTokenRepository
+	events      SecurityEventRepository
+	invitations InvitationRepository
 	tokens      TokenService
 	hasher      PasswordHasher
+	guard       *LoginGuard
 }
 
-func NewAuthUseCase(users UserRepository, tokens TokenService, refreshRepo RefreshTokenRepository, hasher PasswordHasher) *authUseCase {
-	return &authUseCase{users: users, tokens: tokens, refreshRepo: refreshRepo, hasher: hasher}
+func NewAuthUseCase(users UserRepository, tokens TokenService, refreshRepo RefreshTokenRepository, events SecurityEventRepository, invitations InvitationRepository, hasher PasswordHasher, guard *LoginGuard) *authUseCase {
+	return &authUseCase{users: users, tokens: tokens, refreshRepo: refreshRepo, events: events, invitations: invitations, hasher: hasher, guard: guard}
 }
 
-func (uc *authUseCase) Register(login, password, name, surname, role string) (*domAuth.Tokens, error) {
+// Register signs up a student with the invitation code of their class.
+// Accounts with other roles are created by an admin, see accountUseCase. The
+// email is optional; without one the password cannot be reset by mail.
+func (uc *authUseCase) Register(login, password, name, surname, email, inviteCode string, client domAuth.Client) (*domAuth.Tokens, error) {
+	// The code goes first, so that only its holders learn which logins are
+	// taken.
+	if _, err := uc.invitations.UseInvitation(inviteCode, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// Spares hashing the password of a taken login. The repository has the
+	// final word: of concurrent registrations only one gets past CreateUser.
 	if _, err := uc.users.GetUserByLogin(login); err == nil {
-		return nil, ErrLoginInUse
+		return nil, errors.Join(ErrLoginInUse, uc.invitations.ReleaseInvitation(inviteCode))
 	}
 
 	hash, err := uc.hasher.Hash(password)
 	if err != nil {
-		return nil, err
+		return nil, errors.Join(err, uc.invitations.ReleaseInvitation(inviteCode))
 	}
 
 	user := domUser.User{
@@ -31,36 +49,72 @@ func (uc *authUseCase) Register(login, password, name, surname, role string) (*d
 		PasswordHash: hash,
 		Name:         name,
 		Surname:      surname,
-		Role:         role,
+		Role:         domUser.RoleStudent,
+		Email:        normalizeEmail(email),
 	}
 
-	userID := uc.users.CreateUser(user)
-
-	access, err := uc.tokens.GenerateAccessToken(userID, user.Role)
+	user.ID, err = uc.users.CreateUser(user)
 	if err != nil {
+		// The code was fine, so the use is given back.
+		return nil, errors.Join(err, uc.invitations.ReleaseInvitation(inviteCode))
+	}
+
+	return uc.issueTokens(user, client)
+}
+
+// Login is throttled by the login guard: wrong passwords slow down further
+// attempts and eventually lock the login, see LoginGuard.
+func (uc *authUseCase) Login(login, password string, client domAuth.Client) (*domAuth.Tokens, error) {
+	now := time.Now()
+	if err := uc.guard.Check(login, client.IP, now); err != nil {
 		return nil, err
 	}
 
-	refresh, refreshID, refreshExp, err := uc.tokens.GenerateRefreshToken(user.ID)
+	user, err := uc.users.GetUserByLogin(login)
 	if err != nil {
-		return nil, err
+		return nil, uc.failLogin(login, nil, client, now)
+	}
+	// The password of an account created by an admin is not set yet.
+	if user.PasswordHash == "" {
+		return nil, uc.failLogin(login, user, client, now)
 	}
 
-	uc.refreshRepo.Save(refreshID, user.ID, refreshExp)
+	if err := uc.hasher.Compare(user.PasswordHash, password); err != nil {
+		return nil, uc.failLogin(login, user, client, now)
+	}
+	if err := uc.guard.Succeed(login); err != nil {
+		return nil, err
+	}
+	if user.Blocked {
+		return nil, ErrUserBlocked
+	}
 
-	return &domAuth.Tokens{AccessToken: access, RefreshToken: refresh}, nil
+	return uc.issueTokens(*user, client)
 }
 
-func (uc *authUseCase) Login(login, password string) (*domAuth.Tokens, error) {
-	user, err := uc.users.GetUserByLogin(login)
+// failLogin counts a failed sign-in and returns ErrInvalidCredentials. The
+// lockout of an existing user goes to the security events.
+func (uc *authUseCase) failLogin(login string, user *domUser.User, client domAuth.Client, now time.Time) error {
+	locked, err := uc.guard.Fail(login, client.IP, now)
 	if err != nil {
-		return nil, ErrInvalidCredentials
+		return err
 	}
-
-	if err := uc.hasher.Compare(user.PasswordHash, password); err != nil {
-		return nil, ErrInvalidCredentials
+	if locked && user != nil {
+		_, err := uc.events.RecordEvent(domAuth.SecurityEvent{
+			UserID:    user.ID,
+			Kind:      domAuth.AccountLocked,
+			Details:   fmt.Sprintf("too many wrong passwords, the last one from %s", client.IP),
+			CreatedAt: now,
+		})
+		if err != nil {
+			return err
+		}
 	}
+	return ErrInvalidCredentials
+}
 
+// issueTokens starts a new refresh token family, named after its first token.
+func (uc *authUseCase) issueTokens(user domUser.User, client domAuth.Client) (*domAuth.Tokens, error) {
 	access, err := uc.tokens.GenerateAccessToken(user.ID, user.Role)
 	if err != nil {
 		return nil, err
@@ -71,7 +125,19 @@ func (uc *authUseCase) Login(login, password string) (*domAuth.Tokens, error) {
 		return nil, err
 	}
 
-	uc.refreshRepo.Save(refreshID, user.ID, refreshExp)
+	now := time.Now()
+	token := domAuth.RefreshToken{
+		ID:         refreshID,
+		UserID:     user.ID,
+		FamilyID:   refreshID,
+		Client:     client,
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  refreshExp,
+	}
+	if err := uc.refreshRepo.Save(token); err != nil {
+		return nil, err
+	}
 
 	return &domAuth.Tokens{AccessToken: access, RefreshToken: refresh}, nil
 }
@@ -92,45 +158,159 @@ func (uc *authUseCase) GetUserByID(userID domUser.UserID) (*domUser.User, error)
 	return user, nil
 }
 
-func (uc *authUseCase) Refresh(refreshToken string) (*domAuth.Tokens, error) {
-	userID, tokenID, err := uc.tokens.ParseRefreshToken(refreshToken)
+// Refresh rotates the refresh token within its family. A token that has been
+// rotated already is evidence of theft: the whole family is revoked, which
+// logs out every device holding a token of the chain, and ErrRefreshReused is
+// returned. Blocked users get ErrUserBlocked.
+func (uc *authUseCase) Refresh(refreshToken string, client domAuth.Client) (*domAuth.Tokens, error) {
+	current, err := uc.lookupRefresh(refreshToken)
 	if err != nil {
-		return nil, ErrInvalidRefresh
+		return nil, err
+	}
+	if current.Rotated() {
+		return nil, uc.revokeReused(*current)
 	}
 
-	ok := uc.refreshRepo.IsValid(tokenID, userID)
-	if !ok {
+	user, err := uc.users.GetUserByID(current.UserID)
+	if errors.Is(err, ErrUserNotFound) {
 		return nil, ErrInvalidRefresh
 	}
+	if err != nil {
+		return nil, err
+	}
+	if user.Blocked {
+		return nil, ErrUserBlocked
+	}
 
-	uc.refreshRepo.Delete(tokenID)
-
-	user, _ := uc.users.GetUserByID(userID)
-	access, err := uc.tokens.GenerateAccessToken(userID, user.Role)
+	access, err := uc.tokens.GenerateAccessToken(current.UserID, user.Role)
 	if err != nil {
 		return nil, err
 	}
 
-	newRefresh, newID, newExp, err := uc.tokens.GenerateRefreshToken(userID)
+	newRefresh, newID, newExp, err := uc.tokens.GenerateRefreshToken(current.UserID)
 	if err != nil {
 		return nil, err
 	}
 
-	uc.refreshRepo.Save(newID, userID, newExp)
+	next := domAuth.RefreshToken{
+		ID:         newID,
+		UserID:     current.UserID,
+		FamilyID:   current.FamilyID,
+		Client:     client,
+		CreatedAt:  current.CreatedAt,
+		LastUsedAt: time.Now(),
+		ExpiresAt:  newExp,
+	}
+	switch err := uc.refreshRepo.Rotate(current.ID, next); {
+	case errors.Is(err, ErrRefreshRotated):
+		// Lost the race to another request presenting the same token.
+		return nil, uc.revokeReused(*current)
+	case errors.Is(err, ErrRefreshNotFound):
+		return nil, ErrInvalidRefresh
+	case err != nil:
+		return nil, err
+	}
 	return &domAuth.Tokens{AccessToken: access, RefreshToken: newRefresh}, nil
 }
 
+// RevokeRefreshToken ends the session of the token by revoking its family.
 func (uc *authUseCase) RevokeRefreshToken(refreshToken string) error {
+	current, err := uc.lookupRefresh(refreshToken)
+	if err != nil {
+		return err
+	}
+	if current.Rotated() {
+		return uc.revokeReused(*current)
+	}
+	return uc.refreshRepo.DeleteFamily(current.FamilyID)
+}
+
+// ListSessions returns the sessions of the user. The session refreshToken
+// belongs to, if any, is marked current.
+func (uc *authUseCase) ListSessions(userID domUser.UserID, refreshToken string) ([]domAuth.Session, error) {
+	tokens, err := uc.refreshRepo.ListActive(userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var currentFamily string
+	if refreshToken != "" {
+		if current, err := uc.lookupRefresh(refreshToken); err == nil {
+			currentFamily = current.FamilyID
+		}
+	}
+
+	sessions := make([]domAuth.Session, 0, len(tokens))
+	for _, token := range tokens {
+		sessions = append(sessions, domAuth.Session{
+			ID:         token.FamilyID,
+			UserID:     token.UserID,
+			Client:     token.Client,
+			CreatedAt:  token.CreatedAt,
+			LastUsedAt: token.LastUsedAt,
+			ExpiresAt:  token.ExpiresAt,
+			Current:    token.FamilyID == currentFamily,
+		})
+	}
+	return sessions, nil
+}
+
+// RevokeSession logs the user out on one device. Access tokens already
+// issued stay valid until they expire.
+func (uc *authUseCase) RevokeSession(userID domUser.UserID, sessionID string) error {
+	tokens, err := uc.refreshRepo.ListActive(userID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		if token.FamilyID == sessionID {
+			return uc.refreshRepo.DeleteFamily(sessionID)
+		}
+	}
+	return ErrSessionNotFound
+}
+
+// RevokeAllSessions logs the user out everywhere.
+func (uc *authUseCase) RevokeAllSessions(userID domUser.UserID) error {
+	if _, err := uc.users.GetUserByID(userID); err != nil {
+		return err
+	}
+	return uc.refreshRepo.DeleteByUser(userID)
+}
+
+func (uc *authUseCase) lookupRefresh(refreshToken string) (*domAuth.RefreshToken, error) {
 	userID, tokenID, err := uc.tokens.ParseRefreshToken(refreshToken)
 	if err != nil {
-		return ErrInvalidRefresh
+		return nil, ErrInvalidRefresh
+	}
+
+	token, err := uc.refreshRepo.Get(tokenID)
+	if errors.Is(err, ErrRefreshNotFound) {
+		return nil, ErrInvalidRefresh
+	}
+	if err != nil {
+		return nil, err
 	}
+	if token.UserID != userID {
+		return nil, ErrInvalidRefresh
+	}
+	return token, nil
+}
 
-	ok := uc.refreshRepo.IsValid(tokenID, userID)
-	if !ok {
-		return ErrInvalidRefresh
+func (uc *authUseCase) revokeReused(token domAuth.RefreshToken) error {
+	if err := uc.refreshRepo.DeleteFamily(token.FamilyID); err != nil {
+		return err
 	}
 
-	uc.refreshRepo.Delete(tokenID)
-	return nil
+	_, err := uc.events.RecordEvent(domAuth.SecurityEvent{
+		UserID:    token.UserID,
+		Kind:      domAuth.RefreshTokenReuse,
+		Details:   fmt.Sprintf("token %s presented after rotation, family %s revoked", token.ID, token.FamilyID),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	return ErrRefreshReused
 }
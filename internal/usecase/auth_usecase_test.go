@@ -0,0 +1,330 @@
+package usecase_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	jwtadapter "canteen-app/internal/adapter/jwt"
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domAuth "canteen-app/internal/domain/auth"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// plainHasher keeps the stress tests fast; bcrypt is not what they exercise.
+type plainHasher struct{}
+
+func (plainHasher) Hash(password string) (string, error) { return password, nil }
+
+func (plainHasher) Compare(hash, password string) error {
+	if hash != password {
+		return errors.New("password mismatch")
+	}
+	return nil
+}
+
+func newTokenService() usecase.TokenService {
+	return jwtadapter.NewJWTTokenService([]byte("access"), []byte("refresh"), time.Minute, time.Hour, "test")
+}
+
+// testLockout lets a test get a couple of passwords wrong unhindered.
+var testLockout = domAuth.LockoutPolicy{
+	FreeFailures:    3,
+	BaseDelay:       time.Second,
+	MaxDelay:        time.Minute,
+	MaxFailures:     5,
+	LockoutDuration: 15 * time.Minute,
+	Window:          time.Hour,
+}
+
+func newLoginGuard() *usecase.LoginGuard {
+	return usecase.NewLoginGuard(ram_storage.NewLoginAttemptRepo(), testLockout, testLockout)
+}
+
+// testInviteCode is a class invitation of newInvitations with room for
+// every registration of a test.
+const testInviteCode = "TESTCODE"
+
+func newInvitations(t *testing.T) *ram_storage.InvitationRepo {
+	t.Helper()
+
+	invitations := ram_storage.NewInvitationRepo()
+	require.NoError(t, invitations.CreateInvitation(domAuth.Invitation{
+		Code:      testInviteCode,
+		Class:     "7Б",
+		CreatedBy: 1,
+		MaxUses:   100,
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}))
+	return invitations
+}
+
+func TestAuthUseCase_ConcurrentRegister(t *testing.T) {
+	authUC := usecase.NewAuthUseCase(ram_storage.NewUserRepo(), newTokenService(), ram_storage.NewRefreshRepo(), ram_storage.NewSecurityEventRepo(), newInvitations(t), plainHasher{}, newLoginGuard())
+	const attempts = 32
+
+	var (
+		wg               sync.WaitGroup
+		registered, used atomic.Int32
+	)
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := authUC.Register("slim", "password", "Slim", "Shady", "", testInviteCode, domAuth.Client{})
+			switch {
+			case err == nil:
+				registered.Add(1)
+			case errors.Is(err, usecase.ErrLoginInUse):
+				used.Add(1)
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 1, registered.Load())
+	assert.EqualValues(t, attempts-1, used.Load())
+
+	_, err := authUC.Login("slim", "password", domAuth.Client{})
+	assert.NoError(t, err)
+}
+
+func TestAuthUseCase_ConcurrentLoginAndRefresh(t *testing.T) {
+	authUC := usecase.NewAuthUseCase(ram_storage.NewUserRepo(), newTokenService(), ram_storage.NewRefreshRepo(), ram_storage.NewSecurityEventRepo(), newInvitations(t), plainHasher{}, newLoginGuard())
+	_, err := authUC.Register("slim", "password", "Slim", "Shady", "", testInviteCode, domAuth.Client{})
+	require.NoError(t, err)
+
+	const (
+		clients   = 16
+		rotations = 8
+	)
+
+	var wg sync.WaitGroup
+	for range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			tokens, err := authUC.Login("slim", "password", domAuth.Client{})
+			if err != nil {
+				t.Errorf("login: %v", err)
+				return
+			}
+			for range rotations {
+				if tokens, err = authUC.Refresh(tokens.RefreshToken, domAuth.Client{}); err != nil {
+					t.Errorf("refresh: %v", err)
+					return
+				}
+			}
+			if err := authUC.RevokeRefreshToken(tokens.RefreshToken); err != nil {
+				t.Errorf("revoke: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAuthUseCase_RefreshReuse(t *testing.T) {
+	events := ram_storage.NewSecurityEventRepo()
+	authUC := usecase.NewAuthUseCase(ram_storage.NewUserRepo(), newTokenService(), ram_storage.NewRefreshRepo(), events, newInvitations(t), plainHasher{}, newLoginGuard())
+	registered, err := authUC.Register("slim", "password", "Slim", "Shady", "", testInviteCode, domAuth.Client{})
+	require.NoError(t, err)
+	user, err := authUC.GetUserByLogin("slim")
+	require.NoError(t, err)
+
+	// Another device logged in separately has a family of its own.
+	otherDevice, err := authUC.Login("slim", "password", domAuth.Client{})
+	require.NoError(t, err)
+
+	rotated, err := authUC.Refresh(registered.RefreshToken, domAuth.Client{})
+	require.NoError(t, err)
+	current, err := authUC.Refresh(rotated.RefreshToken, domAuth.Client{})
+	require.NoError(t, err)
+
+	_, err = authUC.Refresh(rotated.RefreshToken, domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrRefreshReused)
+
+	_, err = authUC.Refresh(current.RefreshToken, domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRefresh, "the whole family is revoked")
+	_, err = authUC.Refresh(registered.RefreshToken, domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRefresh)
+
+	_, err = authUC.Refresh(otherDevice.RefreshToken, domAuth.Client{})
+	assert.NoError(t, err)
+
+	recorded, err := events.ListEvents(user.ID)
+	require.NoError(t, err)
+	require.Len(t, recorded, 1)
+	assert.Equal(t, domAuth.RefreshTokenReuse, recorded[0].Kind)
+}
+
+func TestAuthUseCase_ConcurrentRefreshOfOneToken(t *testing.T) {
+	events := ram_storage.NewSecurityEventRepo()
+	authUC := usecase.NewAuthUseCase(ram_storage.NewUserRepo(), newTokenService(), ram_storage.NewRefreshRepo(), events, newInvitations(t), plainHasher{}, newLoginGuard())
+	tokens, err := authUC.Register("slim", "password", "Slim", "Shady", "", testInviteCode, domAuth.Client{})
+	require.NoError(t, err)
+	user, err := authUC.GetUserByLogin("slim")
+	require.NoError(t, err)
+
+	const attempts = 16
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		refreshed []*domAuth.Tokens
+	)
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			got, err := authUC.Refresh(tokens.RefreshToken, domAuth.Client{})
+			if err != nil {
+				if !errors.Is(err, usecase.ErrRefreshReused) && !errors.Is(err, usecase.ErrInvalidRefresh) {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			mu.Lock()
+			refreshed = append(refreshed, got)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	// One request wins the rotation; the others reveal the reuse, which
+	// revokes the winner's token as well.
+	require.Len(t, refreshed, 1)
+	_, err = authUC.Refresh(refreshed[0].RefreshToken, domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRefresh)
+
+	recorded, err := events.ListEvents(user.ID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, recorded)
+}
+
+func TestAuthUseCase_RevokeRefreshToken(t *testing.T) {
+	authUC := usecase.NewAuthUseCase(ram_storage.NewUserRepo(), newTokenService(), ram_storage.NewRefreshRepo(), ram_storage.NewSecurityEventRepo(), newInvitations(t), plainHasher{}, newLoginGuard())
+	registered, err := authUC.Register("slim", "password", "Slim", "Shady", "", testInviteCode, domAuth.Client{})
+	require.NoError(t, err)
+	current, err := authUC.Refresh(registered.RefreshToken, domAuth.Client{})
+	require.NoError(t, err)
+
+	require.NoError(t, authUC.RevokeRefreshToken(current.RefreshToken))
+
+	_, err = authUC.Refresh(current.RefreshToken, domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRefresh)
+	assert.ErrorIs(t, authUC.RevokeRefreshToken(current.RefreshToken), usecase.ErrInvalidRefresh)
+}
+
+func TestAuthUseCase_Sessions(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	authUC := usecase.NewAuthUseCase(users, newTokenService(), ram_storage.NewRefreshRepo(), ram_storage.NewSecurityEventRepo(), newInvitations(t), plainHasher{}, newLoginGuard())
+	laptopClient := domAuth.Client{UserAgent: "Firefox", IP: "192.0.2.1"}
+	laptop, err := authUC.Register("slim", "password", "Slim", "Shady", "", testInviteCode, laptopClient)
+	require.NoError(t, err)
+	phone, err := authUC.Login("slim", "password", domAuth.Client{UserAgent: "Safari", IP: "192.0.2.2"})
+	require.NoError(t, err)
+	user, err := authUC.GetUserByLogin("slim")
+	require.NoError(t, err)
+
+	// Rotation keeps the session and records the client that refreshed last.
+	movedClient := domAuth.Client{UserAgent: "Firefox", IP: "198.51.100.7"}
+	laptop, err = authUC.Refresh(laptop.RefreshToken, movedClient)
+	require.NoError(t, err)
+
+	sessions, err := authUC.ListSessions(user.ID, laptop.RefreshToken)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.True(t, sessions[0].Current)
+	assert.Equal(t, movedClient, sessions[0].Client)
+	assert.False(t, sessions[0].LastUsedAt.Before(sessions[0].CreatedAt))
+	assert.False(t, sessions[1].Current)
+	assert.Equal(t, "Safari", sessions[1].Client.UserAgent)
+
+	other, err := authUC.Register("marshall", "password", "Marshall", "Mathers", "", testInviteCode, domAuth.Client{})
+	require.NoError(t, err)
+	otherUser, err := authUC.GetUserByLogin("marshall")
+	require.NoError(t, err)
+
+	t.Run("revoke session of another user", func(t *testing.T) {
+		assert.ErrorIs(t, authUC.RevokeSession(otherUser.ID, sessions[1].ID), usecase.ErrSessionNotFound)
+		_, err := authUC.Refresh(phone.RefreshToken, domAuth.Client{})
+		require.NoError(t, err, "session survives a foreign revoke")
+
+		phone, err = authUC.Login("slim", "password", domAuth.Client{UserAgent: "Safari"})
+		require.NoError(t, err)
+	})
+
+	t.Run("revoke one session", func(t *testing.T) {
+		require.NoError(t, authUC.RevokeSession(user.ID, sessions[0].ID))
+		assert.ErrorIs(t, authUC.RevokeSession(user.ID, sessions[0].ID), usecase.ErrSessionNotFound)
+
+		_, err := authUC.Refresh(laptop.RefreshToken, domAuth.Client{})
+		assert.ErrorIs(t, err, usecase.ErrInvalidRefresh)
+		phone, err = authUC.Refresh(phone.RefreshToken, domAuth.Client{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("revoke all sessions", func(t *testing.T) {
+		require.NoError(t, authUC.RevokeAllSessions(user.ID))
+
+		_, err := authUC.Refresh(phone.RefreshToken, domAuth.Client{})
+		assert.ErrorIs(t, err, usecase.ErrInvalidRefresh)
+		left, err := authUC.ListSessions(user.ID, "")
+		require.NoError(t, err)
+		assert.Empty(t, left)
+
+		_, err = authUC.Refresh(other.RefreshToken, domAuth.Client{})
+		assert.NoError(t, err, "other users keep their sessions")
+
+		assert.ErrorIs(t, authUC.RevokeAllSessions(404), usecase.ErrUserNotFound)
+	})
+}
+
+func TestAuthUseCase_LoginLockout(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	events := ram_storage.NewSecurityEventRepo()
+	// No backoff, so that the test does not have to wait.
+	policy := domAuth.LockoutPolicy{MaxFailures: 3, LockoutDuration: time.Hour, Window: time.Hour}
+	guard := usecase.NewLoginGuard(ram_storage.NewLoginAttemptRepo(), policy, domAuth.LockoutPolicy{Window: time.Hour})
+	authUC := usecase.NewAuthUseCase(users, newTokenService(), ram_storage.NewRefreshRepo(), events, ram_storage.NewInvitationRepo(), plainHasher{}, guard)
+
+	id, err := users.CreateUser(domUser.User{Login: "slim", PasswordHash: "password", Role: domUser.RoleStudent})
+	require.NoError(t, err)
+	client := domAuth.Client{IP: "192.0.2.1"}
+
+	for i := 0; i < policy.MaxFailures; i++ {
+		_, err := authUC.Login("slim", "wrong", client)
+		require.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+	}
+
+	_, err = authUC.Login("slim", "password", client)
+	assert.ErrorIs(t, err, usecase.ErrAccountLocked, "the right password does not help while locked")
+
+	recorded, err := events.ListEvents(id)
+	require.NoError(t, err)
+	require.Len(t, recorded, 1)
+	assert.Equal(t, domAuth.AccountLocked, recorded[0].Kind)
+
+	for i := 0; i < policy.MaxFailures; i++ {
+		_, err := authUC.Login("nobody", "wrong", client)
+		require.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+	}
+	_, err = authUC.Login("nobody", "wrong", client)
+	assert.ErrorIs(t, err, usecase.ErrAccountLocked, "unknown logins lock alike")
+
+	require.NoError(t, guard.Unlock("slim"))
+	_, err = authUC.Login("slim", "password", client)
+	assert.NoError(t, err)
+}
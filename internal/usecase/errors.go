@@ -6,6 +6,72 @@ var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrUserExists         = errors.New("user already exists")
 	ErrLoginInUse         = errors.New("login already in use")
+	ErrEmailInUse         = errors.New("email already in use")
 	ErrUserNotFound       = errors.New("user not found")
+	ErrUserIDConflict     = errors.New("user id already taken")
 	ErrInvalidRefresh     = errors.New("invalid refresh token")
+	ErrRefreshReused      = errors.New("refresh token reused, its sessions are revoked")
+	ErrRefreshNotFound    = errors.New("refresh token not found")
+	ErrRefreshRotated     = errors.New("refresh token already rotated")
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrInvalidRole        = errors.New("unknown role")
+	ErrUserBlocked        = errors.New("user blocked")
+	ErrOwnAccount         = errors.New("admins cannot block, demote or delete themselves")
+	ErrTooManyAttempts    = errors.New("too many sign-in attempts")
+	ErrAccountLocked      = errors.New("account temporarily locked")
+
+	ErrInvitationInvalid    = errors.New("invalid invitation code")
+	ErrInvitationNotFound   = errors.New("invitation not found")
+	ErrInvitationExists     = errors.New("invitation code already exists")
+	ErrPasswordTokenInvalid = errors.New("invalid or expired set-password token")
+
+	ErrDishNotFound = errors.New("dish not found")
+	ErrMenuNotFound = errors.New("menu not found")
+	ErrMenuExists   = errors.New("menu already exists")
+
+	ErrOrderNotFound = errors.New("order not found")
+	ErrOrderStatus   = errors.New("invalid order status transition")
+	ErrDishNotInMenu = errors.New("dish is not in menu")
+	ErrMenuClosed    = errors.New("menu is closed for orders")
+	ErrEmptyOrder    = errors.New("order has no items")
+
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrAlreadyCharged    = errors.New("order already charged")
+	ErrInvalidAmount     = errors.New("invalid amount")
+	ErrNotAStudent       = errors.New("user is not a student")
+	ErrAlreadyCredited   = errors.New("payment already credited")
+
+	ErrPaymentNotFound  = errors.New("payment not found")
+	ErrPaymentStatus    = errors.New("payment is already finalized")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidWebhook   = errors.New("invalid webhook payload")
+
+	ErrPlanNotFound         = errors.New("subscription plan not found")
+	ErrInvalidPlan          = errors.New("invalid subscription plan")
+	ErrPlanExpired          = errors.New("subscription plan has expired")
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+	ErrSubscriptionUsedUp   = errors.New("subscription has no meals left")
+
+	ErrUnknownAllergen  = errors.New("unknown allergen")
+	ErrAllergenConflict = errors.New("order contains allergens from dietary profile")
+
+	ErrReviewNotFound   = errors.New("review not found")
+	ErrInvalidRating    = errors.New("invalid rating")
+	ErrReviewNotAllowed = errors.New("only dishes from issued orders can be reviewed")
+	ErrAlreadyReviewed  = errors.New("dish already reviewed for this order")
+
+	ErrProductNotFound   = errors.New("product not found")
+	ErrProductExists     = errors.New("product already exists")
+	ErrInsufficientStock = errors.New("insufficient stock")
+	ErrInvalidQuantity   = errors.New("invalid quantity")
+	ErrInvalidMovement   = errors.New("invalid stock movement")
+	ErrUnknownUnit       = errors.New("unknown unit")
+
+	ErrPurchaseRequestNotFound = errors.New("purchase request not found")
+	ErrPurchaseStatus          = errors.New("invalid purchase request status transition")
+	ErrEmptyPurchaseRequest    = errors.New("purchase request has no items")
+	ErrNotRequestAuthor        = errors.New("only the author can change a purchase request")
+
+	ErrInvalidRecipe   = errors.New("invalid recipe")
+	ErrInvalidPortions = errors.New("invalid planned portions")
 )
@@ -4,28 +4,229 @@ import (
 	"time"
 
 	domAuth "canteen-app/internal/domain/auth"
+	domInventory "canteen-app/internal/domain/inventory"
+	domMail "canteen-app/internal/domain/mail"
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domPayment "canteen-app/internal/domain/payment"
+	domProcurement "canteen-app/internal/domain/procurement"
+	domRecipe "canteen-app/internal/domain/recipe"
+	domReview "canteen-app/internal/domain/review"
+	domSubscription "canteen-app/internal/domain/subscription"
 	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
 )
 
+// UserRepository rejects a user whose login is already taken with
+// ErrLoginInUse and one whose email is with ErrEmailInUse; an empty email is
+// never taken. An empty password hash marks an account whose password has not
+// been set yet.
 type UserRepository interface {
-	CreateUser(user domUser.User) domUser.UserID
+	CreateUser(user domUser.User) (domUser.UserID, error)
 	GetUserByID(id domUser.UserID) (*domUser.User, error)
 	GetUserByLogin(login string) (*domUser.User, error)
+	GetUserByEmail(email string) (*domUser.User, error)
+	SetPasswordHash(id domUser.UserID, hash string) error
+	// ListUsers returns the page of users the query selects and how many
+	// users match it in total.
+	ListUsers(query domUser.ListQuery) ([]domUser.User, int, error)
+	// UpdateUser saves the name, surname, role and blocked flag of the user;
+	// the login, email and password stay as they are.
+	UpdateUser(user domUser.User) error
+	DeleteUser(id domUser.UserID) error
 }
 
+// DietaryProfileRepository returns an empty profile for users that have not
+// filled one in yet.
+type DietaryProfileRepository interface {
+	GetProfile(userID domUser.UserID) (*domUser.DietaryProfile, error)
+	SaveProfile(profile domUser.DietaryProfile) error
+}
+
+// RefreshTokenRepository stores issued refresh tokens. Get reports
+// ErrRefreshNotFound for unknown and expired tokens. Rotate atomically marks
+// a token rotated and stores next, its successor in the same family; it
+// reports ErrRefreshRotated when the token has been rotated already.
+// ListActive returns the unrotated, unexpired tokens of a user, one per
+// family, oldest family first. DeleteExpired and CountActive take the current
+// time and return the number of tokens removed and of unrotated tokens still
+// valid respectively.
 type RefreshTokenRepository interface {
-	Save(tokenID string, userID domUser.UserID, exp time.Time)
-	Delete(tokenID string)
-	IsValid(tokenID string, userID domUser.UserID) bool
+	Save(token domAuth.RefreshToken) error
+	Get(tokenID string) (*domAuth.RefreshToken, error)
+	Rotate(tokenID string, next domAuth.RefreshToken) error
+	ListActive(userID domUser.UserID, now time.Time) ([]domAuth.RefreshToken, error)
+	DeleteFamily(familyID string) error
+	DeleteByUser(userID domUser.UserID) error
+	DeleteExpired(now time.Time) (int, error)
+	CountActive(now time.Time) (int, error)
+}
+
+// InvitationRepository stores class invitation codes; CreateInvitation
+// reports ErrInvitationExists for a taken code. UseInvitation atomically
+// counts one use of a usable invitation and reports ErrInvitationInvalid
+// otherwise; ReleaseInvitation gives a use back. ListInvitations returns the
+// newest first.
+type InvitationRepository interface {
+	CreateInvitation(invitation domAuth.Invitation) error
+	ListInvitations() ([]domAuth.Invitation, error)
+	DeleteInvitation(code string) error
+	UseInvitation(code string, now time.Time) (*domAuth.Invitation, error)
+	ReleaseInvitation(code string) error
+}
+
+// PasswordTokenRepository stores set-password tokens by hash.
+// TakePasswordToken deletes the token, so each works once, and reports
+// ErrPasswordTokenInvalid for unknown and expired ones.
+type PasswordTokenRepository interface {
+	SavePasswordToken(token domAuth.PasswordToken) error
+	TakePasswordToken(hash string, now time.Time) (*domAuth.PasswordToken, error)
+}
+
+// SecurityEventRepository is the audit trail of suspicious activity.
+// ListEvents returns the events of a user, oldest first.
+type SecurityEventRepository interface {
+	RecordEvent(event domAuth.SecurityEvent) (domAuth.SecurityEventID, error)
+	ListEvents(userID domUser.UserID) ([]domAuth.SecurityEvent, error)
+}
+
+// LoginAttemptRepository counts failed sign-ins by key and returns zero
+// attempts for unknown keys. RecordFailure atomically counts a failure at now,
+// starting over when the previous one is older than window, and returns the
+// updated attempts. Lock keeps the failures and sets LockedUntil.
+type LoginAttemptRepository interface {
+	GetAttempts(key string) (domAuth.Attempts, error)
+	RecordFailure(key string, now time.Time, window time.Duration) (domAuth.Attempts, error)
+	Lock(key string, until time.Time) error
+	ResetAttempts(key string) error
+}
+
+type MenuRepository interface {
+	CreateDish(dish domMenu.Dish) (domMenu.DishID, error)
+	UpdateDish(dish domMenu.Dish) error
+	GetDishByID(id domMenu.DishID) (*domMenu.Dish, error)
+	ListDishes() ([]domMenu.Dish, error)
+	CreateMenu(menu domMenu.Menu) (domMenu.MenuID, error)
+	UpdateMenu(menu domMenu.Menu) error
+	GetMenuByID(id domMenu.MenuID) (*domMenu.Menu, error)
+	GetMenusByDate(date time.Time) ([]domMenu.Menu, error)
+}
+
+type OrderRepository interface {
+	CreateOrder(order domOrder.Order) (domOrder.OrderID, error)
+	GetOrderByID(id domOrder.OrderID) (*domOrder.Order, error)
+	ListOrdersByStudent(studentID domUser.UserID) ([]domOrder.Order, error)
+	ListOrdersByDate(date time.Time, statuses ...domOrder.Status) ([]domOrder.Order, error)
+	UpdateStatus(id domOrder.OrderID, from, to domOrder.Status) error
+	MarkPaidBySubscription(id domOrder.OrderID, subID domSubscription.SubscriptionID) error
+}
+
+// WalletRepository stores the append-only ledger. Append must be atomic: debit
+// entries that would take the balance below zero are rejected with
+// ErrInsufficientFunds, and a Charge for an order that already has one not
+// matched by a Refund is rejected with ErrAlreadyCharged; a payment rolled
+// back by a refund can so be retried. A second TopUp for the same payment is rejected with
+// ErrAlreadyCredited.
+type WalletRepository interface {
+	Append(entry domWallet.Entry) (domWallet.EntryID, error)
+	ListEntries(studentID domUser.UserID) ([]domWallet.Entry, error)
+	Balance(studentID domUser.UserID) (int64, error)
+}
+
+type PaymentRepository interface {
+	CreatePayment(payment domPayment.Payment) error
+	GetPaymentByID(id domPayment.PaymentID) (*domPayment.Payment, error)
+	UpdateStatus(id domPayment.PaymentID, from, to domPayment.Status) error
+}
+
+type SubscriptionRepository interface {
+	CreatePlan(plan domSubscription.Plan) (domSubscription.PlanID, error)
+	GetPlanByID(id domSubscription.PlanID) (*domSubscription.Plan, error)
+	ListPlans() ([]domSubscription.Plan, error)
+	CreateSubscription(sub domSubscription.Subscription) (domSubscription.SubscriptionID, error)
+	GetSubscriptionByID(id domSubscription.SubscriptionID) (*domSubscription.Subscription, error)
+	ListSubscriptionsByStudent(studentID domUser.UserID) ([]domSubscription.Subscription, error)
+	DecrementRemaining(id domSubscription.SubscriptionID) error
+}
+
+// ReviewRepository stores dish reviews. CreateReview rejects a second review
+// of the same dish from the same order with ErrAlreadyReviewed. ListReviews
+// returns reviews of all dishes when dishID is zero.
+type ReviewRepository interface {
+	CreateReview(review domReview.Review) (domReview.ReviewID, error)
+	GetReviewByID(id domReview.ReviewID) (*domReview.Review, error)
+	ListReviews(dishID domMenu.DishID, includeHidden bool) ([]domReview.Review, error)
+	SetHidden(id domReview.ReviewID, hidden bool) error
+	Ratings(dishIDs []domMenu.DishID) (map[domMenu.DishID]domReview.Rating, error)
+}
+
+// InventoryRepository stores kitchen products and their stock movements.
+// ApplyMovement must be atomic: it changes the product quantity by the
+// movement quantity and rejects movements that would take it below zero with
+// ErrInsufficientStock.
+type InventoryRepository interface {
+	CreateProduct(product domInventory.Product) (domInventory.ProductID, error)
+	UpdateProduct(product domInventory.Product) error
+	GetProductByID(id domInventory.ProductID) (*domInventory.Product, error)
+	ListProducts() ([]domInventory.Product, error)
+	ApplyMovement(movement domInventory.Movement) (domInventory.MovementID, error)
+	ListMovements(productID domInventory.ProductID) ([]domInventory.Movement, error)
+}
+
+// ProcurementRepository stores purchase requests. UpdateItems only changes
+// drafts and UpdateStatus is a compare-and-swap on the status; both report
+// ErrPurchaseStatus otherwise. A non-zero approverID is recorded on the
+// request.
+type ProcurementRepository interface {
+	CreateRequest(request domProcurement.Request) (domProcurement.RequestID, error)
+	GetRequestByID(id domProcurement.RequestID) (*domProcurement.Request, error)
+	ListRequests(statuses ...domProcurement.Status) ([]domProcurement.Request, error)
+	UpdateItems(id domProcurement.RequestID, items []domProcurement.Item, estimatedCost int64) error
+	UpdateStatus(id domProcurement.RequestID, from, to domProcurement.Status, approverID domUser.UserID) error
+	AddComment(id domProcurement.RequestID, comment domProcurement.Comment) error
+}
+
+// RecipeRepository stores dish recipes. GetRecipe returns a recipe without
+// ingredients for dishes that have none.
+type RecipeRepository interface {
+	GetRecipe(dishID domMenu.DishID) (*domRecipe.Recipe, error)
+	SaveRecipe(recipe domRecipe.Recipe) error
+}
+
+// IngredientConsumer deducts the ingredients of an issued order from the
+// inventory.
+type IngredientConsumer interface {
+	ConsumeIngredients(order domOrder.Order) error
+}
+
+// PaymentGateway is the port to the card acquirer. CreatePayment registers a
+// payment and returns the URL the payer has to be redirected to; the outcome
+// is delivered later to the webhook, whose payload ParseWebhook authenticates
+// and decodes.
+type PaymentGateway interface {
+	CreatePayment(id domPayment.PaymentID, amount int64, description string) (string, error)
+	ParseWebhook(body []byte, signature string) (domPayment.Event, error)
+}
+
+// Mailer delivers email. Send returns once the message is handed over, which
+// says nothing about whether it reaches the inbox.
+type Mailer interface {
+	Send(msg domMail.Message) error
 }
 
 type TokenService interface {
-	GenerateAccessToken(userID domUser.UserID, role string) (string, error)
+	GenerateAccessToken(userID domUser.UserID, role domUser.Role) (string, error)
 	ParseAccessToken(tokenStr string) (domAuth.Claims, error)
 	GenerateRefreshToken(userID domUser.UserID) (string, string, time.Time, error)
 	ParseRefreshToken(tokenStr string) (domUser.UserID, string, error)
 }
 
+// SessionMetrics receives the number of unexpired refresh tokens after every
+// cleanup.
+type SessionMetrics interface {
+	SetActiveSessions(n int)
+}
+
 type PasswordHasher interface {
 	Hash(password string) (string, error)
 	Compare(hash, password string) error
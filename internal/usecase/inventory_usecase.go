@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	domInventory "canteen-app/internal/domain/inventory"
+)
+
+type inventoryUseCase struct {
+	inventory InventoryRepository
+	menus     MenuRepository
+}
+
+func NewInventoryUseCase(inventory InventoryRepository, menus MenuRepository) *inventoryUseCase {
+	return &inventoryUseCase{inventory: inventory, menus: menus}
+}
+
+// CreateProduct registers a product with zero stock. Stock is added with a
+// receipt movement.
+func (uc *inventoryUseCase) CreateProduct(product domInventory.Product) (*domInventory.Product, error) {
+	product.ID = 0
+	product.Name = strings.TrimSpace(product.Name)
+	product.Quantity = 0
+
+	if !product.Unit.Valid() {
+		return nil, ErrUnknownUnit
+	}
+	if product.MinQuantity < 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	id, err := uc.inventory.CreateProduct(product)
+	if err != nil {
+		return nil, err
+	}
+
+	product.ID = id
+	return &product, nil
+}
+
+// UpdateProduct changes the name, unit and low-stock threshold of a product.
+func (uc *inventoryUseCase) UpdateProduct(product domInventory.Product) (*domInventory.Product, error) {
+	product.Name = strings.TrimSpace(product.Name)
+
+	if !product.Unit.Valid() {
+		return nil, ErrUnknownUnit
+	}
+	if product.MinQuantity < 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	if err := uc.inventory.UpdateProduct(product); err != nil {
+		return nil, err
+	}
+
+	return uc.inventory.GetProductByID(product.ID)
+}
+
+func (uc *inventoryUseCase) GetProduct(id domInventory.ProductID) (*domInventory.Product, error) {
+	return uc.inventory.GetProductByID(id)
+}
+
+func (uc *inventoryUseCase) ListProducts() ([]domInventory.Product, error) {
+	return uc.inventory.ListProducts()
+}
+
+func (uc *inventoryUseCase) LowStock() ([]domInventory.Product, error) {
+	products, err := uc.inventory.ListProducts()
+	if err != nil {
+		return nil, err
+	}
+
+	low := make([]domInventory.Product, 0)
+	for _, product := range products {
+		if product.IsLow() {
+			low = append(low, product)
+		}
+	}
+	return low, nil
+}
+
+// RecordMovement changes the product stock. Quantity is given as a positive
+// amount; its sign is derived from the movement kind. Consumption must name
+// the dish the product was used for.
+func (uc *inventoryUseCase) RecordMovement(movement domInventory.Movement) (*domInventory.Movement, error) {
+	if movement.Quantity <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	switch movement.Kind {
+	case domInventory.Receipt:
+		movement.DishID = 0
+
+	case domInventory.WriteOff:
+		movement.DishID = 0
+		movement.Quantity = -movement.Quantity
+
+	case domInventory.Consumption:
+		if movement.DishID == 0 {
+			return nil, ErrInvalidMovement
+		}
+		if _, err := uc.menus.GetDishByID(movement.DishID); err != nil {
+			return nil, err
+		}
+		movement.Quantity = -movement.Quantity
+
+	default:
+		return nil, ErrInvalidMovement
+	}
+
+	movement.ID = 0
+	movement.Comment = strings.TrimSpace(movement.Comment)
+	movement.CreatedAt = time.Now()
+
+	id, err := uc.inventory.ApplyMovement(movement)
+	if err != nil {
+		return nil, err
+	}
+
+	movement.ID = id
+	return &movement, nil
+}
+
+func (uc *inventoryUseCase) ListMovements(productID domInventory.ProductID) ([]domInventory.Movement, error) {
+	if _, err := uc.inventory.GetProductByID(productID); err != nil {
+		return nil, err
+	}
+	return uc.inventory.ListMovements(productID)
+}
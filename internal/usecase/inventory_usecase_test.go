@@ -0,0 +1,92 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domInventory "canteen-app/internal/domain/inventory"
+	domMenu "canteen-app/internal/domain/menu"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryUseCase_RecordMovement(t *testing.T) {
+	menuRepo := ram_storage.NewMenuRepo()
+	inventoryUC := usecase.NewInventoryUseCase(ram_storage.NewInventoryRepo(), menuRepo)
+
+	dishID, err := menuRepo.CreateDish(domMenu.Dish{Name: "Блины", Price: 8000})
+	require.NoError(t, err)
+
+	flour, err := inventoryUC.CreateProduct(domInventory.Product{Name: "Мука", Unit: domInventory.Kilogram, MinQuantity: 5})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		movement     domInventory.Movement
+		wantErr      error
+		wantQuantity float64
+	}{
+		{
+			name:         "receipt adds stock",
+			movement:     domInventory.Movement{Kind: domInventory.Receipt, Quantity: 10},
+			wantQuantity: 10,
+		},
+
+		{
+			name:         "consumption by dish",
+			movement:     domInventory.Movement{Kind: domInventory.Consumption, Quantity: 3, DishID: dishID},
+			wantQuantity: 7,
+		},
+
+		{
+			name:         "consumption without dish",
+			movement:     domInventory.Movement{Kind: domInventory.Consumption, Quantity: 1},
+			wantErr:      usecase.ErrInvalidMovement,
+			wantQuantity: 7,
+		},
+
+		{
+			name:         "write-off below zero",
+			movement:     domInventory.Movement{Kind: domInventory.WriteOff, Quantity: 8},
+			wantErr:      usecase.ErrInsufficientStock,
+			wantQuantity: 7,
+		},
+
+		{
+			name:         "write-off",
+			movement:     domInventory.Movement{Kind: domInventory.WriteOff, Quantity: 2.5},
+			wantQuantity: 4.5,
+		},
+
+		{
+			name:         "negative quantity",
+			movement:     domInventory.Movement{Kind: domInventory.Receipt, Quantity: -1},
+			wantErr:      usecase.ErrInvalidQuantity,
+			wantQuantity: 4.5,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.movement.ProductID = flour.ID
+
+			_, err := inventoryUC.RecordMovement(tc.movement)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			product, err := inventoryUC.GetProduct(flour.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantQuantity, product.Quantity)
+		})
+	}
+
+	low, err := inventoryUC.LowStock()
+	require.NoError(t, err)
+	require.Len(t, low, 1)
+	assert.Equal(t, flour.ID, low[0].ID)
+}
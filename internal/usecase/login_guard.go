@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"time"
+
+	domAuth "canteen-app/internal/domain/auth"
+)
+
+// LoginGuard throttles password guessing. Failures are counted per login and
+// per client IP, each under its own policy: a login is locked out for a while
+// after too many wrong passwords, while an IP, which a whole school may
+// share behind NAT, is usually only slowed down.
+type LoginGuard struct {
+	attempts    LoginAttemptRepository
+	loginPolicy domAuth.LockoutPolicy
+	ipPolicy    domAuth.LockoutPolicy
+}
+
+func NewLoginGuard(attempts LoginAttemptRepository, loginPolicy, ipPolicy domAuth.LockoutPolicy) *LoginGuard {
+	return &LoginGuard{attempts: attempts, loginPolicy: loginPolicy, ipPolicy: ipPolicy}
+}
+
+func loginKey(login string) string { return "login:" + login }
+
+func ipKey(ip string) string { return "ip:" + ip }
+
+// Check reports ErrAccountLocked for a locked login and ErrTooManyAttempts
+// while the login or the IP has to wait before the next attempt. Unknown
+// logins are throttled alike, so the answer tells nothing about which
+// accounts exist.
+func (g *LoginGuard) Check(login, ip string, now time.Time) error {
+	attempts, err := g.attempts.GetAttempts(loginKey(login))
+	if err != nil {
+		return err
+	}
+	if attempts.LockedUntil.After(now) {
+		return ErrAccountLocked
+	}
+	if g.loginPolicy.RetryAt(attempts).After(now) {
+		return ErrTooManyAttempts
+	}
+
+	if ip == "" {
+		return nil
+	}
+	attempts, err = g.attempts.GetAttempts(ipKey(ip))
+	if err != nil {
+		return err
+	}
+	if g.ipPolicy.RetryAt(attempts).After(now) {
+		return ErrTooManyAttempts
+	}
+	return nil
+}
+
+// Fail counts a wrong password and reports whether it locked the login.
+func (g *LoginGuard) Fail(login, ip string, now time.Time) (bool, error) {
+	locked, err := g.fail(loginKey(login), g.loginPolicy, now)
+	if err != nil {
+		return false, err
+	}
+	if ip != "" {
+		if _, err := g.fail(ipKey(ip), g.ipPolicy, now); err != nil {
+			return false, err
+		}
+	}
+	return locked, nil
+}
+
+func (g *LoginGuard) fail(key string, policy domAuth.LockoutPolicy, now time.Time) (bool, error) {
+	attempts, err := g.attempts.RecordFailure(key, now, policy.Window)
+	if err != nil {
+		return false, err
+	}
+	if !policy.Locks(attempts) || attempts.LockedUntil.After(now) {
+		return false, nil
+	}
+	return true, g.attempts.Lock(key, now.Add(policy.LockoutDuration))
+}
+
+// Succeed forgets the failures of the login after a correct password. Those
+// of the IP are kept: signing in to one's own account must not clear the way
+// for guessing others.
+func (g *LoginGuard) Succeed(login string) error {
+	return g.attempts.ResetAttempts(loginKey(login))
+}
+
+// Unlock lifts the lockout of the login and forgets its failures.
+func (g *LoginGuard) Unlock(login string) error {
+	return g.attempts.ResetAttempts(loginKey(login))
+}
@@ -0,0 +1,81 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domAuth "canteen-app/internal/domain/auth"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginGuard_Backoff(t *testing.T) {
+	policy := testLockout
+	policy.MaxFailures = 0
+	guard := usecase.NewLoginGuard(ram_storage.NewLoginAttemptRepo(), policy, domAuth.LockoutPolicy{Window: time.Hour})
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	for i := 0; i < policy.FreeFailures; i++ {
+		require.NoError(t, guard.Check("slim", "192.0.2.1", now), "failure %d is free", i)
+		locked, err := guard.Fail("slim", "192.0.2.1", now)
+		require.NoError(t, err)
+		assert.False(t, locked)
+	}
+	assert.NoError(t, guard.Check("slim", "192.0.2.1", now), "the free failures do not delay")
+
+	_, err := guard.Fail("slim", "192.0.2.1", now)
+	require.NoError(t, err)
+	assert.ErrorIs(t, guard.Check("slim", "192.0.2.1", now), usecase.ErrTooManyAttempts)
+	assert.ErrorIs(t, guard.Check("slim", "198.51.100.7", now), usecase.ErrTooManyAttempts, "the login waits on any IP")
+	assert.NoError(t, guard.Check("marshall", "192.0.2.1", now), "other logins do not wait")
+	assert.NoError(t, guard.Check("slim", "192.0.2.1", now.Add(policy.BaseDelay)))
+
+	now = now.Add(policy.BaseDelay)
+	_, err = guard.Fail("slim", "192.0.2.1", now)
+	require.NoError(t, err)
+	assert.ErrorIs(t, guard.Check("slim", "192.0.2.1", now.Add(policy.BaseDelay)), usecase.ErrTooManyAttempts, "the delay doubles")
+	assert.NoError(t, guard.Check("slim", "192.0.2.1", now.Add(2*policy.BaseDelay)))
+
+	require.NoError(t, guard.Succeed("slim"))
+	assert.NoError(t, guard.Check("slim", "192.0.2.1", now))
+}
+
+func TestLoginGuard_Lockout(t *testing.T) {
+	guard := usecase.NewLoginGuard(ram_storage.NewLoginAttemptRepo(), testLockout, domAuth.LockoutPolicy{Window: time.Hour})
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	var locked bool
+	for i := 0; i < testLockout.MaxFailures; i++ {
+		var err error
+		locked, err = guard.Fail("slim", "192.0.2.1", now)
+		require.NoError(t, err)
+	}
+	assert.True(t, locked, "the last failure locks the login")
+
+	later := now.Add(testLockout.MaxDelay)
+	assert.ErrorIs(t, guard.Check("slim", "192.0.2.1", later), usecase.ErrAccountLocked)
+	assert.NoError(t, guard.Check("slim", "192.0.2.1", now.Add(testLockout.LockoutDuration+testLockout.MaxDelay)))
+
+	require.NoError(t, guard.Unlock("slim"))
+	assert.NoError(t, guard.Check("slim", "192.0.2.1", later))
+}
+
+func TestLoginGuard_IP(t *testing.T) {
+	ipPolicy := domAuth.LockoutPolicy{FreeFailures: 2, BaseDelay: time.Second, MaxDelay: time.Minute, Window: time.Hour}
+	guard := usecase.NewLoginGuard(ram_storage.NewLoginAttemptRepo(), testLockout, ipPolicy)
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	for _, login := range []string{"slim", "marshall", "eminem"} {
+		_, err := guard.Fail(login, "192.0.2.1", now)
+		require.NoError(t, err)
+	}
+
+	assert.ErrorIs(t, guard.Check("dre", "192.0.2.1", now), usecase.ErrTooManyAttempts, "spraying logins from one IP is slowed down")
+	assert.NoError(t, guard.Check("dre", "198.51.100.7", now))
+
+	require.NoError(t, guard.Succeed("dre"))
+	assert.ErrorIs(t, guard.Check("dre", "192.0.2.1", now), usecase.ErrTooManyAttempts, "a success does not clear the IP")
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"slices"
+	"time"
+
+	domMenu "canteen-app/internal/domain/menu"
+)
+
+type menuUseCase struct {
+	menus MenuRepository
+}
+
+func NewMenuUseCase(menus MenuRepository) *menuUseCase {
+	return &menuUseCase{menus: menus}
+}
+
+func (uc *menuUseCase) CreateDish(dish domMenu.Dish) (*domMenu.Dish, error) {
+	dish.ID = 0
+
+	allergens, err := normalizeAllergens(dish.Allergens)
+	if err != nil {
+		return nil, err
+	}
+	dish.Allergens = allergens
+
+	id, err := uc.menus.CreateDish(dish)
+	if err != nil {
+		return nil, err
+	}
+
+	dish.ID = id
+	return &dish, nil
+}
+
+func (uc *menuUseCase) UpdateDish(dish domMenu.Dish) (*domMenu.Dish, error) {
+	allergens, err := normalizeAllergens(dish.Allergens)
+	if err != nil {
+		return nil, err
+	}
+	dish.Allergens = allergens
+
+	if _, err := uc.menus.GetDishByID(dish.ID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.menus.UpdateDish(dish); err != nil {
+		return nil, err
+	}
+
+	return &dish, nil
+}
+
+func (uc *menuUseCase) ListDishes() ([]domMenu.Dish, error) {
+	return uc.menus.ListDishes()
+}
+
+func (uc *menuUseCase) CreateMenu(date time.Time, mealType domMenu.MealType, dishIDs []domMenu.DishID) (*domMenu.Menu, error) {
+	dishes, err := uc.resolveDishes(dishIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	menu := domMenu.Menu{
+		Date:     truncateToDay(date),
+		MealType: mealType,
+		Dishes:   dishes,
+	}
+
+	id, err := uc.menus.CreateMenu(menu)
+	if err != nil {
+		return nil, err
+	}
+
+	menu.ID = id
+	return &menu, nil
+}
+
+func (uc *menuUseCase) UpdateMenu(id domMenu.MenuID, dishIDs []domMenu.DishID) (*domMenu.Menu, error) {
+	menu, err := uc.menus.GetMenuByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	dishes, err := uc.resolveDishes(dishIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	menu.Dishes = dishes
+	if err := uc.menus.UpdateMenu(*menu); err != nil {
+		return nil, err
+	}
+
+	return menu, nil
+}
+
+func (uc *menuUseCase) GetMenu(id domMenu.MenuID) (*domMenu.Menu, error) {
+	return uc.menus.GetMenuByID(id)
+}
+
+func (uc *menuUseCase) GetDayMenu(date time.Time) ([]domMenu.Menu, error) {
+	return uc.menus.GetMenusByDate(truncateToDay(date))
+}
+
+func (uc *menuUseCase) resolveDishes(dishIDs []domMenu.DishID) ([]domMenu.Dish, error) {
+	dishes := make([]domMenu.Dish, 0, len(dishIDs))
+	seen := make(map[domMenu.DishID]struct{}, len(dishIDs))
+
+	for _, id := range dishIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		dish, err := uc.menus.GetDishByID(id)
+		if err != nil {
+			return nil, err
+		}
+		dishes = append(dishes, *dish)
+	}
+
+	return dishes, nil
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// normalizeAllergens rejects unknown allergens and drops duplicates.
+func normalizeAllergens(allergens []domMenu.Allergen) ([]domMenu.Allergen, error) {
+	normalized := make([]domMenu.Allergen, 0, len(allergens))
+	for _, a := range allergens {
+		if !a.Valid() {
+			return nil, ErrUnknownAllergen
+		}
+		if !slices.Contains(normalized, a) {
+			normalized = append(normalized, a)
+		}
+	}
+	return normalized, nil
+}
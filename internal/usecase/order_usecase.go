@@ -0,0 +1,341 @@
+package usecase
+
+import (
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domSubscription "canteen-app/internal/domain/subscription"
+	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
+)
+
+type orderUseCase struct {
+	orders        OrderRepository
+	menus         MenuRepository
+	wallet        WalletRepository
+	subscriptions SubscriptionRepository
+	profiles      DietaryProfileRepository
+	ingredients   IngredientConsumer
+}
+
+func NewOrderUseCase(
+	orders OrderRepository,
+	menus MenuRepository,
+	wallet WalletRepository,
+	subscriptions SubscriptionRepository,
+	profiles DietaryProfileRepository,
+	ingredients IngredientConsumer,
+) *orderUseCase {
+	return &orderUseCase{
+		orders:        orders,
+		menus:         menus,
+		wallet:        wallet,
+		subscriptions: subscriptions,
+		profiles:      profiles,
+		ingredients:   ingredients,
+	}
+}
+
+// PlaceOrder creates an order for the dishes of the menu. Orders with dishes
+// that contain allergens from the student's dietary profile are rejected with
+// ErrAllergenConflict unless confirmAllergens is set.
+func (uc *orderUseCase) PlaceOrder(studentID domUser.UserID, menuID domMenu.MenuID, items []domOrder.Item, confirmAllergens bool) (*domOrder.Order, error) {
+	menu, err := uc.menus.GetMenuByID(menuID)
+	if err != nil {
+		return nil, err
+	}
+
+	if menu.Date.Before(truncateToDay(time.Now())) {
+		return nil, ErrMenuClosed
+	}
+
+	dishes := make(map[domMenu.DishID]domMenu.Dish, len(menu.Dishes))
+	for _, dish := range menu.Dishes {
+		dishes[dish.ID] = dish
+	}
+
+	quantities := make(map[domMenu.DishID]int, len(items))
+	ordered := make([]domMenu.DishID, 0, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			continue
+		}
+		if _, ok := dishes[item.DishID]; !ok {
+			return nil, ErrDishNotInMenu
+		}
+		if _, ok := quantities[item.DishID]; !ok {
+			ordered = append(ordered, item.DishID)
+		}
+		quantities[item.DishID] += item.Quantity
+	}
+
+	if len(ordered) == 0 {
+		return nil, ErrEmptyOrder
+	}
+
+	profile, err := uc.profiles.GetProfile(studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []domMenu.Allergen
+	for _, id := range ordered {
+		for _, a := range dishes[id].Conflicts(profile.Allergens) {
+			if !slices.Contains(conflicts, a) {
+				conflicts = append(conflicts, a)
+			}
+		}
+	}
+
+	if len(conflicts) > 0 && !confirmAllergens {
+		return nil, ErrAllergenConflict
+	}
+
+	now := time.Now()
+	order := domOrder.Order{
+		StudentID:          studentID,
+		MenuID:             menu.ID,
+		Date:               menu.Date,
+		MealType:           menu.MealType,
+		Items:              make([]domOrder.Item, 0, len(ordered)),
+		Status:             domOrder.Placed,
+		ConfirmedAllergens: conflicts,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	for _, id := range ordered {
+		dish := dishes[id]
+		order.Items = append(order.Items, domOrder.Item{
+			DishID:   dish.ID,
+			Name:     dish.Name,
+			Price:    dish.Price,
+			Quantity: quantities[id],
+		})
+		order.Total += dish.Price * int64(quantities[id])
+	}
+
+	id, err := uc.orders.CreateOrder(order)
+	if err != nil {
+		return nil, err
+	}
+
+	order.ID = id
+	if len(conflicts) > 0 {
+		log.Printf("student %d confirmed order %d containing allergens %v", studentID, order.ID, conflicts)
+	}
+	return &order, nil
+}
+
+func (uc *orderUseCase) GetStudentOrder(studentID domUser.UserID, orderID domOrder.OrderID) (*domOrder.Order, error) {
+	order, err := uc.orders.GetOrderByID(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.StudentID != studentID {
+		return nil, ErrOrderNotFound
+	}
+
+	return order, nil
+}
+
+func (uc *orderUseCase) ListStudentOrders(studentID domUser.UserID) ([]domOrder.Order, error) {
+	return uc.orders.ListOrdersByStudent(studentID)
+}
+
+// PayOrder marks the order paid. If an active subscription covers the meal the
+// order is paid by it, otherwise the order total is charged to the student's
+// wallet. The wallet rejects the charge if it would take the balance negative
+// or if the order has already been charged, so concurrent payments of the same
+// order cannot both succeed.
+func (uc *orderUseCase) PayOrder(studentID domUser.UserID, orderID domOrder.OrderID) (*domOrder.Order, error) {
+	order, err := uc.GetStudentOrder(studentID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.Status.CanTransitionTo(domOrder.Paid) {
+		return nil, ErrOrderStatus
+	}
+
+	sub, err := uc.coveringSubscription(order)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub != nil {
+		if err := uc.orders.MarkPaidBySubscription(order.ID, sub.ID); err != nil {
+			return nil, err
+		}
+		return uc.orders.GetOrderByID(order.ID)
+	}
+
+	_, err = uc.wallet.Append(domWallet.Entry{
+		StudentID: order.StudentID,
+		Kind:      domWallet.Charge,
+		Amount:    -order.Total,
+		OrderID:   order.ID,
+		AuthorID:  studentID,
+		CreatedAt: time.Now(),
+	})
+	if errors.Is(err, ErrAlreadyCharged) {
+		return nil, ErrOrderStatus
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	paid, err := uc.transition(order, domOrder.Paid)
+	if err != nil {
+		if refundErr := uc.refund(order, "payment rolled back"); refundErr != nil {
+			return nil, errors.Join(err, refundErr)
+		}
+		return nil, err
+	}
+
+	return paid, nil
+}
+
+// CancelOrder cancels the student's order and refunds it if it was paid.
+func (uc *orderUseCase) CancelOrder(studentID domUser.UserID, orderID domOrder.OrderID) (*domOrder.Order, error) {
+	order, err := uc.GetStudentOrder(studentID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled, err := uc.transition(order, domOrder.Cancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	// The status is switched first so that concurrent cancellations refund
+	// only once; it is switched back if the refund cannot be written, so that
+	// cancelling can be retried.
+	if order.Status == domOrder.Paid && order.SubscriptionID == 0 {
+		if err := uc.refund(order, "order cancelled"); err != nil {
+			if rollbackErr := uc.orders.UpdateStatus(order.ID, domOrder.Cancelled, order.Status); rollbackErr != nil {
+				return nil, errors.Join(err, rollbackErr)
+			}
+			return nil, err
+		}
+	}
+
+	return cancelled, nil
+}
+
+func (uc *orderUseCase) Queue(date time.Time) ([]domOrder.Order, error) {
+	return uc.orders.ListOrdersByDate(truncateToDay(date), domOrder.Paid, domOrder.Prepared)
+}
+
+func (uc *orderUseCase) MarkPrepared(orderID domOrder.OrderID) (*domOrder.Order, error) {
+	order, err := uc.orders.GetOrderByID(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.transition(order, domOrder.Prepared)
+}
+
+// MarkIssued hands the order out and deducts the ingredients of its dishes
+// from the inventory. Orders paid by a subscription use up one of its meals.
+// The food is gone once the order is issued, so failures of the bookkeeping
+// that follows are logged rather than reported for an order that cannot be
+// issued again.
+func (uc *orderUseCase) MarkIssued(orderID domOrder.OrderID) (*domOrder.Order, error) {
+	order, err := uc.orders.GetOrderByID(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	issued, err := uc.transition(order, domOrder.Issued)
+	if err != nil {
+		return nil, err
+	}
+
+	if issued.SubscriptionID != 0 {
+		err := uc.subscriptions.DecrementRemaining(issued.SubscriptionID)
+		if errors.Is(err, ErrSubscriptionUsedUp) {
+			log.Printf("order %d issued on used up subscription %d", issued.ID, issued.SubscriptionID)
+		} else if err != nil {
+			log.Printf("order %d issued, but its meal was not counted on subscription %d: %v", issued.ID, issued.SubscriptionID, err)
+		}
+	}
+
+	if err := uc.ingredients.ConsumeIngredients(*issued); err != nil {
+		log.Printf("order %d issued, but its ingredients were not deducted: %v", issued.ID, err)
+	}
+
+	return issued, nil
+}
+
+// coveringSubscription finds a subscription of the student that covers the
+// order's meal. A subscription covers at most one order per day and never
+// more orders than it has meals left.
+func (uc *orderUseCase) coveringSubscription(order *domOrder.Order) (*domSubscription.Subscription, error) {
+	subs, err := uc.subscriptions.ListSubscriptionsByStudent(order.StudentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []domOrder.Order
+	for _, sub := range subs {
+		if !sub.Covers(order.Date, order.MealType) {
+			continue
+		}
+
+		if orders == nil {
+			if orders, err = uc.orders.ListOrdersByStudent(order.StudentID); err != nil {
+				return nil, err
+			}
+		}
+
+		outstanding, usedToday := 0, false
+		for _, o := range orders {
+			if o.SubscriptionID != sub.ID || o.Status == domOrder.Cancelled {
+				continue
+			}
+			if o.Date.Equal(order.Date) {
+				usedToday = true
+			}
+			if o.Status == domOrder.Paid || o.Status == domOrder.Prepared {
+				outstanding++
+			}
+		}
+
+		if !usedToday && sub.RemainingMeals > outstanding {
+			return &sub, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (uc *orderUseCase) refund(order *domOrder.Order, comment string) error {
+	_, err := uc.wallet.Append(domWallet.Entry{
+		StudentID: order.StudentID,
+		Kind:      domWallet.Refund,
+		Amount:    order.Total,
+		OrderID:   order.ID,
+		AuthorID:  order.StudentID,
+		Comment:   comment,
+		CreatedAt: time.Now(),
+	})
+	return err
+}
+
+func (uc *orderUseCase) transition(order *domOrder.Order, next domOrder.Status) (*domOrder.Order, error) {
+	if !order.Status.CanTransitionTo(next) {
+		return nil, ErrOrderStatus
+	}
+
+	if err := uc.orders.UpdateStatus(order.ID, order.Status, next); err != nil {
+		return nil, err
+	}
+
+	return uc.orders.GetOrderByID(order.ID)
+}
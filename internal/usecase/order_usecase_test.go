@@ -0,0 +1,136 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errWalletDown = errors.New("wallet unavailable")
+
+// flakyWallet fails to write refunds while down is set.
+type flakyWallet struct {
+	*ram_storage.WalletRepo
+	down bool
+}
+
+func (w *flakyWallet) Append(entry domWallet.Entry) (domWallet.EntryID, error) {
+	if w.down && entry.Kind == domWallet.Refund {
+		return 0, errWalletDown
+	}
+	return w.WalletRepo.Append(entry)
+}
+
+var errOrdersDown = errors.New("orders unavailable")
+
+// flakyOrders fails to change order statuses while down is set.
+type flakyOrders struct {
+	*ram_storage.OrderRepo
+	down bool
+}
+
+func (o *flakyOrders) UpdateStatus(id domOrder.OrderID, from, to domOrder.Status) error {
+	if o.down {
+		return errOrdersDown
+	}
+	return o.OrderRepo.UpdateStatus(id, from, to)
+}
+
+func TestOrderUseCase_PayOrder_RetryAfterRollback(t *testing.T) {
+	const studentID = domUser.UserID(7)
+
+	orders := &flakyOrders{OrderRepo: ram_storage.NewOrderRepo(), down: true}
+	wallet := ram_storage.NewWalletRepo()
+	orderUC := usecase.NewOrderUseCase(orders, ram_storage.NewMenuRepo(), wallet, ram_storage.NewSubscriptionRepo(), ram_storage.NewDietaryProfileRepo(), nil)
+
+	_, err := wallet.Append(domWallet.Entry{StudentID: studentID, Kind: domWallet.TopUp, Amount: 25000, CreatedAt: time.Now()})
+	require.NoError(t, err)
+	orderID, err := orders.CreateOrder(domOrder.Order{
+		StudentID: studentID,
+		Items:     []domOrder.Item{{DishID: domMenu.DishID(2), Quantity: 1}},
+		Total:     25000,
+		Status:    domOrder.Placed,
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	_, err = orderUC.PayOrder(studentID, orderID)
+	require.ErrorIs(t, err, errOrdersDown)
+
+	orders.down = false
+	paid, err := orderUC.PayOrder(studentID, orderID)
+	require.NoError(t, err, "a payment rolled back by a refund can be retried")
+	assert.Equal(t, domOrder.Paid, paid.Status)
+
+	balance, err := wallet.Balance(studentID)
+	require.NoError(t, err)
+	assert.Zero(t, balance, "charged exactly once in the end")
+}
+
+func TestOrderUseCase_CancelOrder_RefundFails(t *testing.T) {
+	const studentID = domUser.UserID(7)
+
+	orderRepo := ram_storage.NewOrderRepo()
+	wallet := &flakyWallet{WalletRepo: ram_storage.NewWalletRepo(), down: true}
+	orderUC := usecase.NewOrderUseCase(orderRepo, ram_storage.NewMenuRepo(), wallet, ram_storage.NewSubscriptionRepo(), ram_storage.NewDietaryProfileRepo(), nil)
+
+	orderID, err := orderRepo.CreateOrder(domOrder.Order{
+		StudentID: studentID,
+		Items:     []domOrder.Item{{DishID: domMenu.DishID(2), Quantity: 1}},
+		Total:     25000,
+		Status:    domOrder.Paid,
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	_, err = orderUC.CancelOrder(studentID, orderID)
+	require.ErrorIs(t, err, errWalletDown)
+
+	order, err := orderRepo.GetOrderByID(orderID)
+	require.NoError(t, err)
+	assert.Equal(t, domOrder.Paid, order.Status, "the order stays paid while it is not refunded")
+
+	wallet.down = false
+	cancelled, err := orderUC.CancelOrder(studentID, orderID)
+	require.NoError(t, err, "cancelling can be retried")
+	assert.Equal(t, domOrder.Cancelled, cancelled.Status)
+
+	balance, err := wallet.Balance(studentID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(25000), balance, "refunded exactly once")
+}
+
+// brokenKitchen fails to deduct any ingredients.
+type brokenKitchen struct{}
+
+func (brokenKitchen) ConsumeIngredients(domOrder.Order) error {
+	return errors.New("inventory unavailable")
+}
+
+func TestOrderUseCase_MarkIssued_BookkeepingFails(t *testing.T) {
+	orderRepo := ram_storage.NewOrderRepo()
+	orderUC := usecase.NewOrderUseCase(orderRepo, ram_storage.NewMenuRepo(), ram_storage.NewWalletRepo(), ram_storage.NewSubscriptionRepo(), ram_storage.NewDietaryProfileRepo(), brokenKitchen{})
+
+	orderID, err := orderRepo.CreateOrder(domOrder.Order{
+		StudentID: 7,
+		Items:     []domOrder.Item{{DishID: domMenu.DishID(2), Quantity: 1}},
+		Total:     25000,
+		Status:    domOrder.Prepared,
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	issued, err := orderUC.MarkIssued(orderID)
+	require.NoError(t, err, "the order is handed out whatever the inventory says")
+	assert.Equal(t, domOrder.Issued, issued.Status)
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	domMail "canteen-app/internal/domain/mail"
+	domUser "canteen-app/internal/domain/user"
+)
+
+type passwordResetUseCase struct {
+	users          UserRepository
+	passwordTokens PasswordTokenRepository
+	mailer         Mailer
+	setPasswordURL string
+	ttl            time.Duration
+}
+
+// NewPasswordResetUseCase lets users who forgot their password set a new
+// one. They are mailed a link, setPasswordURL followed by a one-time token
+// valid for ttl, to the set-password page, see accountUseCase.SetPassword.
+func NewPasswordResetUseCase(users UserRepository, passwordTokens PasswordTokenRepository, mailer Mailer, setPasswordURL string, ttl time.Duration) *passwordResetUseCase {
+	return &passwordResetUseCase{
+		users:          users,
+		passwordTokens: passwordTokens,
+		mailer:         mailer,
+		setPasswordURL: setPasswordURL,
+		ttl:            ttl,
+	}
+}
+
+// RequestReset mails a reset link to the user with the email, if the input
+// holds an @ and matches one, or else with the login. The current password keeps working until a new one is set.
+// Unknown and blocked users and users without an email get nothing, with no
+// error either, so that the answer tells nothing about which accounts exist.
+func (uc *passwordResetUseCase) RequestReset(loginOrEmail string) error {
+	var (
+		user *domUser.User
+		err  = ErrUserNotFound
+	)
+	if strings.Contains(loginOrEmail, "@") {
+		user, err = uc.users.GetUserByEmail(normalizeEmail(loginOrEmail))
+	}
+	// Logins may hold an @ too, so they are tried when no email matches.
+	if errors.Is(err, ErrUserNotFound) {
+		user, err = uc.users.GetUserByLogin(loginOrEmail)
+	}
+	if errors.Is(err, ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if user.Email == "" || user.Blocked {
+		return nil
+	}
+
+	setup, err := issuePasswordToken(uc.passwordTokens, user.ID, uc.ttl)
+	if err != nil {
+		return err
+	}
+
+	link := uc.setPasswordURL + url.QueryEscape(setup.Token)
+	return uc.mailer.Send(domMail.Message{
+		To:      user.Email,
+		Subject: "Сброс пароля",
+		Body: fmt.Sprintf("Здравствуйте, %s!\n\n"+
+			"Для учетной записи %s запрошен сброс пароля. Задать новый пароль можно по ссылке:\n\n"+
+			"%s\n\n"+
+			"Ссылка одноразовая и действует до %s. Если вы не запрашивали сброс, просто проигнорируйте это письмо: пароль останется прежним.\n",
+			user.Name, user.Login, link, setup.ExpiresAt.Format("02.01.2006 15:04")),
+	})
+}
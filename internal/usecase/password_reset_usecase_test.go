@@ -0,0 +1,141 @@
+package usecase_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domAuth "canteen-app/internal/domain/auth"
+	domMail "canteen-app/internal/domain/mail"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSetPasswordURL = "https://canteen.example.com/set-password?token="
+
+// mailbox keeps the messages sent instead of delivering them.
+type mailbox struct {
+	sent []domMail.Message
+}
+
+func (m *mailbox) Send(msg domMail.Message) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// resetToken extracts the token from the link in a reset message.
+func resetToken(t *testing.T, msg domMail.Message) string {
+	t.Helper()
+
+	start := strings.Index(msg.Body, testSetPasswordURL)
+	require.NotEqual(t, -1, start, "no link in %q", msg.Body)
+	link := strings.Fields(msg.Body[start:])[0]
+
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	return parsed.Query().Get("token")
+}
+
+func TestPasswordResetUseCase_RequestReset(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	refresh := ram_storage.NewRefreshRepo()
+	passwordTokens := ram_storage.NewPasswordTokenRepo()
+	mails := &mailbox{}
+	authUC := usecase.NewAuthUseCase(users, newTokenService(), refresh, ram_storage.NewSecurityEventRepo(), newInvitations(t), plainHasher{}, newLoginGuard())
+	accountUC := usecase.NewAccountUseCase(users, refresh, ram_storage.NewInvitationRepo(), passwordTokens, plainHasher{}, newLoginGuard(), time.Hour)
+	resetUC := usecase.NewPasswordResetUseCase(users, passwordTokens, mails, testSetPasswordURL, time.Hour)
+
+	tokens, err := authUC.Register("slim", "password", "Slim", "Shady", "slim@example.com", testInviteCode, domAuth.Client{})
+	require.NoError(t, err)
+
+	require.NoError(t, resetUC.RequestReset("Slim@Example.com"))
+	require.Len(t, mails.sent, 1)
+	assert.Equal(t, "slim@example.com", mails.sent[0].To)
+
+	_, err = authUC.Login("slim", "password", domAuth.Client{})
+	require.NoError(t, err, "the old password works until a new one is set")
+
+	token := resetToken(t, mails.sent[0])
+	require.NoError(t, accountUC.SetPassword(token, "new password"))
+	assert.ErrorIs(t, accountUC.SetPassword(token, "another"), usecase.ErrPasswordTokenInvalid, "the link works once")
+
+	_, err = authUC.Refresh(tokens.RefreshToken, domAuth.Client{})
+	assert.Error(t, err, "sessions started with the old password are revoked")
+
+	_, err = authUC.Login("slim", "password", domAuth.Client{})
+	assert.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+	_, err = authUC.Login("slim", "new password", domAuth.Client{})
+	require.NoError(t, err)
+
+	require.NoError(t, resetUC.RequestReset("slim"))
+	assert.Len(t, mails.sent, 2, "a reset can be requested by login too")
+
+	_, err = users.CreateUser(domUser.User{Login: "slim@school", Role: domUser.RoleStudent, Email: "shady@example.com"})
+	require.NoError(t, err)
+	require.NoError(t, resetUC.RequestReset("slim@school"))
+	require.Len(t, mails.sent, 3, "a login with an @ is found when no email matches")
+	assert.Equal(t, "shady@example.com", mails.sent[2].To)
+}
+
+func TestPasswordResetUseCase_LiftsLockout(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	refresh := ram_storage.NewRefreshRepo()
+	passwordTokens := ram_storage.NewPasswordTokenRepo()
+	mails := &mailbox{}
+	policy := domAuth.LockoutPolicy{MaxFailures: 1, LockoutDuration: time.Hour, Window: time.Hour}
+	guard := usecase.NewLoginGuard(ram_storage.NewLoginAttemptRepo(), policy, domAuth.LockoutPolicy{Window: time.Hour})
+	authUC := usecase.NewAuthUseCase(users, newTokenService(), refresh, ram_storage.NewSecurityEventRepo(), ram_storage.NewInvitationRepo(), plainHasher{}, guard)
+	accountUC := usecase.NewAccountUseCase(users, refresh, ram_storage.NewInvitationRepo(), passwordTokens, plainHasher{}, guard, time.Hour)
+	resetUC := usecase.NewPasswordResetUseCase(users, passwordTokens, mails, testSetPasswordURL, time.Hour)
+
+	_, err := users.CreateUser(domUser.User{Login: "slim", PasswordHash: "password", Role: domUser.RoleStudent, Email: "slim@example.com"})
+	require.NoError(t, err)
+
+	_, err = authUC.Login("slim", "forgotten", domAuth.Client{})
+	require.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+	_, err = authUC.Login("slim", "forgotten", domAuth.Client{})
+	require.ErrorIs(t, err, usecase.ErrAccountLocked)
+
+	require.NoError(t, resetUC.RequestReset("slim"))
+	require.Len(t, mails.sent, 1)
+	require.NoError(t, accountUC.SetPassword(resetToken(t, mails.sent[0]), "new password"))
+
+	_, err = authUC.Login("slim", "new password", domAuth.Client{})
+	assert.NoError(t, err, "the new password works right away")
+}
+
+func TestPasswordResetUseCase_NothingToSend(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	mails := &mailbox{}
+	resetUC := usecase.NewPasswordResetUseCase(users, ram_storage.NewPasswordTokenRepo(), mails, testSetPasswordURL, time.Hour)
+
+	_, err := users.CreateUser(domUser.User{Login: "noemail", Role: domUser.RoleStudent})
+	require.NoError(t, err)
+	_, err = users.CreateUser(domUser.User{Login: "blocked", Role: domUser.RoleStudent, Email: "blocked@example.com", Blocked: true})
+	require.NoError(t, err)
+
+	for _, loginOrEmail := range []string{"unknown", "unknown@example.com", "noemail", "blocked", "blocked@example.com"} {
+		assert.NoError(t, resetUC.RequestReset(loginOrEmail), loginOrEmail)
+	}
+	assert.Empty(t, mails.sent)
+}
+
+func TestPasswordResetUseCase_Expired(t *testing.T) {
+	users := ram_storage.NewUserRepo()
+	passwordTokens := ram_storage.NewPasswordTokenRepo()
+	mails := &mailbox{}
+	accountUC := usecase.NewAccountUseCase(users, ram_storage.NewRefreshRepo(), ram_storage.NewInvitationRepo(), passwordTokens, plainHasher{}, newLoginGuard(), time.Hour)
+	resetUC := usecase.NewPasswordResetUseCase(users, passwordTokens, mails, testSetPasswordURL, -time.Minute)
+
+	_, err := users.CreateUser(domUser.User{Login: "slim", Role: domUser.RoleStudent, Email: "slim@example.com"})
+	require.NoError(t, err)
+
+	require.NoError(t, resetUC.RequestReset("slim"))
+	require.Len(t, mails.sent, 1)
+	assert.ErrorIs(t, accountUC.SetPassword(resetToken(t, mails.sent[0]), "password"), usecase.ErrPasswordTokenInvalid)
+}
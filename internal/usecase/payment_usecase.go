@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	domPayment "canteen-app/internal/domain/payment"
+	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
+
+	"github.com/google/uuid"
+)
+
+type paymentUseCase struct {
+	payments PaymentRepository
+	wallet   WalletRepository
+	gateway  PaymentGateway
+}
+
+func NewPaymentUseCase(payments PaymentRepository, wallet WalletRepository, gateway PaymentGateway) *paymentUseCase {
+	return &paymentUseCase{payments: payments, wallet: wallet, gateway: gateway}
+}
+
+func (uc *paymentUseCase) CreateTopUp(studentID domUser.UserID, amount int64) (*domPayment.Payment, string, error) {
+	if amount <= 0 {
+		return nil, "", ErrInvalidAmount
+	}
+
+	now := time.Now()
+	payment := domPayment.Payment{
+		ID:        domPayment.PaymentID(uuid.NewString()),
+		StudentID: studentID,
+		Amount:    amount,
+		Status:    domPayment.Pending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := uc.payments.CreatePayment(payment); err != nil {
+		return nil, "", err
+	}
+
+	redirectURL, err := uc.gateway.CreatePayment(payment.ID, payment.Amount, fmt.Sprintf("balance top-up for student %d", studentID))
+	if err != nil {
+		if updErr := uc.payments.UpdateStatus(payment.ID, domPayment.Pending, domPayment.Declined); updErr != nil {
+			return nil, "", errors.Join(err, updErr)
+		}
+		return nil, "", err
+	}
+
+	return &payment, redirectURL, nil
+}
+
+func (uc *paymentUseCase) GetPayment(studentID domUser.UserID, id domPayment.PaymentID) (*domPayment.Payment, error) {
+	payment, err := uc.payments.GetPaymentByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.StudentID != studentID {
+		return nil, ErrPaymentNotFound
+	}
+
+	return payment, nil
+}
+
+// HandleWebhook applies a gateway notification. It is safe to call repeatedly
+// with the same payload: the payment status only moves out of pending once,
+// and the wallet refuses to credit the same payment twice, so a replayed
+// success callback only finishes a credit that was interrupted earlier.
+func (uc *paymentUseCase) HandleWebhook(body []byte, signature string) error {
+	event, err := uc.gateway.ParseWebhook(body, signature)
+	if err != nil {
+		return err
+	}
+
+	if !event.Status.IsFinal() {
+		return ErrInvalidWebhook
+	}
+
+	payment, err := uc.payments.GetPaymentByID(event.PaymentID)
+	if err != nil {
+		return err
+	}
+
+	if event.Amount != payment.Amount {
+		return ErrInvalidWebhook
+	}
+
+	err = uc.payments.UpdateStatus(payment.ID, domPayment.Pending, event.Status)
+	if err != nil && !errors.Is(err, ErrPaymentStatus) {
+		return err
+	}
+
+	if event.Status != domPayment.Succeeded {
+		return nil
+	}
+
+	payment, err = uc.payments.GetPaymentByID(payment.ID)
+	if err != nil {
+		return err
+	}
+
+	if payment.Status != domPayment.Succeeded {
+		return nil
+	}
+
+	_, err = uc.wallet.Append(domWallet.Entry{
+		StudentID: payment.StudentID,
+		Kind:      domWallet.TopUp,
+		Amount:    payment.Amount,
+		PaymentID: payment.ID,
+		AuthorID:  payment.StudentID,
+		Comment:   "card top-up",
+		CreatedAt: time.Now(),
+	})
+	if err != nil && !errors.Is(err, ErrAlreadyCredited) {
+		return err
+	}
+
+	return nil
+}
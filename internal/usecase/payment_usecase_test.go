@@ -0,0 +1,105 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"canteen-app/internal/adapter/payment/fake"
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domPayment "canteen-app/internal/domain/payment"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentUseCase_HandleWebhook(t *testing.T) {
+	const studentID = domUser.UserID(7)
+
+	tests := []struct {
+		name        string
+		outcome     fake.Outcome
+		deliveries  int
+		tamper      bool
+		wantErr     error
+		wantStatus  domPayment.Status
+		wantBalance int64
+	}{
+		{
+			name:        "success credits balance",
+			outcome:     fake.Success,
+			deliveries:  1,
+			wantStatus:  domPayment.Succeeded,
+			wantBalance: 50000,
+		},
+
+		{
+			name:        "replayed success credits once",
+			outcome:     fake.Success,
+			deliveries:  3,
+			wantStatus:  domPayment.Succeeded,
+			wantBalance: 50000,
+		},
+
+		{
+			name:        "decline does not credit",
+			outcome:     fake.Decline,
+			deliveries:  2,
+			wantStatus:  domPayment.Declined,
+			wantBalance: 0,
+		},
+
+		{
+			name:        "timeout expires payment",
+			outcome:     fake.Timeout,
+			deliveries:  1,
+			wantStatus:  domPayment.Expired,
+			wantBalance: 0,
+		},
+
+		{
+			name:        "invalid signature is rejected",
+			outcome:     fake.Success,
+			deliveries:  1,
+			tamper:      true,
+			wantErr:     usecase.ErrInvalidSignature,
+			wantStatus:  domPayment.Pending,
+			wantBalance: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			walletRepo := ram_storage.NewWalletRepo()
+			gateway := fake.NewGateway([]byte("secret"), "/checkout", "")
+			uc := usecase.NewPaymentUseCase(ram_storage.NewPaymentRepo(), walletRepo, gateway)
+
+			payment, redirectURL, err := uc.CreateTopUp(studentID, 50000)
+			require.NoError(t, err)
+			assert.Equal(t, "/checkout/"+string(payment.ID), redirectURL)
+
+			body, signature, err := gateway.Simulate(payment.ID, tc.outcome)
+			require.NoError(t, err)
+			if tc.tamper {
+				body = []byte(`{"payment_id":"` + string(payment.ID) + `","status":"succeeded","amount":5000000}`)
+			}
+
+			for i := 0; i < tc.deliveries; i++ {
+				err := uc.HandleWebhook(body, signature)
+				if tc.wantErr != nil {
+					assert.ErrorIs(t, err, tc.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+
+			got, err := uc.GetPayment(studentID, payment.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, got.Status)
+
+			balance, err := walletRepo.Balance(studentID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantBalance, balance)
+		})
+	}
+}
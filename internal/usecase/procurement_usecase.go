@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	domInventory "canteen-app/internal/domain/inventory"
+	domProcurement "canteen-app/internal/domain/procurement"
+	domUser "canteen-app/internal/domain/user"
+)
+
+type procurementUseCase struct {
+	requests  ProcurementRepository
+	inventory InventoryRepository
+}
+
+func NewProcurementUseCase(requests ProcurementRepository, inventory InventoryRepository) *procurementUseCase {
+	return &procurementUseCase{requests: requests, inventory: inventory}
+}
+
+// CreateRequest drafts a purchase request. The author can change its items
+// until the request is submitted for approval.
+func (uc *procurementUseCase) CreateRequest(authorID domUser.UserID, items []domProcurement.Item, comment string) (*domProcurement.Request, error) {
+	items, total, err := uc.resolveItems(items)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	request := domProcurement.Request{
+		AuthorID:      authorID,
+		Items:         items,
+		EstimatedCost: total,
+		Status:        domProcurement.Draft,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if text := strings.TrimSpace(comment); text != "" {
+		request.Comments = []domProcurement.Comment{{AuthorID: authorID, Text: text, CreatedAt: now}}
+	}
+
+	id, err := uc.requests.CreateRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.ID = id
+	return &request, nil
+}
+
+func (uc *procurementUseCase) UpdateItems(userID domUser.UserID, id domProcurement.RequestID, items []domProcurement.Item) (*domProcurement.Request, error) {
+	request, err := uc.requests.GetRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if request.AuthorID != userID {
+		return nil, ErrNotRequestAuthor
+	}
+
+	items, total, err := uc.resolveItems(items)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.requests.UpdateItems(id, items, total); err != nil {
+		return nil, err
+	}
+
+	return uc.requests.GetRequestByID(id)
+}
+
+func (uc *procurementUseCase) GetRequest(id domProcurement.RequestID) (*domProcurement.Request, error) {
+	return uc.requests.GetRequestByID(id)
+}
+
+func (uc *procurementUseCase) ListRequests(statuses ...domProcurement.Status) ([]domProcurement.Request, error) {
+	return uc.requests.ListRequests(statuses...)
+}
+
+func (uc *procurementUseCase) Submit(userID domUser.UserID, id domProcurement.RequestID) (*domProcurement.Request, error) {
+	request, err := uc.requests.GetRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if request.AuthorID != userID {
+		return nil, ErrNotRequestAuthor
+	}
+
+	return uc.transition(request, domProcurement.Submitted, 0, userID, "")
+}
+
+func (uc *procurementUseCase) Approve(adminID domUser.UserID, id domProcurement.RequestID, comment string) (*domProcurement.Request, error) {
+	request, err := uc.requests.GetRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.transition(request, domProcurement.Approved, adminID, adminID, comment)
+}
+
+func (uc *procurementUseCase) Reject(adminID domUser.UserID, id domProcurement.RequestID, comment string) (*domProcurement.Request, error) {
+	request, err := uc.requests.GetRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.transition(request, domProcurement.Rejected, adminID, adminID, comment)
+}
+
+// Fulfill marks an approved request as delivered and posts a receipt into the
+// inventory for every item. The status is switched first, so a request can
+// never be received twice.
+func (uc *procurementUseCase) Fulfill(userID domUser.UserID, id domProcurement.RequestID, comment string) (*domProcurement.Request, error) {
+	request, err := uc.requests.GetRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	fulfilled, err := uc.transition(request, domProcurement.Fulfilled, 0, userID, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range request.Items {
+		_, err := uc.inventory.ApplyMovement(domInventory.Movement{
+			ProductID: item.ProductID,
+			Kind:      domInventory.Receipt,
+			Quantity:  item.Quantity,
+			AuthorID:  userID,
+			Comment:   fmt.Sprintf("purchase request #%d", request.ID),
+			CreatedAt: time.Now(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("receive %q for purchase request %d: %w", item.Name, request.ID, err)
+		}
+	}
+
+	return fulfilled, nil
+}
+
+func (uc *procurementUseCase) AddComment(userID domUser.UserID, id domProcurement.RequestID, text string) (*domProcurement.Request, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return uc.requests.GetRequestByID(id)
+	}
+
+	err := uc.requests.AddComment(id, domProcurement.Comment{AuthorID: userID, Text: text, CreatedAt: time.Now()})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.requests.GetRequestByID(id)
+}
+
+// transition moves the request to the next status and attaches the optional
+// comment of the user who did it.
+func (uc *procurementUseCase) transition(
+	request *domProcurement.Request,
+	next domProcurement.Status,
+	approverID domUser.UserID,
+	userID domUser.UserID,
+	comment string,
+) (*domProcurement.Request, error) {
+	if !request.Status.CanTransitionTo(next) {
+		return nil, ErrPurchaseStatus
+	}
+
+	if err := uc.requests.UpdateStatus(request.ID, request.Status, next, approverID); err != nil {
+		return nil, err
+	}
+
+	return uc.AddComment(userID, request.ID, comment)
+}
+
+// resolveItems merges items of the same product, fills in product names and
+// units and returns the estimated cost of the whole request.
+func (uc *procurementUseCase) resolveItems(items []domProcurement.Item) ([]domProcurement.Item, int64, error) {
+	resolved := make([]domProcurement.Item, 0, len(items))
+	index := make(map[domInventory.ProductID]int, len(items))
+	var total int64
+
+	for _, item := range items {
+		if item.Quantity <= 0 || item.EstimatedCost < 0 {
+			return nil, 0, ErrInvalidQuantity
+		}
+
+		if i, ok := index[item.ProductID]; ok {
+			resolved[i].Quantity += item.Quantity
+			resolved[i].EstimatedCost += item.EstimatedCost
+			total += item.EstimatedCost
+			continue
+		}
+
+		product, err := uc.inventory.GetProductByID(item.ProductID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		item.Name = product.Name
+		item.Unit = product.Unit
+		index[item.ProductID] = len(resolved)
+		resolved = append(resolved, item)
+		total += item.EstimatedCost
+	}
+
+	if len(resolved) == 0 {
+		return nil, 0, ErrEmptyPurchaseRequest
+	}
+	return resolved, total, nil
+}
@@ -0,0 +1,110 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domInventory "canteen-app/internal/domain/inventory"
+	domProcurement "canteen-app/internal/domain/procurement"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcurement_FulfillPostsReceipts(t *testing.T) {
+	const (
+		employeeID = domUser.UserID(2)
+		adminID    = domUser.UserID(1)
+	)
+
+	inventoryRepo := ram_storage.NewInventoryRepo()
+	inventoryUC := usecase.NewInventoryUseCase(inventoryRepo, ram_storage.NewMenuRepo())
+	procurementUC := usecase.NewProcurementUseCase(ram_storage.NewProcurementRepo(), inventoryRepo)
+
+	flour, err := inventoryUC.CreateProduct(domInventory.Product{Name: "Мука", Unit: domInventory.Kilogram, MinQuantity: 5})
+	require.NoError(t, err)
+	milk, err := inventoryUC.CreateProduct(domInventory.Product{Name: "Молоко", Unit: domInventory.Liter, MinQuantity: 10})
+	require.NoError(t, err)
+
+	_, err = procurementUC.CreateRequest(employeeID, nil, "")
+	assert.ErrorIs(t, err, usecase.ErrEmptyPurchaseRequest)
+
+	request, err := procurementUC.CreateRequest(employeeID, []domProcurement.Item{
+		{ProductID: flour.ID, Quantity: 20, EstimatedCost: 100000},
+		{ProductID: milk.ID, Quantity: 30, EstimatedCost: 270000},
+		{ProductID: flour.ID, Quantity: 5, EstimatedCost: 25000},
+	}, "на неделю")
+	require.NoError(t, err)
+	require.Len(t, request.Items, 2)
+	assert.Equal(t, 25.0, request.Items[0].Quantity)
+	assert.Equal(t, "Мука", request.Items[0].Name)
+	assert.Equal(t, int64(395000), request.EstimatedCost)
+	assert.Equal(t, domProcurement.Draft, request.Status)
+
+	// Only approved requests can be received.
+	_, err = procurementUC.Fulfill(employeeID, request.ID, "")
+	assert.ErrorIs(t, err, usecase.ErrPurchaseStatus)
+
+	_, err = procurementUC.Submit(adminID, request.ID)
+	assert.ErrorIs(t, err, usecase.ErrNotRequestAuthor)
+
+	_, err = procurementUC.Submit(employeeID, request.ID)
+	require.NoError(t, err)
+
+	_, err = procurementUC.UpdateItems(employeeID, request.ID, []domProcurement.Item{{ProductID: milk.ID, Quantity: 1}})
+	assert.ErrorIs(t, err, usecase.ErrPurchaseStatus)
+
+	approved, err := procurementUC.Approve(adminID, request.ID, "согласовано")
+	require.NoError(t, err)
+	assert.Equal(t, adminID, approved.ApproverID)
+	assert.Len(t, approved.Comments, 2)
+
+	fulfilled, err := procurementUC.Fulfill(employeeID, request.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, domProcurement.Fulfilled, fulfilled.Status)
+
+	_, err = procurementUC.Fulfill(employeeID, request.ID, "")
+	assert.ErrorIs(t, err, usecase.ErrPurchaseStatus)
+
+	flour, err = inventoryUC.GetProduct(flour.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 25.0, flour.Quantity)
+
+	milk, err = inventoryUC.GetProduct(milk.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 30.0, milk.Quantity)
+
+	movements, err := inventoryUC.ListMovements(milk.ID)
+	require.NoError(t, err)
+	require.Len(t, movements, 1)
+	assert.Equal(t, domInventory.Receipt, movements[0].Kind)
+	assert.Equal(t, employeeID, movements[0].AuthorID)
+}
+
+func TestProcurement_Reject(t *testing.T) {
+	inventoryRepo := ram_storage.NewInventoryRepo()
+	procurementUC := usecase.NewProcurementUseCase(ram_storage.NewProcurementRepo(), inventoryRepo)
+
+	productID, err := inventoryRepo.CreateProduct(domInventory.Product{Name: "Сахар", Unit: domInventory.Kilogram})
+	require.NoError(t, err)
+
+	request, err := procurementUC.CreateRequest(2, []domProcurement.Item{{ProductID: productID, Quantity: 10}}, "")
+	require.NoError(t, err)
+
+	_, err = procurementUC.Approve(1, request.ID, "")
+	assert.ErrorIs(t, err, usecase.ErrPurchaseStatus)
+
+	_, err = procurementUC.Submit(2, request.ID)
+	require.NoError(t, err)
+
+	rejected, err := procurementUC.Reject(1, request.ID, "сахара хватает")
+	require.NoError(t, err)
+	assert.Equal(t, domProcurement.Rejected, rejected.Status)
+	require.Len(t, rejected.Comments, 1)
+	assert.Equal(t, "сахара хватает", rejected.Comments[0].Text)
+
+	_, err = procurementUC.Fulfill(2, request.ID, "")
+	assert.ErrorIs(t, err, usecase.ErrPurchaseStatus)
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	domUser "canteen-app/internal/domain/user"
+)
+
+type profileUseCase struct {
+	profiles DietaryProfileRepository
+}
+
+func NewProfileUseCase(profiles DietaryProfileRepository) *profileUseCase {
+	return &profileUseCase{profiles: profiles}
+}
+
+func (uc *profileUseCase) GetProfile(userID domUser.UserID) (*domUser.DietaryProfile, error) {
+	return uc.profiles.GetProfile(userID)
+}
+
+func (uc *profileUseCase) UpdateProfile(profile domUser.DietaryProfile) (*domUser.DietaryProfile, error) {
+	allergens, err := normalizeAllergens(profile.Allergens)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.Allergens = allergens
+	profile.Restrictions = strings.TrimSpace(profile.Restrictions)
+	profile.UpdatedAt = time.Now()
+
+	if err := uc.profiles.SaveProfile(profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	domInventory "canteen-app/internal/domain/inventory"
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domRecipe "canteen-app/internal/domain/recipe"
+)
+
+// recipeUseCase links dishes to inventory products. It checks menu plans
+// against the stock and deducts ingredients when orders are issued.
+type recipeUseCase struct {
+	recipes   RecipeRepository
+	menus     MenuRepository
+	inventory InventoryRepository
+}
+
+func NewRecipeUseCase(recipes RecipeRepository, menus MenuRepository, inventory InventoryRepository) *recipeUseCase {
+	return &recipeUseCase{recipes: recipes, menus: menus, inventory: inventory}
+}
+
+func (uc *recipeUseCase) GetRecipe(dishID domMenu.DishID) (*domRecipe.Recipe, error) {
+	if _, err := uc.menus.GetDishByID(dishID); err != nil {
+		return nil, err
+	}
+
+	return uc.recipes.GetRecipe(dishID)
+}
+
+// SetRecipe replaces the ingredients of a dish. Ingredients of the same
+// product are merged; an empty list removes the recipe.
+func (uc *recipeUseCase) SetRecipe(recipe domRecipe.Recipe) (*domRecipe.Recipe, error) {
+	if _, err := uc.menus.GetDishByID(recipe.DishID); err != nil {
+		return nil, err
+	}
+
+	ingredients := make([]domRecipe.Ingredient, 0, len(recipe.Ingredients))
+	index := make(map[domInventory.ProductID]int, len(recipe.Ingredients))
+	for _, ingredient := range recipe.Ingredients {
+		if ingredient.Quantity <= 0 || math.IsInf(ingredient.Quantity, 0) {
+			return nil, ErrInvalidRecipe
+		}
+
+		if i, ok := index[ingredient.ProductID]; ok {
+			ingredients[i].Quantity += ingredient.Quantity
+			continue
+		}
+
+		if _, err := uc.inventory.GetProductByID(ingredient.ProductID); err != nil {
+			return nil, err
+		}
+
+		index[ingredient.ProductID] = len(ingredients)
+		ingredients = append(ingredients, ingredient)
+	}
+
+	recipe.Ingredients = ingredients
+	recipe.UpdatedAt = time.Now()
+	if err := uc.recipes.SaveRecipe(recipe); err != nil {
+		return nil, err
+	}
+
+	return &recipe, nil
+}
+
+// PlanPortions sets the number of portions of each dish the kitchen plans to
+// cook for the menu and returns the stock check of the new plan. Dishes left
+// out of portions are not planned.
+func (uc *recipeUseCase) PlanPortions(menuID domMenu.MenuID, portions map[domMenu.DishID]int) (*domRecipe.Coverage, error) {
+	menu, err := uc.menus.GetMenuByID(menuID)
+	if err != nil {
+		return nil, err
+	}
+
+	planned := make(map[domMenu.DishID]int, len(portions))
+	for dishID, n := range portions {
+		if n < 0 {
+			return nil, ErrInvalidPortions
+		}
+		if !menuHasDish(menu, dishID) {
+			return nil, ErrDishNotInMenu
+		}
+		if n > 0 {
+			planned[dishID] = n
+		}
+	}
+
+	menu.Portions = planned
+	if err := uc.menus.UpdateMenu(*menu); err != nil {
+		return nil, err
+	}
+
+	return uc.coverage(menu)
+}
+
+// Coverage checks whether the stock is enough to cook the planned portions
+// of the menu.
+func (uc *recipeUseCase) Coverage(menuID domMenu.MenuID) (*domRecipe.Coverage, error) {
+	menu, err := uc.menus.GetMenuByID(menuID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.coverage(menu)
+}
+
+// ConsumeIngredients posts a consumption movement for every ingredient of the
+// issued order. The food has already been handed out at this point, so a
+// shortage takes the stock down to zero and is logged rather than failing
+// the order.
+func (uc *recipeUseCase) ConsumeIngredients(order domOrder.Order) error {
+	for _, item := range order.Items {
+		recipe, err := uc.recipes.GetRecipe(item.DishID)
+		if err != nil {
+			return err
+		}
+
+		for _, ingredient := range recipe.Ingredients {
+			movement := domInventory.Movement{
+				ProductID: ingredient.ProductID,
+				Kind:      domInventory.Consumption,
+				Quantity:  -ingredient.Quantity * float64(item.Quantity),
+				DishID:    item.DishID,
+				Comment:   fmt.Sprintf("order #%d", order.ID),
+				CreatedAt: time.Now(),
+			}
+			_, err := uc.inventory.ApplyMovement(movement)
+			if errors.Is(err, ErrInsufficientStock) {
+				err = uc.consumeRemaining(order.ID, movement)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// consumeRemaining posts what is left of the product when the stock is
+// short of the movement. It retries if the stock drops in the meantime.
+func (uc *recipeUseCase) consumeRemaining(orderID domOrder.OrderID, movement domInventory.Movement) error {
+	needed := -movement.Quantity
+	for {
+		product, err := uc.inventory.GetProductByID(movement.ProductID)
+		if err != nil {
+			return err
+		}
+		if product.Quantity <= 0 {
+			log.Printf("order %d: product %d is out of stock, %g %s short for dish %d", orderID, product.ID, needed, product.Unit, movement.DishID)
+			return nil
+		}
+
+		short := needed - product.Quantity
+		movement.Quantity = -product.Quantity
+		movement.Comment = fmt.Sprintf("order #%d, %g %s short", orderID, short, product.Unit)
+		_, err = uc.inventory.ApplyMovement(movement)
+		if errors.Is(err, ErrInsufficientStock) {
+			continue
+		}
+		if err == nil {
+			log.Printf("order %d: product %d ran out, %g %s short for dish %d", orderID, product.ID, short, product.Unit, movement.DishID)
+		}
+		return err
+	}
+}
+
+func (uc *recipeUseCase) coverage(menu *domMenu.Menu) (*domRecipe.Coverage, error) {
+	coverage := domRecipe.Coverage{
+		MenuID:    menu.ID,
+		Dishes:    make([]domRecipe.DishCoverage, 0, len(menu.Dishes)),
+		Shortages: make([]domRecipe.Shortage, 0),
+	}
+
+	products := make(map[domInventory.ProductID]*domInventory.Product)
+	required := make(map[domInventory.ProductID]float64)
+	var productIDs []domInventory.ProductID
+
+	for _, dish := range menu.Dishes {
+		recipe, err := uc.recipes.GetRecipe(dish.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		planned := menu.Portions[dish.ID]
+		dishCoverage := domRecipe.DishCoverage{DishID: dish.ID, Name: dish.Name, Planned: planned, Available: -1}
+
+		for _, ingredient := range recipe.Ingredients {
+			product, ok := products[ingredient.ProductID]
+			if !ok {
+				if product, err = uc.inventory.GetProductByID(ingredient.ProductID); err != nil {
+					return nil, err
+				}
+				products[ingredient.ProductID] = product
+				productIDs = append(productIDs, ingredient.ProductID)
+			}
+
+			portions := int(math.Floor(product.Quantity/ingredient.Quantity + quantityEpsilon))
+			if dishCoverage.Available < 0 || portions < dishCoverage.Available {
+				dishCoverage.Available = portions
+			}
+			required[ingredient.ProductID] += ingredient.Quantity * float64(planned)
+		}
+
+		coverage.Dishes = append(coverage.Dishes, dishCoverage)
+	}
+
+	for _, id := range productIDs {
+		product := products[id]
+		if required[id] > product.Quantity+quantityEpsilon {
+			coverage.Shortages = append(coverage.Shortages, domRecipe.Shortage{
+				ProductID: id,
+				Name:      product.Name,
+				Unit:      product.Unit,
+				Required:  required[id],
+				Available: product.Quantity,
+			})
+		}
+	}
+
+	return &coverage, nil
+}
+
+// quantityEpsilon absorbs float rounding when stock is divided into portions,
+// so that 0.6 kg is three portions of 0.2 kg rather than two.
+const quantityEpsilon = 1e-9
+
+func menuHasDish(menu *domMenu.Menu, dishID domMenu.DishID) bool {
+	for _, dish := range menu.Dishes {
+		if dish.ID == dishID {
+			return true
+		}
+	}
+	return false
+}
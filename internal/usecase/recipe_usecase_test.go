@@ -0,0 +1,144 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domInventory "canteen-app/internal/domain/inventory"
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domRecipe "canteen-app/internal/domain/recipe"
+	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipe_CoverageAndDeduction(t *testing.T) {
+	const studentID = domUser.UserID(7)
+
+	menuRepo := ram_storage.NewMenuRepo()
+	orderRepo := ram_storage.NewOrderRepo()
+	walletRepo := ram_storage.NewWalletRepo()
+	inventoryRepo := ram_storage.NewInventoryRepo()
+
+	menuUC := usecase.NewMenuUseCase(menuRepo)
+	inventoryUC := usecase.NewInventoryUseCase(inventoryRepo, menuRepo)
+	recipeUC := usecase.NewRecipeUseCase(ram_storage.NewRecipeRepo(), menuRepo, inventoryRepo)
+	orderUC := usecase.NewOrderUseCase(orderRepo, menuRepo, walletRepo, ram_storage.NewSubscriptionRepo(), ram_storage.NewDietaryProfileRepo(), recipeUC)
+
+	beet, err := inventoryUC.CreateProduct(domInventory.Product{Name: "Свекла", Unit: domInventory.Kilogram})
+	require.NoError(t, err)
+	_, err = inventoryUC.RecordMovement(domInventory.Movement{ProductID: beet.ID, Kind: domInventory.Receipt, Quantity: 1})
+	require.NoError(t, err)
+
+	borscht, err := menuUC.CreateDish(domMenu.Dish{Name: "Борщ", Price: 15000})
+	require.NoError(t, err)
+	tea, err := menuUC.CreateDish(domMenu.Dish{Name: "Чай", Price: 2000})
+	require.NoError(t, err)
+
+	_, err = recipeUC.SetRecipe(domRecipe.Recipe{DishID: borscht.ID, Ingredients: []domRecipe.Ingredient{{ProductID: beet.ID, Quantity: 0}}})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRecipe)
+
+	_, err = recipeUC.SetRecipe(domRecipe.Recipe{DishID: borscht.ID, Ingredients: []domRecipe.Ingredient{{ProductID: 99, Quantity: 1}}})
+	assert.ErrorIs(t, err, usecase.ErrProductNotFound)
+
+	recipe, err := recipeUC.SetRecipe(domRecipe.Recipe{DishID: borscht.ID, Ingredients: []domRecipe.Ingredient{
+		{ProductID: beet.ID, Quantity: 0.1},
+		{ProductID: beet.ID, Quantity: 0.1},
+	}})
+	require.NoError(t, err)
+	require.Len(t, recipe.Ingredients, 1)
+	assert.InDelta(t, 0.2, recipe.Ingredients[0].Quantity, 1e-9)
+
+	menu, err := menuUC.CreateMenu(time.Now(), domMenu.Lunch, []domMenu.DishID{borscht.ID, tea.ID})
+	require.NoError(t, err)
+
+	_, err = recipeUC.PlanPortions(menu.ID, map[domMenu.DishID]int{99: 1})
+	assert.ErrorIs(t, err, usecase.ErrDishNotInMenu)
+
+	coverage, err := recipeUC.PlanPortions(menu.ID, map[domMenu.DishID]int{borscht.ID: 8, tea.ID: 100})
+	require.NoError(t, err)
+	assert.False(t, coverage.Covered())
+	require.Len(t, coverage.Dishes, 2)
+	assert.Equal(t, 5, coverage.Dishes[0].Available)
+	assert.False(t, coverage.Dishes[0].Covered())
+	assert.Equal(t, -1, coverage.Dishes[1].Available)
+	assert.True(t, coverage.Dishes[1].Covered())
+	require.Len(t, coverage.Shortages, 1)
+	assert.InDelta(t, 1.6, coverage.Shortages[0].Required, 1e-9)
+
+	menu, err = menuUC.GetMenu(menu.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 8, menu.Portions[borscht.ID])
+
+	_, err = walletRepo.Append(domWallet.Entry{StudentID: studentID, Kind: domWallet.TopUp, Amount: 100000, CreatedAt: time.Now()})
+	require.NoError(t, err)
+
+	order, err := orderUC.PlaceOrder(studentID, menu.ID, []domOrder.Item{{DishID: borscht.ID, Quantity: 2}, {DishID: tea.ID, Quantity: 1}}, false)
+	require.NoError(t, err)
+	_, err = orderUC.PayOrder(studentID, order.ID)
+	require.NoError(t, err)
+	_, err = orderUC.MarkPrepared(order.ID)
+	require.NoError(t, err)
+
+	beet, err = inventoryUC.GetProduct(beet.ID)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, beet.Quantity, 1e-9)
+
+	_, err = orderUC.MarkIssued(order.ID)
+	require.NoError(t, err)
+
+	beet, err = inventoryUC.GetProduct(beet.ID)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.6, beet.Quantity, 1e-9)
+
+	movements, err := inventoryUC.ListMovements(beet.ID)
+	require.NoError(t, err)
+	require.Len(t, movements, 2)
+	assert.Equal(t, domInventory.Consumption, movements[1].Kind)
+	assert.Equal(t, borscht.ID, movements[1].DishID)
+
+	coverage, err = recipeUC.Coverage(menu.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, coverage.Dishes[0].Available)
+}
+
+func TestRecipe_ConsumeIngredientsShortage(t *testing.T) {
+	menuRepo := ram_storage.NewMenuRepo()
+	inventoryRepo := ram_storage.NewInventoryRepo()
+	menuUC := usecase.NewMenuUseCase(menuRepo)
+	inventoryUC := usecase.NewInventoryUseCase(inventoryRepo, menuRepo)
+	recipeUC := usecase.NewRecipeUseCase(ram_storage.NewRecipeRepo(), menuRepo, inventoryRepo)
+
+	beet, err := inventoryUC.CreateProduct(domInventory.Product{Name: "Свекла", Unit: domInventory.Kilogram})
+	require.NoError(t, err)
+	_, err = inventoryUC.RecordMovement(domInventory.Movement{ProductID: beet.ID, Kind: domInventory.Receipt, Quantity: 0.3})
+	require.NoError(t, err)
+
+	borscht, err := menuUC.CreateDish(domMenu.Dish{Name: "Борщ", Price: 15000})
+	require.NoError(t, err)
+	_, err = recipeUC.SetRecipe(domRecipe.Recipe{DishID: borscht.ID, Ingredients: []domRecipe.Ingredient{{ProductID: beet.ID, Quantity: 0.2}}})
+	require.NoError(t, err)
+
+	order := domOrder.Order{ID: 1, Items: []domOrder.Item{{DishID: borscht.ID, Quantity: 2}}}
+	require.NoError(t, recipeUC.ConsumeIngredients(order))
+
+	beet, err = inventoryUC.GetProduct(beet.ID)
+	require.NoError(t, err)
+	assert.InDelta(t, 0, beet.Quantity, 1e-9, "what there was is used up")
+
+	movements, err := inventoryUC.ListMovements(beet.ID)
+	require.NoError(t, err)
+	require.Len(t, movements, 2)
+	assert.InDelta(t, -0.3, movements[1].Quantity, 1e-9)
+	assert.Contains(t, movements[1].Comment, "short")
+
+	require.NoError(t, recipeUC.ConsumeIngredients(order), "an empty stock only logs the shortage")
+	movements, err = inventoryUC.ListMovements(beet.ID)
+	require.NoError(t, err)
+	assert.Len(t, movements, 2)
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// RefreshJanitor periodically purges expired refresh tokens and reports the
+// number of active sessions.
+type RefreshJanitor struct {
+	refreshRepo RefreshTokenRepository
+	metrics     SessionMetrics
+	interval    time.Duration
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+func NewRefreshJanitor(refreshRepo RefreshTokenRepository, metrics SessionMetrics, interval time.Duration) *RefreshJanitor {
+	return &RefreshJanitor{
+		refreshRepo: refreshRepo,
+		metrics:     metrics,
+		interval:    interval,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Start runs a sweep right away and then one every interval in the
+// background. Calls after the first have no effect.
+func (j *RefreshJanitor) Start() {
+	j.startOnce.Do(func() {
+		go j.run()
+	})
+}
+
+// Stop ends the background loop and waits for a running sweep to finish. It
+// is safe to call without Start and more than once.
+func (j *RefreshJanitor) Stop() error {
+	j.stopOnce.Do(func() {
+		close(j.stop)
+		j.startOnce.Do(func() { close(j.done) })
+	})
+	<-j.done
+	return nil
+}
+
+func (j *RefreshJanitor) run() {
+	defer close(j.done)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.Sweep()
+		select {
+		case <-ticker.C:
+		case <-j.stop:
+			return
+		}
+	}
+}
+
+// Sweep deletes the expired tokens once and updates the metrics.
+func (j *RefreshJanitor) Sweep() {
+	now := time.Now()
+	deleted, err := j.refreshRepo.DeleteExpired(now)
+	if err != nil {
+		log.Printf("refresh janitor: delete expired tokens: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("refresh janitor: deleted %d expired tokens", deleted)
+	}
+
+	active, err := j.refreshRepo.CountActive(now)
+	if err != nil {
+		log.Printf("refresh janitor: count active tokens: %v", err)
+		return
+	}
+	j.metrics.SetActiveSessions(active)
+}
@@ -0,0 +1,84 @@
+package usecase_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domAuth "canteen-app/internal/domain/auth"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedMetrics struct {
+	mu     sync.Mutex
+	active []int
+}
+
+func (m *recordedMetrics) SetActiveSessions(n int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.active = append(m.active, n)
+}
+
+func (m *recordedMetrics) reports() []int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]int(nil), m.active...)
+}
+
+func TestRefreshJanitor_Sweep(t *testing.T) {
+	repo := ram_storage.NewRefreshRepo()
+	require.NoError(t, repo.Save(domAuth.RefreshToken{ID: "expired", UserID: 1, FamilyID: "expired", ExpiresAt: time.Now().Add(-time.Minute)}))
+	require.NoError(t, repo.Save(domAuth.RefreshToken{ID: "active", UserID: 1, FamilyID: "active", ExpiresAt: time.Now().Add(time.Hour)}))
+	require.NoError(t, repo.Save(domAuth.RefreshToken{ID: "active-2", UserID: 2, FamilyID: "active-2", ExpiresAt: time.Now().Add(time.Hour)}))
+
+	metrics := &recordedMetrics{}
+	usecase.NewRefreshJanitor(repo, metrics, time.Hour).Sweep()
+
+	assert.Equal(t, []int{2}, metrics.reports())
+	deleted, err := repo.DeleteExpired(time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, deleted, "expired token left behind")
+}
+
+func TestRefreshJanitor_StartStop(t *testing.T) {
+	repo := ram_storage.NewRefreshRepo()
+	metrics := &recordedMetrics{}
+	janitor := usecase.NewRefreshJanitor(repo, metrics, 10*time.Millisecond)
+
+	janitor.Start()
+	require.NoError(t, repo.Save(domAuth.RefreshToken{ID: "short-lived", UserID: 1, FamilyID: "short-lived", ExpiresAt: time.Now().Add(200 * time.Millisecond)}))
+
+	// The token shows up as active and then disappears once it expires.
+	assert.Eventually(t, func() bool {
+		reports := metrics.reports()
+		seen := false
+		for _, n := range reports {
+			seen = seen || n == 1
+		}
+		return seen && reports[len(reports)-1] == 0
+	}, 2*time.Second, 5*time.Millisecond)
+
+	require.NoError(t, janitor.Stop())
+	require.NoError(t, janitor.Stop(), "second stop")
+	deleted, err := repo.DeleteExpired(time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, deleted, "expired token left behind")
+
+	reported := len(metrics.reports())
+
+	time.Sleep(30 * time.Millisecond)
+	assert.Len(t, metrics.reports(), reported, "sweeps after stop")
+}
+
+func TestRefreshJanitor_StopWithoutStart(t *testing.T) {
+	janitor := usecase.NewRefreshJanitor(ram_storage.NewRefreshRepo(), &recordedMetrics{}, time.Hour)
+	assert.NoError(t, janitor.Stop())
+
+	janitor.Start()
+	assert.NoError(t, janitor.Stop())
+}
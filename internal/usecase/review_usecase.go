@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domReview "canteen-app/internal/domain/review"
+)
+
+type reviewUseCase struct {
+	reviews ReviewRepository
+	orders  OrderRepository
+}
+
+func NewReviewUseCase(reviews ReviewRepository, orders OrderRepository) *reviewUseCase {
+	return &reviewUseCase{reviews: reviews, orders: orders}
+}
+
+// CreateReview rates a dish the student received. Only dishes from the
+// student's own issued orders can be reviewed, once per order.
+func (uc *reviewUseCase) CreateReview(review domReview.Review) (*domReview.Review, error) {
+	if review.Rating < domReview.MinRating || review.Rating > domReview.MaxRating {
+		return nil, ErrInvalidRating
+	}
+
+	order, err := uc.orders.GetOrderByID(review.OrderID)
+	if errors.Is(err, ErrOrderNotFound) || (err == nil && order.StudentID != review.StudentID) {
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status != domOrder.Issued || !containsDish(order.Items, review.DishID) {
+		return nil, ErrReviewNotAllowed
+	}
+
+	review.ID = 0
+	review.Comment = strings.TrimSpace(review.Comment)
+	review.Hidden = false
+	review.CreatedAt = time.Now()
+
+	id, err := uc.reviews.CreateReview(review)
+	if err != nil {
+		return nil, err
+	}
+
+	review.ID = id
+	return &review, nil
+}
+
+func (uc *reviewUseCase) ListDishReviews(dishID domMenu.DishID) ([]domReview.Review, error) {
+	return uc.reviews.ListReviews(dishID, false)
+}
+
+// ListAllReviews returns every review including hidden ones, for moderation.
+func (uc *reviewUseCase) ListAllReviews() ([]domReview.Review, error) {
+	return uc.reviews.ListReviews(0, true)
+}
+
+func (uc *reviewUseCase) SetHidden(id domReview.ReviewID, hidden bool) (*domReview.Review, error) {
+	if err := uc.reviews.SetHidden(id, hidden); err != nil {
+		return nil, err
+	}
+	return uc.reviews.GetReviewByID(id)
+}
+
+func (uc *reviewUseCase) Ratings(dishIDs []domMenu.DishID) (map[domMenu.DishID]domReview.Rating, error) {
+	return uc.reviews.Ratings(dishIDs)
+}
+
+func containsDish(items []domOrder.Item, dishID domMenu.DishID) bool {
+	for _, item := range items {
+		if item.DishID == dishID {
+			return true
+		}
+	}
+	return false
+}
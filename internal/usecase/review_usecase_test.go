@@ -0,0 +1,98 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domReview "canteen-app/internal/domain/review"
+	domUser "canteen-app/internal/domain/user"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewUseCase_CreateReview(t *testing.T) {
+	const (
+		studentID = domUser.UserID(7)
+		dishID    = domMenu.DishID(2)
+	)
+
+	orderRepo := ram_storage.NewOrderRepo()
+	reviewRepo := ram_storage.NewReviewRepo()
+	reviewUC := usecase.NewReviewUseCase(reviewRepo, orderRepo)
+
+	newOrder := func(owner domUser.UserID, status domOrder.Status) domOrder.OrderID {
+		id, err := orderRepo.CreateOrder(domOrder.Order{
+			StudentID: owner,
+			Items:     []domOrder.Item{{DishID: dishID, Quantity: 1}},
+			Status:    status,
+			CreatedAt: time.Now(),
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	issued := newOrder(studentID, domOrder.Issued)
+	paid := newOrder(studentID, domOrder.Paid)
+	foreign := newOrder(studentID+1, domOrder.Issued)
+
+	tests := []struct {
+		name    string
+		review  domReview.Review
+		wantErr error
+	}{
+		{
+			name:   "issued order",
+			review: domReview.Review{StudentID: studentID, OrderID: issued, DishID: dishID, Rating: 4},
+		},
+
+		{
+			name:    "same dish twice",
+			review:  domReview.Review{StudentID: studentID, OrderID: issued, DishID: dishID, Rating: 5},
+			wantErr: usecase.ErrAlreadyReviewed,
+		},
+
+		{
+			name:    "order not issued yet",
+			review:  domReview.Review{StudentID: studentID, OrderID: paid, DishID: dishID, Rating: 5},
+			wantErr: usecase.ErrReviewNotAllowed,
+		},
+
+		{
+			name:    "dish not in order",
+			review:  domReview.Review{StudentID: studentID, OrderID: issued, DishID: dishID + 1, Rating: 5},
+			wantErr: usecase.ErrReviewNotAllowed,
+		},
+
+		{
+			name:    "order of another student",
+			review:  domReview.Review{StudentID: studentID, OrderID: foreign, DishID: dishID, Rating: 5},
+			wantErr: usecase.ErrOrderNotFound,
+		},
+
+		{
+			name:    "rating out of range",
+			review:  domReview.Review{StudentID: studentID, OrderID: issued, DishID: dishID, Rating: 6},
+			wantErr: usecase.ErrInvalidRating,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := reviewUC.CreateReview(tc.review)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	ratings, err := reviewUC.Ratings([]domMenu.DishID{dishID})
+	require.NoError(t, err)
+	assert.Equal(t, domReview.Rating{DishID: dishID, Average: 4, Count: 1}, ratings[dishID])
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	domSubscription "canteen-app/internal/domain/subscription"
+	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
+)
+
+type subscriptionUseCase struct {
+	subscriptions SubscriptionRepository
+	wallet        WalletRepository
+}
+
+func NewSubscriptionUseCase(subscriptions SubscriptionRepository, wallet WalletRepository) *subscriptionUseCase {
+	return &subscriptionUseCase{subscriptions: subscriptions, wallet: wallet}
+}
+
+func (uc *subscriptionUseCase) CreatePlan(plan domSubscription.Plan) (*domSubscription.Plan, error) {
+	plan.ID = 0
+	plan.StartDate = truncateToDay(plan.StartDate)
+	plan.EndDate = truncateToDay(plan.EndDate)
+
+	if plan.Price <= 0 || plan.EndDate.Before(plan.StartDate) || plan.DaysFrom(plan.StartDate) == 0 {
+		return nil, ErrInvalidPlan
+	}
+
+	id, err := uc.subscriptions.CreatePlan(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.ID = id
+	return &plan, nil
+}
+
+func (uc *subscriptionUseCase) ListPlans() ([]domSubscription.Plan, error) {
+	return uc.subscriptions.ListPlans()
+}
+
+// Purchase charges the plan price to the student's wallet and creates a
+// subscription for the days of the plan that are still ahead.
+func (uc *subscriptionUseCase) Purchase(studentID domUser.UserID, planID domSubscription.PlanID) (*domSubscription.Subscription, error) {
+	plan, err := uc.subscriptions.GetPlanByID(planID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	meals := plan.DaysFrom(truncateToDay(now))
+	if meals == 0 {
+		return nil, ErrPlanExpired
+	}
+
+	_, err = uc.wallet.Append(domWallet.Entry{
+		StudentID: studentID,
+		Kind:      domWallet.SubscriptionPurchase,
+		Amount:    -plan.Price,
+		AuthorID:  studentID,
+		Comment:   plan.Name,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sub := domSubscription.Subscription{
+		StudentID:      studentID,
+		Plan:           *plan,
+		RemainingMeals: meals,
+		PurchasedAt:    now,
+	}
+
+	id, err := uc.subscriptions.CreateSubscription(sub)
+	if err != nil {
+		_, refundErr := uc.wallet.Append(domWallet.Entry{
+			StudentID: studentID,
+			Kind:      domWallet.Refund,
+			Amount:    plan.Price,
+			AuthorID:  studentID,
+			Comment:   "subscription purchase rolled back",
+			CreatedAt: time.Now(),
+		})
+		return nil, errors.Join(err, refundErr)
+	}
+
+	sub.ID = id
+	return &sub, nil
+}
+
+func (uc *subscriptionUseCase) ListStudentSubscriptions(studentID domUser.UserID) ([]domSubscription.Subscription, error) {
+	return uc.subscriptions.ListSubscriptionsByStudent(studentID)
+}
+
+// ActiveSubscriptions returns the student's subscriptions that still have
+// meals left and have not ended by the given date.
+func (uc *subscriptionUseCase) ActiveSubscriptions(studentID domUser.UserID, date time.Time) ([]domSubscription.Subscription, error) {
+	subs, err := uc.subscriptions.ListSubscriptionsByStudent(studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	date = truncateToDay(date)
+	active := make([]domSubscription.Subscription, 0, len(subs))
+	for _, sub := range subs {
+		if sub.RemainingMeals > 0 && !sub.Plan.EndDate.Before(date) {
+			active = append(active, sub)
+		}
+	}
+	return active, nil
+}
@@ -0,0 +1,90 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"canteen-app/internal/adapter/repo/ram_storage"
+	domMenu "canteen-app/internal/domain/menu"
+	domOrder "canteen-app/internal/domain/order"
+	domSubscription "canteen-app/internal/domain/subscription"
+	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
+	"canteen-app/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscription_CoversDailyMeal(t *testing.T) {
+	const studentID = domUser.UserID(7)
+
+	menuRepo := ram_storage.NewMenuRepo()
+	orderRepo := ram_storage.NewOrderRepo()
+	walletRepo := ram_storage.NewWalletRepo()
+	subscriptionRepo := ram_storage.NewSubscriptionRepo()
+
+	menuUC := usecase.NewMenuUseCase(menuRepo)
+	recipeUC := usecase.NewRecipeUseCase(ram_storage.NewRecipeRepo(), menuRepo, ram_storage.NewInventoryRepo())
+	orderUC := usecase.NewOrderUseCase(orderRepo, menuRepo, walletRepo, subscriptionRepo, ram_storage.NewDietaryProfileRepo(), recipeUC)
+	subscriptionUC := usecase.NewSubscriptionUseCase(subscriptionRepo, walletRepo)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	dish, err := menuUC.CreateDish(domMenu.Dish{Name: "Борщ", Price: 15000})
+	require.NoError(t, err)
+	menu, err := menuUC.CreateMenu(today, domMenu.Lunch, []domMenu.DishID{dish.ID})
+	require.NoError(t, err)
+
+	plan, err := subscriptionUC.CreatePlan(domSubscription.Plan{
+		Name:      "lunch, 3 days",
+		MealType:  domMenu.Lunch,
+		StartDate: today,
+		EndDate:   today.AddDate(0, 0, 2),
+		Price:     40000,
+	})
+	require.NoError(t, err)
+
+	_, err = subscriptionUC.Purchase(studentID, plan.ID)
+	assert.ErrorIs(t, err, usecase.ErrInsufficientFunds)
+
+	_, err = walletRepo.Append(domWallet.Entry{StudentID: studentID, Kind: domWallet.TopUp, Amount: 60000, CreatedAt: time.Now()})
+	require.NoError(t, err)
+
+	sub, err := subscriptionUC.Purchase(studentID, plan.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, sub.RemainingMeals)
+
+	balance, err := walletRepo.Balance(studentID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20000), balance)
+
+	items := []domOrder.Item{{DishID: dish.ID, Quantity: 1}}
+
+	covered, err := orderUC.PlaceOrder(studentID, menu.ID, items, false)
+	require.NoError(t, err)
+	covered, err = orderUC.PayOrder(studentID, covered.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sub.ID, covered.SubscriptionID)
+
+	// The subscription covers one meal a day, the second lunch is charged.
+	extra, err := orderUC.PlaceOrder(studentID, menu.ID, items, false)
+	require.NoError(t, err)
+	extra, err = orderUC.PayOrder(studentID, extra.ID)
+	require.NoError(t, err)
+	assert.Zero(t, extra.SubscriptionID)
+
+	balance, err = walletRepo.Balance(studentID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5000), balance)
+
+	_, err = orderUC.MarkPrepared(covered.ID)
+	require.NoError(t, err)
+	_, err = orderUC.MarkIssued(covered.ID)
+	require.NoError(t, err)
+
+	active, err := subscriptionUC.ActiveSubscriptions(studentID, today)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, 2, active[0].RemainingMeals)
+}
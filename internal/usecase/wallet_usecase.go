@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"time"
+
+	domUser "canteen-app/internal/domain/user"
+	domWallet "canteen-app/internal/domain/wallet"
+)
+
+type walletUseCase struct {
+	wallet WalletRepository
+	users  UserRepository
+}
+
+func NewWalletUseCase(wallet WalletRepository, users UserRepository) *walletUseCase {
+	return &walletUseCase{wallet: wallet, users: users}
+}
+
+func (uc *walletUseCase) Balance(studentID domUser.UserID) (int64, error) {
+	return uc.wallet.Balance(studentID)
+}
+
+func (uc *walletUseCase) History(studentID domUser.UserID) ([]domWallet.Entry, error) {
+	return uc.wallet.ListEntries(studentID)
+}
+
+func (uc *walletUseCase) PostAdminEntry(adminID, studentID domUser.UserID, kind domWallet.EntryKind, amount int64, comment string) (*domWallet.Entry, error) {
+	switch {
+	case kind == domWallet.TopUp && amount <= 0,
+		kind == domWallet.Correction && amount == 0,
+		kind != domWallet.TopUp && kind != domWallet.Correction:
+		return nil, ErrInvalidAmount
+	}
+
+	student, err := uc.users.GetUserByID(studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if student.Role != domUser.RoleStudent {
+		return nil, ErrNotAStudent
+	}
+
+	entry := domWallet.Entry{
+		StudentID: studentID,
+		Kind:      kind,
+		Amount:    amount,
+		AuthorID:  adminID,
+		Comment:   comment,
+		CreatedAt: time.Now(),
+	}
+
+	id, err := uc.wallet.Append(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.ID = id
+	return &entry, nil
+}